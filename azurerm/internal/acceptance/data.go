@@ -10,6 +10,7 @@ import (
 
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 )
 
 const (
@@ -54,10 +55,15 @@ type TestData struct {
 
 	// resourceLabel is the local used for the resource - generally "test""
 	resourceLabel string
+
+	// testName is the name of the test, used to record/replay the requests made during this test
+	testName string
 }
 
 // BuildTestData generates some test data for the given resource
 func BuildTestData(t *testing.T, resourceType string, resourceLabel string) TestData {
+	setReplayDefaults()
+
 	env, err := Environment()
 	if err != nil {
 		t.Fatalf("Error retrieving Environment: %+v", err)
@@ -73,6 +79,7 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 
 		ResourceType:  resourceType,
 		resourceLabel: resourceLabel,
+		testName:      t.Name(),
 	}
 
 	if features.UseDynamicTestLocations() {
@@ -85,6 +92,23 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		}
 	}
 
+	// the values unique to this test run are replaced with placeholders in the recordings
+	stop, err := recording.Start(t.Name(), map[string]string{
+		"random_integer":     strconv.Itoa(testData.RandomInteger),
+		"random_string":      testData.RandomString,
+		"location_primary":   testData.Locations.Primary,
+		"location_secondary": testData.Locations.Secondary,
+		"location_ternary":   testData.Locations.Ternary,
+	})
+	if err != nil {
+		t.Fatalf("Error starting the recording: %+v", err)
+	}
+	t.Cleanup(func() {
+		if err := stop(); err != nil {
+			t.Errorf("Error saving the recording: %+v", err)
+		}
+	})
+
 	return testData
 }
 
@@ -104,7 +128,9 @@ func (td *TestData) RandomIntOfLength(len int) int {
 
 	// 16-17 just strip off the last 1-2 digits
 	if len >= 16 {
-		return td.RandomInteger / int(math.Pow10(18-len))
+		i := td.RandomInteger / int(math.Pow10(18-len))
+		recording.AddValue(td.testName, fmt.Sprintf("random_integer_%d", len), strconv.Itoa(i))
+		return i
 	}
 
 	// 8-15 keep len - 2 digits and add 2 characters of randomness on
@@ -112,6 +138,7 @@ func (td *TestData) RandomIntOfLength(len int) int {
 	r := s[16:18]
 	v := s[0 : len-2]
 	i, _ := strconv.Atoi(v + r)
+	recording.AddValue(td.testName, fmt.Sprintf("random_integer_%d", len), strconv.Itoa(i))
 
 	return i
 }
@@ -123,7 +150,9 @@ func (td *TestData) RandomStringOfLength(len int) string {
 		panic("Invalid Test: RandomStringOfLength: length argument must be between 1 and 1024 characters")
 	}
 
-	return randString(len)
+	s := randString(len)
+	recording.AddValue(td.testName, fmt.Sprintf("random_string_%d", len), s)
+	return s
 }
 
 // randString generates a random alphanumeric string of the length specified
@@ -10,9 +10,38 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 )
 
+// replayDefaults are the values used for the environment variables required to run the acceptance tests when
+// replaying the recorded interactions - since no credentials are required (and the values are replaced by the
+// placeholders within the recordings), these are only used when the environment variable isn't set
+var replayDefaults = map[string]string{
+	"ARM_CLIENT_ID":          "00000000-0000-0000-0000-000000000000",
+	"ARM_CLIENT_SECRET":      "replay",
+	"ARM_SUBSCRIPTION_ID":    "00000000-0000-0000-0000-000000000000",
+	"ARM_TENANT_ID":          "00000000-0000-0000-0000-000000000000",
+	"ARM_TEST_LOCATION":      "westeurope",
+	"ARM_TEST_LOCATION_ALT":  "eastus2",
+	"ARM_TEST_LOCATION_ALT2": "westus2",
+}
+
+// setReplayDefaults sets any of the required environment variables which aren't set when replaying
+func setReplayDefaults() {
+	if recording.ModeFromEnvironment() != recording.ModeReplay {
+		return
+	}
+
+	for variable, value := range replayDefaults {
+		if os.Getenv(variable) == "" {
+			os.Setenv(variable, value)
+		}
+	}
+}
+
 func PreCheck(t *testing.T) {
+	setReplayDefaults()
+
 	variables := []string{
 		"ARM_CLIENT_ID",
 		"ARM_CLIENT_SECRET",
@@ -6,6 +6,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
@@ -13,7 +14,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
+	computeClient "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/client"
 )
 
 type ClientBuilder struct {
@@ -26,6 +29,8 @@ type ClientBuilder struct {
 	StorageUseAzureAD           bool
 	TerraformVersion            string
 	Features                    features.UserFeatures
+	Retry                       *common.RetryOptions
+	RateLimit                   *common.RateLimitOptions
 }
 
 const azureStackEnvironmentError = `
@@ -56,6 +61,20 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf("unable to find environment %q from endpoint %q: %+v", builder.AuthConfig.Environment, builder.AuthConfig.MetadataHost, err)
 	}
 
+	// the built-in catalogue of Regions for this Environment is used to validate Locations when the
+	// Supported Locations can't be retrieved from the Azure MetaData Service
+	location.SetEnvironment(env.Name)
+
+	recordingMode := recording.ModeFromEnvironment()
+	if recordingMode != recording.ModeDisabled {
+		recording.SetEnvironmentValue("subscription_id", builder.AuthConfig.SubscriptionID)
+		recording.SetEnvironmentValue("tenant_id", builder.AuthConfig.TenantID)
+		recording.SetEnvironmentValue("client_id", builder.AuthConfig.ClientID)
+	}
+	if recordingMode == recording.ModeReplay {
+		return buildForReplay(ctx, builder, env)
+	}
+
 	// client declarations:
 	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, *env, builder.SkipProviderRegistration)
 	if err != nil {
@@ -138,6 +157,9 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		Environment:                 *env,
 		Features:                    builder.Features,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
+		Retry:                       builder.Retry,
+		RateLimit:                   builder.RateLimit,
+		Recording:                   recordingMode,
 	}
 
 	if err := client.Build(ctx, o); err != nil {
@@ -145,9 +167,157 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	if features.EnhancedValidationEnabled() {
+		cacheEnhancedValidationData(ctx, env, builder.AuthConfig.SubscriptionID, &client)
+	}
+
+	return &client, nil
+}
+
+// buildForReplay builds a Client which replays the interactions recorded for the Acceptance Tests, as
+// such no credentials are required and no requests are made to Azure Active Directory or the Azure APIs
+func buildForReplay(ctx context.Context, builder ClientBuilder, env *azure.Environment) (*Client, error) {
+	config := *builder.AuthConfig
+	config.GetAuthenticatedObjectID = nil
+
+	account, err := NewResourceManagerAccount(ctx, config, *env, builder.SkipProviderRegistration)
+	if err != nil {
+		return nil, fmt.Errorf("Error building account: %+v", err)
+	}
+
+	client := Client{
+		Account: account,
+	}
+
+	auth := autorest.NullAuthorizer{}
+	o := &common.ClientOptions{
+		SubscriptionId:              builder.AuthConfig.SubscriptionID,
+		TenantID:                    builder.AuthConfig.TenantID,
+		PartnerId:                   builder.PartnerId,
+		TerraformVersion:            builder.TerraformVersion,
+		GraphAuthorizer:             auth,
+		GraphEndpoint:               env.GraphEndpoint,
+		KeyVaultAuthorizer:          auth,
+		ResourceManagerAuthorizer:   auth,
+		ResourceManagerEndpoint:     env.ResourceManagerEndpoint,
+		StorageAuthorizer:           auth,
+		SynapseAuthorizer:           auth,
+		BatchManagementAuthorizer:   auth,
+		SkipProviderReg:             builder.SkipProviderRegistration,
+		DisableCorrelationRequestID: builder.DisableCorrelationRequestID,
+		CustomCorrelationRequestID:  builder.CustomCorrelationRequestID,
+		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
+		Environment:                 *env,
+		Features:                    builder.Features,
+		StorageUseAzureAD:           builder.StorageUseAzureAD,
+		Recording:                   recording.ModeReplay,
+	}
+
+	if err := client.Build(ctx, o); err != nil {
+		return nil, fmt.Errorf("error building Client: %+v", err)
+	}
+
+	// Enhanced Validation is intentionally not used when replaying, since this retrieves the
+	// Supported Locations from the Metadata Host - which isn't recorded
+	return &client, nil
+}
+
+// cacheEnhancedValidationData caches the supported Locations and Resource Providers for use in
+// Enhanced Validation - using the on-disk cache (when enabled) to avoid retrieving these from the
+// Azure APIs where possible, or should retrieving these from the Azure APIs fail
+func cacheEnhancedValidationData(ctx context.Context, env *azure.Environment, subscriptionId string, client *Client) {
+	cacheFile := resourceproviders.CacheFileFromEnvironment()
+	cached := cacheFile.Get(env.Name, subscriptionId)
+
+	if cacheFile.IsFresh(cached.SupportedLocations) {
+		log.Printf("[DEBUG] Using the cached Supported Locations")
+		location.SetSupportedLocations(cached.SupportedLocations.Values)
+	} else {
 		location.CacheSupportedLocations(ctx, env)
+		if locations := location.CachedSupportedLocations(); locations != nil {
+			cacheFile.Update(env.Name, subscriptionId, func(entry *resourceproviders.CacheEntry) {
+				entry.SupportedLocations = resourceproviders.NewCachedValues(*locations)
+			})
+		} else if cached.SupportedLocations != nil {
+			log.Printf("[DEBUG] Falling back to the (expired) cached Supported Locations")
+			location.SetSupportedLocations(cached.SupportedLocations.Values)
+		}
+	}
+
+	if cacheFile.IsFresh(cached.SupportedProviders) {
+		log.Printf("[DEBUG] Using the cached Supported Resource Providers")
+		resourceproviders.SetSupportedProviders(cached.SupportedProviders.Values)
+	} else {
 		resourceproviders.CacheSupportedProviders(ctx, client.Resource.ProvidersClient)
+		if providers := resourceproviders.CachedSupportedProviders(); providers != nil {
+			cacheFile.Update(env.Name, subscriptionId, func(entry *resourceproviders.CacheEntry) {
+				entry.SupportedProviders = resourceproviders.NewCachedValues(*providers)
+			})
+		} else if cached.SupportedProviders != nil {
+			log.Printf("[DEBUG] Falling back to the (expired) cached Supported Resource Providers")
+			resourceproviders.SetSupportedProviders(cached.SupportedProviders.Values)
+		}
 	}
 
-	return &client, nil
+	// the Availability Zones which each SKU is available in are retrieved on-demand (once per Location)
+	// when validating the Availability Zones for a Resource, since these are specific to the Subscription
+	location.SetSkuAvailabilityZonesFunc(skuAvailabilityZones(client.Compute))
+}
+
+// skuAvailabilityZones returns a SkuAvailabilityZonesFunc which retrieves the Availability Zones which each
+// SKU is available in within a Location from the Resource SKUs API - excluding any Availability Zones
+// where the SKU is restricted for this Subscription
+func skuAvailabilityZones(client *computeClient.Client) location.SkuAvailabilityZonesFunc {
+	return func(ctx context.Context, loc string) (*[]location.SkuAvailabilityZones, error) {
+		skus, err := client.ResourceSkusForLocation(ctx, loc)
+		if err != nil {
+			return nil, err
+		}
+
+		out := make([]location.SkuAvailabilityZones, 0)
+		for _, sku := range *skus {
+			if sku.ResourceType == nil || sku.Name == nil {
+				continue
+			}
+
+			restricted := make(map[string]struct{})
+			restrictedInLocation := false
+			if sku.Restrictions != nil {
+				for _, restriction := range *sku.Restrictions {
+					if restriction.Type == compute.Location {
+						restrictedInLocation = true
+					}
+					if restriction.Type != compute.Zone || restriction.RestrictionInfo == nil || restriction.RestrictionInfo.Zones == nil {
+						continue
+					}
+
+					for _, zone := range *restriction.RestrictionInfo.Zones {
+						restricted[zone] = struct{}{}
+					}
+				}
+			}
+
+			zones := make([]string, 0)
+			if sku.LocationInfo != nil && !restrictedInLocation {
+				for _, info := range *sku.LocationInfo {
+					if info.Location == nil || location.Normalize(*info.Location) != location.Normalize(loc) || info.Zones == nil {
+						continue
+					}
+
+					for _, zone := range *info.Zones {
+						if _, isRestricted := restricted[zone]; !isRestricted {
+							zones = append(zones, zone)
+						}
+					}
+				}
+			}
+
+			out = append(out, location.SkuAvailabilityZones{
+				ResourceType: *sku.ResourceType,
+				Name:         *sku.Name,
+				Zones:        zones,
+			})
+		}
+
+		return &out, nil
+	}
 }
@@ -108,6 +108,15 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// DefaultTags are the Tags specified in the `default_tags` block in the Provider, which are
+	// assigned to all resources which support Tags
+	DefaultTags map[string]string
+
+	// RegisteredResourceProviders is the set of (lower-cased) Resource Provider Namespaces which are registered
+	// or have been registered by the Provider - this is only populated when `resource_providers_to_register`
+	// is specified in the Provider block, and is used to check a Resource's Resource Provider is registered
+	RegisteredResourceProviders map[string]struct{}
+
 	Advisor               *advisor.Client
 	AnalysisServices      *analysisServices.Client
 	ApiManagement         *apiManagement.Client
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/meta"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/version"
 )
 
@@ -35,6 +36,17 @@ type ClientOptions struct {
 	Environment                 azure.Environment
 	Features                    features.UserFeatures
 	StorageUseAzureAD           bool
+
+	// Retry configures how requests which fail with a retryable status code are retried - when nil
+	// requests are only retried by the Azure SDK, when set this replaces the retries made by the Azure SDK
+	Retry *RetryOptions
+
+	// RateLimit configures the client-side rate limit applied to requests - when nil requests aren't rate limited
+	RateLimit *RateLimitOptions
+
+	// Recording specifies whether the requests made to the Azure APIs are recorded to (or replayed from)
+	// a cassette, which is used by the Acceptance Tests
+	Recording recording.Mode
 }
 
 func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.Authorizer) {
@@ -42,7 +54,27 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 
 	c.Authorizer = authorizer
 	c.Sender = sender.BuildSender("AzureRM")
+	// the interactions are recorded as sent/received, so this needs to be applied before any retries
+	if o.Recording != recording.ModeDisabled {
+		c.Sender = autorest.DecorateSender(c.Sender, recording.WithRecording(o.Recording))
+	}
+	// each attempt made by the retry decorator is rate limited, so this needs to be applied first
+	if o.RateLimit != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, withRateLimit(*o.RateLimit, o.SubscriptionId))
+	}
+	if o.Retry != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, withRetries(*o.Retry))
+
+		// the Azure SDK also retries each request (using the Send Decorators passed by each generated client),
+		// which would multiply the number of attempts - so these are replaced so that only the decorator above retries
+		c.SendDecorators = []autorest.SendDecorator{}
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
+	if o.Recording == recording.ModeReplay {
+		// the responses are replayed, so there's no need to wait between polling/retrying
+		c.PollingDelay = 0
+		c.RetryDuration = 0
+	}
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
 		if id == "" {
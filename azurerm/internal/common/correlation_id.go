@@ -37,3 +37,9 @@ func correlationRequestID() string {
 
 	return msCorrelationRequestID
 }
+
+// CorrelationRequestID returns the UUID sent in the `x-ms-correlation-request-id` header
+// for all requests made by this instance of the Provider
+func CorrelationRequestID() string {
+	return correlationRequestID()
+}
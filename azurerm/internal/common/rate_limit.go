@@ -0,0 +1,228 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// RequestClass is the class of HTTP Verb used for a request, which Azure Resource Manager
+// throttles independently for each Subscription
+type RequestClass string
+
+const (
+	RequestClassDelete RequestClass = "delete"
+	RequestClassRead   RequestClass = "read"
+	RequestClassWrite  RequestClass = "write"
+)
+
+// RateLimitOptions configures the client-side rate limit applied to requests made by each of the API Clients
+//
+// A separate token bucket is used for each Subscription and Request Class (Reads/Writes/Deletes) - which is
+// shared across all of the API Clients, such that the Subscription's quota isn't exceeded with high parallelism
+type RateLimitOptions struct {
+	// ReadsPerHour is the number of Read (GET/HEAD/OPTIONS) requests which can be made per hour
+	ReadsPerHour int
+
+	// WritesPerHour is the number of Write (PUT/POST/PATCH) requests which can be made per hour
+	WritesPerHour int
+
+	// DeletesPerHour is the number of Delete requests which can be made per hour
+	DeletesPerHour int
+
+	// Burst is the number of requests of each Request Class which can be made in quick succession
+	Burst int
+}
+
+// DefaultRateLimitOptions returns the RateLimitOptions used when these aren't configured in the Provider block,
+// which are the default Subscription limits documented for Azure Resource Manager
+func DefaultRateLimitOptions() RateLimitOptions {
+	return RateLimitOptions{
+		ReadsPerHour:   12000,
+		WritesPerHour:  1200,
+		DeletesPerHour: 15000,
+		Burst:          10,
+	}
+}
+
+func (o RateLimitOptions) requestsPerHour(class RequestClass) int {
+	switch class {
+	case RequestClassDelete:
+		return o.DeletesPerHour
+	case RequestClassWrite:
+		return o.WritesPerHour
+	}
+
+	return o.ReadsPerHour
+}
+
+// requestClassForMethod returns the Request Class for the specified HTTP Method
+func requestClassForMethod(method string) RequestClass {
+	switch strings.ToUpper(method) {
+	case http.MethodDelete:
+		return RequestClassDelete
+	case http.MethodPatch, http.MethodPost, http.MethodPut:
+		return RequestClassWrite
+	}
+
+	return RequestClassRead
+}
+
+// subscriptionIdFromRequest returns the Subscription ID which the request is scoped to, falling back
+// to the default Subscription ID for requests which aren't scoped to a Subscription
+func subscriptionIdFromRequest(r *http.Request, defaultSubscriptionId string) string {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if strings.EqualFold(segments[i], "subscriptions") && segments[i+1] != "" {
+			return strings.ToLower(segments[i+1])
+		}
+	}
+
+	return strings.ToLower(defaultSubscriptionId)
+}
+
+// tokenBucket is a token bucket which is refilled at a constant rate, up to the burst size
+type tokenBucket struct {
+	lock *sync.Mutex
+
+	// rate is the number of tokens added per second
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(requestsPerHour, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &tokenBucket{
+		lock:   &sync.Mutex{},
+		rate:   float64(requestsPerHour) / time.Hour.Seconds(),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token from the bucket, returning how long the caller must wait before it's available
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token to the bucket, for when the caller stops waiting for it
+func (b *tokenBucket) cancel() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.tokens++
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+// rateLimiter holds a token bucket for each Subscription and Request Class
+type rateLimiter struct {
+	lock    *sync.Mutex
+	options RateLimitOptions
+	buckets map[string]*tokenBucket
+}
+
+var (
+	rateLimitersLock = &sync.Mutex{}
+
+	// rateLimiters are shared across all API Clients using the same RateLimitOptions
+	rateLimiters = make(map[RateLimitOptions]*rateLimiter)
+)
+
+// rateLimiterFor returns the (shared) rate limiter for the specified options
+func rateLimiterFor(options RateLimitOptions) *rateLimiter {
+	rateLimitersLock.Lock()
+	defer rateLimitersLock.Unlock()
+
+	if existing, ok := rateLimiters[options]; ok {
+		return existing
+	}
+
+	limiter := &rateLimiter{
+		lock:    &sync.Mutex{},
+		options: options,
+		buckets: make(map[string]*tokenBucket),
+	}
+	rateLimiters[options] = limiter
+	return limiter
+}
+
+func (l *rateLimiter) bucket(subscriptionId string, class RequestClass) *tokenBucket {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	key := fmt.Sprintf("%s/%s", subscriptionId, class)
+	if existing, ok := l.buckets[key]; ok {
+		return existing
+	}
+
+	bucket := newTokenBucket(l.options.requestsPerHour(class), l.options.Burst)
+	l.buckets[key] = bucket
+	return bucket
+}
+
+// wait blocks until a request of the specified Request Class can be made for this Subscription
+func (l *rateLimiter) wait(ctx context.Context, subscriptionId string, class RequestClass) error {
+	bucket := l.bucket(subscriptionId, class)
+	delay := bucket.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Rate Limiting %s request for Subscription %q - waiting %s", class, subscriptionId, delay)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		bucket.cancel()
+		return ctx.Err()
+	}
+}
+
+// withRateLimit returns a SendDecorator which limits the rate of requests per Subscription and Request Class
+func withRateLimit(options RateLimitOptions, defaultSubscriptionId string) autorest.SendDecorator {
+	limiter := rateLimiterFor(options)
+
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			subscriptionId := subscriptionIdFromRequest(r, defaultSubscriptionId)
+			if err := limiter.wait(r.Context(), subscriptionId, requestClassForMethod(r.Method)); err != nil {
+				return nil, err
+			}
+
+			return s.Do(r)
+		})
+	}
+}
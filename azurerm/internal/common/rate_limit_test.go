@@ -0,0 +1,108 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRequestClassForMethod(t *testing.T) {
+	testData := map[string]RequestClass{
+		http.MethodGet:     RequestClassRead,
+		http.MethodHead:    RequestClassRead,
+		http.MethodOptions: RequestClassRead,
+		http.MethodPatch:   RequestClassWrite,
+		http.MethodPost:    RequestClassWrite,
+		http.MethodPut:     RequestClassWrite,
+		http.MethodDelete:  RequestClassDelete,
+		"delete":           RequestClassDelete,
+	}
+
+	for method, expected := range testData {
+		if actual := requestClassForMethod(method); actual != expected {
+			t.Fatalf("expected %q for %q but got %q", expected, method, actual)
+		}
+	}
+}
+
+func TestSubscriptionIdFromRequest(t *testing.T) {
+	testData := map[string]string{
+		"https://management.azure.com/subscriptions/ABC-123/resourceGroups/example?api-version=2020-01-01": "abc-123",
+		"https://management.azure.com/providers/Microsoft.Management/managementGroups/example":             "default",
+		"https://example.vault.azure.net/keys/example":                                                     "default",
+	}
+
+	for input, expected := range testData {
+		req, _ := http.NewRequest(http.MethodGet, input, nil)
+		if actual := subscriptionIdFromRequest(req, "DEFAULT"); actual != expected {
+			t.Fatalf("expected %q for %q but got %q", expected, input, actual)
+		}
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	// 3600 requests per hour is 1 per second
+	bucket := newTokenBucket(3600, 2)
+	now := bucket.last
+
+	if delay := bucket.reserve(now); delay != 0 {
+		t.Fatalf("expected the first request not to be delayed but got %s", delay)
+	}
+	if delay := bucket.reserve(now); delay != 0 {
+		t.Fatalf("expected the second request to use the burst but got %s", delay)
+	}
+	if delay := bucket.reserve(now); delay != time.Second {
+		t.Fatalf("expected the third request to be delayed by 1s but got %s", delay)
+	}
+
+	// the bucket is refilled over time, but never exceeds the burst
+	later := now.Add(time.Hour)
+	for i := 0; i < 2; i++ {
+		if delay := bucket.reserve(later); delay != 0 {
+			t.Fatalf("expected request %d to use the refilled burst but got %s", i, delay)
+		}
+	}
+	if delay := bucket.reserve(later); delay == 0 {
+		t.Fatalf("expected the burst not to be exceeded")
+	}
+}
+
+func TestWithRateLimitIsSharedAcrossClients(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	options := RateLimitOptions{
+		ReadsPerHour:   1,
+		WritesPerHour:  3600,
+		DeletesPerHour: 3600,
+		Burst:          1,
+	}
+
+	// two separate clients share the same bucket for the subscription
+	first := autorest.DecorateSender(server.Client(), withRateLimit(options, "shared"))
+	second := autorest.DecorateSender(server.Client(), withRateLimit(options, "shared"))
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	if _, err := first.Do(req); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ = http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if _, err := second.Do(req); err == nil {
+		t.Fatalf("expected the second request to be rate limited")
+	}
+
+	// writes are limited separately from reads
+	req, _ = http.NewRequest(http.MethodPut, server.URL, nil)
+	if _, err := second.Do(req); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
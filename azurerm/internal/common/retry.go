@@ -0,0 +1,154 @@
+package common
+
+import (
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// RetryOptions configures how requests which fail with a transient (retryable) status code
+// (for example as a result of throttling) are retried by each of the API Clients
+type RetryOptions struct {
+	// MaxAttempts is the maximum number of times a request is sent, including the initial request
+	MaxAttempts int
+
+	// BaseBackoff is the delay before the first retry, which is doubled for each subsequent retry
+	BaseBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between retries
+	MaxBackoff time.Duration
+
+	// HonorRetryAfter specifies whether the `Retry-After` header returned by the API should be used
+	// as the delay before the next retry, rather than the exponential backoff
+	HonorRetryAfter bool
+
+	// RetryableStatusCodes is the list of HTTP Status Codes which should be retried
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryableStatusCodes are the HTTP Status Codes which are retried when none are specified
+var DefaultRetryableStatusCodes = []int{
+	http.StatusRequestTimeout,      // 408
+	http.StatusTooManyRequests,     // 429
+	http.StatusInternalServerError, // 500
+	http.StatusBadGateway,          // 502
+	http.StatusServiceUnavailable,  // 503
+	http.StatusGatewayTimeout,      // 504
+}
+
+// DefaultRetryOptions returns the RetryOptions used when these aren't configured in the Provider block
+func DefaultRetryOptions() RetryOptions {
+	return RetryOptions{
+		MaxAttempts:          3,
+		BaseBackoff:          5 * time.Second,
+		MaxBackoff:           60 * time.Second,
+		HonorRetryAfter:      true,
+		RetryableStatusCodes: DefaultRetryableStatusCodes,
+	}
+}
+
+// isRetryable returns whether the specified HTTP Status Code should be retried
+func (o RetryOptions) isRetryable(statusCode int) bool {
+	for _, v := range o.RetryableStatusCodes {
+		if v == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the delay before the specified retry (starting at 1) - which is the `Retry-After`
+// header when this is returned and honored, else an exponential backoff with jitter
+func (o RetryOptions) backoff(retry int, resp *http.Response) time.Duration {
+	if o.HonorRetryAfter {
+		if delay, ok := retryAfter(resp); ok {
+			return delay
+		}
+	}
+
+	delay := o.BaseBackoff
+	for i := 1; i < retry && delay < o.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if o.MaxBackoff > 0 && delay > o.MaxBackoff {
+		delay = o.MaxBackoff
+	}
+
+	// add up to 20% jitter so that concurrent requests which are throttled don't retry in lockstep
+	if jitter := int64(delay) / 5; jitter > 0 {
+		delay += time.Duration(rand.Int63n(jitter)) // nolint gosec
+	}
+
+	return delay
+}
+
+// retryAfter parses the `Retry-After` header, which can be either a number of seconds or a HTTP Date
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	v := resp.Header.Get(autorest.HeaderRetryAfter)
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(v); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// withRetries returns a SendDecorator which retries requests which fail with one of the retryable status codes
+func withRetries(options RetryOptions) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			// ensure the request body can be re-read for each attempt
+			rr := autorest.NewRetriableRequest(r)
+
+			var resp *http.Response
+			var err error
+			for attempt := 1; ; attempt++ {
+				if err = rr.Prepare(); err != nil {
+					return resp, err
+				}
+
+				resp, err = s.Do(rr.Request())
+				if err != nil || resp == nil || !options.isRetryable(resp.StatusCode) || attempt >= options.MaxAttempts {
+					return resp, err
+				}
+
+				delay := options.backoff(attempt, resp)
+				log.Printf("[DEBUG] %s %s returned %d - retrying in %s (attempt %d of %d)", r.Method, r.URL, resp.StatusCode, delay, attempt+1, options.MaxAttempts)
+
+				// the response is discarded, so drain and close the body to allow the connection to be reused
+				if resp.Body != nil {
+					_, _ = io.Copy(ioutil.Discard, resp.Body)
+					resp.Body.Close()
+				}
+
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package common
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithRetries(t *testing.T) {
+	testData := []struct {
+		name             string
+		statusCodes      []int
+		options          RetryOptions
+		expectedAttempts int
+		expectedStatus   int
+	}{
+		{
+			name:             "success",
+			statusCodes:      []int{http.StatusOK},
+			options:          RetryOptions{MaxAttempts: 3, RetryableStatusCodes: DefaultRetryableStatusCodes},
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "retried until success",
+			statusCodes:      []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK},
+			options:          RetryOptions{MaxAttempts: 3, RetryableStatusCodes: DefaultRetryableStatusCodes},
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "attempts exhausted",
+			statusCodes:      []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests},
+			options:          RetryOptions{MaxAttempts: 2, RetryableStatusCodes: DefaultRetryableStatusCodes},
+			expectedAttempts: 2,
+			expectedStatus:   http.StatusTooManyRequests,
+		},
+		{
+			name:             "not retryable",
+			statusCodes:      []int{http.StatusConflict, http.StatusOK},
+			options:          RetryOptions{MaxAttempts: 3, RetryableStatusCodes: DefaultRetryableStatusCodes},
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusConflict,
+		},
+		{
+			name:             "custom retryable status code",
+			statusCodes:      []int{http.StatusConflict, http.StatusOK},
+			options:          RetryOptions{MaxAttempts: 3, RetryableStatusCodes: []int{http.StatusConflict}},
+			expectedAttempts: 2,
+			expectedStatus:   http.StatusOK,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				if string(body) != "hello" {
+					t.Errorf("expected the request body to be sent for each attempt but got %q", string(body))
+				}
+
+				w.WriteHeader(v.statusCodes[attempts])
+				attempts++
+			}))
+			defer server.Close()
+
+			req, _ := http.NewRequest(http.MethodPut, server.URL, strings.NewReader("hello"))
+			resp, err := autorest.DecorateSender(server.Client(), withRetries(v.options)).Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if resp.StatusCode != v.expectedStatus {
+				t.Fatalf("expected status %d but got %d", v.expectedStatus, resp.StatusCode)
+			}
+			if attempts != v.expectedAttempts {
+				t.Fatalf("expected %d attempts but got %d", v.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestConfigureClientRetriesOnlyOnce(t *testing.T) {
+	testData := []struct {
+		name             string
+		retry            *RetryOptions
+		expectedAttempts int
+	}{
+		{
+			name: "retry options",
+			retry: &RetryOptions{
+				MaxAttempts:          3,
+				BaseBackoff:          time.Millisecond,
+				RetryableStatusCodes: DefaultRetryableStatusCodes,
+			},
+			expectedAttempts: 3,
+		},
+		{
+			name: "single attempt",
+			retry: &RetryOptions{
+				MaxAttempts:          1,
+				RetryableStatusCodes: DefaultRetryableStatusCodes,
+			},
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				w.WriteHeader(http.StatusBadGateway)
+			}))
+			defer server.Close()
+
+			client := resources.NewGroupsClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+			options := ClientOptions{
+				Retry:                       v.retry,
+				DisableCorrelationRequestID: true,
+			}
+			options.ConfigureClient(&client.Client, autorest.NullAuthorizer{})
+
+			if _, err := client.Get(context.Background(), "example-resources"); err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+
+			if attempts != v.expectedAttempts {
+				t.Fatalf("expected %d attempts but got %d", v.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestWithRetriesContextCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	options := RetryOptions{
+		MaxAttempts:          3,
+		BaseBackoff:          time.Minute,
+		RetryableStatusCodes: DefaultRetryableStatusCodes,
+	}
+	if _, err := autorest.DecorateSender(server.Client(), withRetries(options)).Do(req); err == nil {
+		t.Fatalf("expected an error when the context is cancelled")
+	}
+}
+
+func TestRetryOptionsBackoff(t *testing.T) {
+	options := RetryOptions{
+		BaseBackoff:     time.Second,
+		MaxBackoff:      4 * time.Second,
+		HonorRetryAfter: true,
+	}
+
+	testData := []struct {
+		retry      int
+		retryAfter string
+		min        time.Duration
+		max        time.Duration
+	}{
+		{retry: 1, min: time.Second, max: 1200 * time.Millisecond},
+		{retry: 2, min: 2 * time.Second, max: 2400 * time.Millisecond},
+		{retry: 5, min: 4 * time.Second, max: 4800 * time.Millisecond},
+		{retry: 1, retryAfter: "30", min: 30 * time.Second, max: 30 * time.Second},
+		{retry: 1, retryAfter: "invalid", min: time.Second, max: 1200 * time.Millisecond},
+	}
+
+	for _, v := range testData {
+		resp := &http.Response{Header: http.Header{}}
+		if v.retryAfter != "" {
+			resp.Header.Set(autorest.HeaderRetryAfter, v.retryAfter)
+		}
+
+		actual := options.backoff(v.retry, resp)
+		if actual < v.min || actual > v.max {
+			t.Fatalf("expected the backoff for retry %d (Retry-After %q) to be between %s and %s but got %s", v.retry, v.retryAfter, v.min, v.max, actual)
+		}
+	}
+}
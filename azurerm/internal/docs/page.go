@@ -0,0 +1,342 @@
+package docs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	SectionArguments  = "Arguments Reference"
+	SectionAttributes = "Attributes Reference"
+	SectionTimeouts   = "Timeouts"
+	SectionImport     = "Import"
+)
+
+var (
+	// blockHeaderRegex matches the header for one or more blocks, e.g. "A `foo` block supports the following:",
+	// "A `foo` and `bar` block exports the following:" or "`foo` supports the following:"
+	blockHeaderRegex = regexp.MustCompile("^(?:(?:An?|The|Each|Elements of) )?((?:`[a-zA-Z0-9_]+`(?:, | and |, and | or )?)+)(?: -)?(?: blocks?)? (?:supports?|exports?|contains)\\b")
+	blockNameRegex   = regexp.MustCompile("`([a-zA-Z0-9_]+)`")
+	fieldRegex       = regexp.MustCompile("^\\* `([a-zA-Z0-9_]+)` - (.*)$")
+	importRegex      = regexp.MustCompile(`terraform import [a-zA-Z0-9_]+\.[a-zA-Z0-9_]+ (.+)`)
+	statusRegex      = regexp.MustCompile(`^\((Required|Optional)\)\s*`)
+	timeoutRegex     = regexp.MustCompile("^\\* `(create|read|update|delete)` - \\(Defaults to ([^)]+)\\)")
+
+	// ForceNewRegex matches the sentence documenting that changing a field forces a new resource to be created
+	ForceNewRegex = regexp.MustCompile(`(?i)\s*Changing this (forces|will force) a new (.+?) to be created\.?`)
+
+	// brandNameRegexes are used to find the brand name within the existing documentation, which is the last group
+	brandNameRegexes = []*regexp.Regexp{
+		regexp.MustCompile(`Used when (creating|retrieving) the (.+?)\.`),
+		regexp.MustCompile(`(?m)^(Manages|Gets information about) (?:an? )?(?:existing )?(.+?)\.`),
+		regexp.MustCompile(`information about an existing (.+?)\.`),
+		ForceNewRegex,
+	}
+)
+
+// PagePath returns the path to the documentation page for the specified Data Source/Resource within
+// the specified `website/docs` directory
+func PagePath(docsPath string, name string, isDataSource bool) string {
+	resourceKind := "r"
+	if isDataSource {
+		resourceKind = "d"
+	}
+
+	fileName := strings.TrimPrefix(name, "azurerm_")
+	filePath := filepath.Join(docsPath, resourceKind, fmt.Sprintf("%s.html.markdown", fileName))
+
+	// some of the older documentation pages don't use the `.html` suffix
+	legacyFilePath := filepath.Join(docsPath, resourceKind, fmt.Sprintf("%s.markdown", fileName))
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		if _, err := os.Stat(legacyFilePath); err == nil {
+			return legacyFilePath
+		}
+	}
+
+	return filePath
+}
+
+// Page is a documentation page, split into sections by the `##` headings
+type Page struct {
+	// Preamble is everything before the first section - the front matter, title, description & examples
+	Preamble string
+
+	Sections []Section
+}
+
+type Section struct {
+	Heading string
+
+	// Raw is the full contents of this section, including the heading
+	Raw string
+}
+
+// Field is a field documented within an Arguments/Attributes section
+type Field struct {
+	Name string
+
+	// Order is the position of this field within the documentation
+	Order int
+
+	// Status is the `Required`/`Optional` status of this field, which is empty for Attributes
+	Status string
+
+	Description string
+
+	// Notes are any additional lines (e.g. `~> **NOTE:**` blocks) documented after this field
+	Notes string
+}
+
+// Block is a list of fields documented within an Arguments/Attributes section, where the name
+// is empty for the top-level fields
+type Block struct {
+	Name   string
+	Fields map[string]Field
+
+	// Order is the position of this block within the documentation
+	Order int
+}
+
+// ParsePage splits the specified documentation page into the Preamble and each of it's Sections
+func ParsePage(input string) Page {
+	page := Page{}
+
+	current := make([]string, 0)
+	heading := ""
+	isPreamble := true
+	inCodeBlock := false
+	flush := func() {
+		raw := strings.Trim(strings.Join(current, "\n"), "\n")
+		if isPreamble {
+			page.Preamble = raw
+		} else {
+			page.Sections = append(page.Sections, Section{
+				Heading: heading,
+				Raw:     raw,
+			})
+		}
+		current = make([]string, 0)
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(input, "\r\n", "\n"), "\n") {
+		if strings.HasPrefix(line, "```") {
+			inCodeBlock = !inCodeBlock
+		}
+
+		if !inCodeBlock && strings.HasPrefix(line, "## ") {
+			flush()
+			isPreamble = false
+			heading = strings.TrimSpace(strings.TrimPrefix(line, "## "))
+		}
+
+		current = append(current, line)
+	}
+	flush()
+
+	return page
+}
+
+// Section returns the section with the specified (normalized) name, if it exists
+func (p Page) Section(name string) *Section {
+	for _, section := range p.Sections {
+		if NormalizeHeading(section.Heading) == name {
+			return &section
+		}
+	}
+
+	return nil
+}
+
+// NormalizeHeading returns the name of the section for the specified heading - since
+// some pages use `Argument Reference` or `Attribute Reference` instead
+func NormalizeHeading(heading string) string {
+	switch {
+	case strings.HasPrefix(heading, "Argument"):
+		return SectionArguments
+	case strings.HasPrefix(heading, "Attribute"):
+		return SectionAttributes
+	}
+
+	return heading
+}
+
+// Description returns the description of the Data Source/Resource defined in the Front Matter
+func (p Page) Description() string {
+	lines := strings.Split(p.Preamble, "\n")
+	if len(lines) == 0 || lines[0] != "---" {
+		return ""
+	}
+
+	description := ""
+	inDescription := false
+	for _, line := range lines[1:] {
+		if line == "---" {
+			break
+		}
+
+		if inDescription && strings.HasPrefix(line, "  ") {
+			description = strings.TrimSpace(description + " " + strings.TrimSpace(line))
+			continue
+		}
+		inDescription = strings.HasPrefix(line, "description:")
+	}
+
+	return description
+}
+
+// Fields returns the fields documented within the specified section, keyed by the name of the block
+func (p Page) Fields(sectionName string) map[string]Block {
+	blocks := map[string]Block{
+		"": {
+			Fields: make(map[string]Field),
+		},
+	}
+
+	section := p.Section(sectionName)
+	if section == nil {
+		return blocks
+	}
+
+	blockNames := []string{""}
+	var field *Field
+	notes := make([]string, 0)
+
+	// descriptions can wrap onto the following lines, up until a blank line
+	isContinuation := false
+	flush := func() {
+		if field == nil {
+			return
+		}
+
+		field.Notes = trimBlankLines(notes)
+		for _, blockName := range blockNames {
+			blocks[blockName].Fields[field.Name] = *field
+		}
+		field = nil
+		notes = make([]string, 0)
+	}
+
+	for _, line := range strings.Split(section.Raw, "\n")[1:] {
+		if match := blockHeaderRegex.FindStringSubmatch(line); match != nil {
+			flush()
+			blockNames = make([]string, 0)
+			for _, nameMatch := range blockNameRegex.FindAllStringSubmatch(match[1], -1) {
+				blockName := nameMatch[1]
+				blockNames = append(blockNames, blockName)
+				if _, exists := blocks[blockName]; !exists {
+					blocks[blockName] = Block{
+						Name:   blockName,
+						Fields: make(map[string]Field),
+						Order:  len(blocks),
+					}
+				}
+			}
+			continue
+		}
+
+		if match := fieldRegex.FindStringSubmatch(line); match != nil {
+			flush()
+
+			description := match[2]
+			status := ""
+			if statusMatch := statusRegex.FindStringSubmatch(description); statusMatch != nil {
+				status = statusMatch[1]
+				description = strings.TrimPrefix(description, statusMatch[0])
+			}
+
+			isContinuation = true
+			field = &Field{
+				Name:        match[1],
+				Order:       len(blocks[blockNames[0]].Fields),
+				Status:      status,
+				Description: strings.TrimSpace(description),
+			}
+			continue
+		}
+
+		if strings.TrimSpace(line) == "---" {
+			flush()
+			continue
+		}
+
+		if strings.TrimSpace(line) == "" {
+			isContinuation = false
+		}
+
+		if field != nil && isContinuation && !strings.HasPrefix(strings.TrimSpace(line), "*") {
+			field.Description = strings.TrimSpace(fmt.Sprintf("%s %s", field.Description, strings.TrimSpace(line)))
+			continue
+		}
+
+		if field != nil {
+			notes = append(notes, line)
+		}
+	}
+	flush()
+
+	return blocks
+}
+
+// Timeouts returns the default timeouts documented within the Timeouts section, keyed by the operation
+func (p Page) Timeouts() map[string]string {
+	output := make(map[string]string)
+
+	section := p.Section(SectionTimeouts)
+	if section == nil {
+		return output
+	}
+
+	for _, line := range strings.Split(section.Raw, "\n") {
+		if match := timeoutRegex.FindStringSubmatch(line); match != nil {
+			output[match[1]] = match[2]
+		}
+	}
+
+	return output
+}
+
+// ImportResourceId returns the example Resource ID documented within the Import section, if any
+func (p Page) ImportResourceId() string {
+	if section := p.Section(SectionImport); section != nil {
+		if match := importRegex.FindStringSubmatch(section.Raw); match != nil {
+			return strings.TrimSpace(match[1])
+		}
+	}
+
+	return ""
+}
+
+// BrandName returns the brand name used within the documentation (e.g. `Resource Group`)
+func (p Page) BrandName() string {
+	contents := p.Preamble
+	for _, section := range p.Sections {
+		contents += "\n" + section.Raw
+	}
+
+	for _, regex := range brandNameRegexes {
+		for _, match := range regex.FindAllStringSubmatch(contents, -1) {
+			// some pages refer to a new `resource` rather than the brand name
+			if brandName := strings.TrimSpace(match[len(match)-1]); !strings.EqualFold(brandName, "resource") {
+				return brandName
+			}
+		}
+	}
+
+	return ""
+}
+
+// trimBlankLines joins the specified lines, omitting any leading or trailing blank lines - but retaining
+// the indentation of the remaining lines, which is significant for nested lists
+func trimBlankLines(lines []string) string {
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return strings.Join(lines, "\n")
+}
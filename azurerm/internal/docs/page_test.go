@@ -0,0 +1,64 @@
+package docs
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDescription(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "---\nlayout: \"azurerm\"\ndescription: |-\n  Manages an Example.\n---\n\n# azurerm_example\n",
+			expected: "Manages an Example.",
+		},
+		{
+			input:    "---\ndescription: |-\n  Manages an Example\n  which wraps.\nsubcategory: \"Example\"\n---\n",
+			expected: "Manages an Example which wraps.",
+		},
+		{
+			input:    "# azurerm_example\n\ndescription: |-\n  Not Front Matter.\n",
+			expected: "",
+		},
+	}
+
+	for _, v := range testData {
+		if actual := ParsePage(v.input).Description(); actual != v.expected {
+			t.Fatalf("Expected the description to be %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestFieldsMultipleBlocks(t *testing.T) {
+	input := strings.ReplaceAll(`## Arguments Reference
+
+* 'name' - (Required) The name.
+
+---
+
+A 'first' and 'second' block supports the following:
+
+* 'value' - (Optional) The value.
+
+---
+
+'third' supports the following:
+
+* 'other' - (Required) Another value.
+`, "'", "`")
+
+	blocks := ParsePage(input).Fields(SectionArguments)
+	for _, blockName := range []string{"first", "second"} {
+		if _, ok := blocks[blockName].Fields["value"]; !ok {
+			t.Fatalf("Expected `value` to be documented in the %q block", blockName)
+		}
+	}
+	if v := blocks["third"].Fields["other"]; v.Status != "Required" {
+		t.Fatalf("Expected `other` to be documented as Required in the `third` block but got %q", v.Status)
+	}
+	if _, ok := blocks[""].Fields["value"]; ok {
+		t.Fatalf("Expected `value` not to be documented at the top-level")
+	}
+}
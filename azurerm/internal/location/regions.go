@@ -0,0 +1,203 @@
+package location
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Region is an Azure Region (Location) which is available within an Azure Environment
+type Region struct {
+	// Name is the canonical name of this Region (e.g. `westeurope`)
+	Name string
+
+	// DisplayName is the human readable name of this Region (e.g. `West Europe`)
+	DisplayName string
+
+	// PairedRegion is the canonical name of the Region which this Region is paired with
+	// for Business Continuity and Disaster Recovery (BCDR) - this can be empty
+	PairedRegion string
+
+	// SupportsAvailabilityZones specifies whether this Region supports Availability Zones
+	SupportsAvailabilityZones bool
+}
+
+// regions is the built-in catalogue of the Regions available within each Azure Environment (keyed by the
+// Environment Name), which allows the Regions to be validated and normalized without network access.
+//
+// NOTE: this is best-effort and needs to be updated when new Regions become available, as such the list of
+// Supported Locations retrieved from the Azure MetaData Service takes precedence during validation.
+var regions = map[string][]Region{
+	azure.ChinaCloud.Name: {
+		{Name: "chinaeast", DisplayName: "China East", PairedRegion: "chinanorth"},
+		{Name: "chinaeast2", DisplayName: "China East 2", PairedRegion: "chinanorth2"},
+		{Name: "chinaeast3", DisplayName: "China East 3", PairedRegion: "chinanorth3"},
+		{Name: "chinanorth", DisplayName: "China North", PairedRegion: "chinaeast"},
+		{Name: "chinanorth2", DisplayName: "China North 2", PairedRegion: "chinaeast2"},
+		{Name: "chinanorth3", DisplayName: "China North 3", PairedRegion: "chinaeast3", SupportsAvailabilityZones: true},
+	},
+	azure.GermanCloud.Name: {
+		{Name: "germanycentral", DisplayName: "Germany Central", PairedRegion: "germanynortheast"},
+		{Name: "germanynortheast", DisplayName: "Germany Northeast", PairedRegion: "germanycentral"},
+	},
+	azure.PublicCloud.Name: {
+		{Name: "australiacentral", DisplayName: "Australia Central", PairedRegion: "australiacentral2"},
+		{Name: "australiacentral2", DisplayName: "Australia Central 2", PairedRegion: "australiacentral"},
+		{Name: "australiaeast", DisplayName: "Australia East", PairedRegion: "australiasoutheast", SupportsAvailabilityZones: true},
+		{Name: "australiasoutheast", DisplayName: "Australia Southeast", PairedRegion: "australiaeast"},
+		{Name: "brazilsouth", DisplayName: "Brazil South", PairedRegion: "southcentralus", SupportsAvailabilityZones: true},
+		{Name: "brazilsoutheast", DisplayName: "Brazil Southeast", PairedRegion: "brazilsouth"},
+		{Name: "canadacentral", DisplayName: "Canada Central", PairedRegion: "canadaeast", SupportsAvailabilityZones: true},
+		{Name: "canadaeast", DisplayName: "Canada East", PairedRegion: "canadacentral"},
+		{Name: "centralindia", DisplayName: "Central India", PairedRegion: "southindia", SupportsAvailabilityZones: true},
+		{Name: "centralus", DisplayName: "Central US", PairedRegion: "eastus2", SupportsAvailabilityZones: true},
+		{Name: "eastasia", DisplayName: "East Asia", PairedRegion: "southeastasia", SupportsAvailabilityZones: true},
+		{Name: "eastus", DisplayName: "East US", PairedRegion: "westus", SupportsAvailabilityZones: true},
+		{Name: "eastus2", DisplayName: "East US 2", PairedRegion: "centralus", SupportsAvailabilityZones: true},
+		{Name: "francecentral", DisplayName: "France Central", PairedRegion: "francesouth", SupportsAvailabilityZones: true},
+		{Name: "francesouth", DisplayName: "France South", PairedRegion: "francecentral"},
+		{Name: "germanynorth", DisplayName: "Germany North", PairedRegion: "germanywestcentral"},
+		{Name: "germanywestcentral", DisplayName: "Germany West Central", PairedRegion: "germanynorth", SupportsAvailabilityZones: true},
+		{Name: "japaneast", DisplayName: "Japan East", PairedRegion: "japanwest", SupportsAvailabilityZones: true},
+		{Name: "japanwest", DisplayName: "Japan West", PairedRegion: "japaneast"},
+		{Name: "jioindiacentral", DisplayName: "Jio India Central", PairedRegion: "jioindiawest"},
+		{Name: "jioindiawest", DisplayName: "Jio India West", PairedRegion: "jioindiacentral"},
+		{Name: "koreacentral", DisplayName: "Korea Central", PairedRegion: "koreasouth", SupportsAvailabilityZones: true},
+		{Name: "koreasouth", DisplayName: "Korea South", PairedRegion: "koreacentral"},
+		{Name: "northcentralus", DisplayName: "North Central US", PairedRegion: "southcentralus"},
+		{Name: "northeurope", DisplayName: "North Europe", PairedRegion: "westeurope", SupportsAvailabilityZones: true},
+		{Name: "norwayeast", DisplayName: "Norway East", PairedRegion: "norwaywest", SupportsAvailabilityZones: true},
+		{Name: "norwaywest", DisplayName: "Norway West", PairedRegion: "norwayeast"},
+		{Name: "southafricanorth", DisplayName: "South Africa North", PairedRegion: "southafricawest", SupportsAvailabilityZones: true},
+		{Name: "southafricawest", DisplayName: "South Africa West", PairedRegion: "southafricanorth"},
+		{Name: "southcentralus", DisplayName: "South Central US", PairedRegion: "northcentralus", SupportsAvailabilityZones: true},
+		{Name: "southeastasia", DisplayName: "Southeast Asia", PairedRegion: "eastasia", SupportsAvailabilityZones: true},
+		{Name: "southindia", DisplayName: "South India", PairedRegion: "centralindia"},
+		{Name: "swedencentral", DisplayName: "Sweden Central", PairedRegion: "swedensouth", SupportsAvailabilityZones: true},
+		{Name: "swedensouth", DisplayName: "Sweden South", PairedRegion: "swedencentral"},
+		{Name: "switzerlandnorth", DisplayName: "Switzerland North", PairedRegion: "switzerlandwest", SupportsAvailabilityZones: true},
+		{Name: "switzerlandwest", DisplayName: "Switzerland West", PairedRegion: "switzerlandnorth"},
+		{Name: "uaecentral", DisplayName: "UAE Central", PairedRegion: "uaenorth"},
+		{Name: "uaenorth", DisplayName: "UAE North", PairedRegion: "uaecentral"},
+		{Name: "uksouth", DisplayName: "UK South", PairedRegion: "ukwest", SupportsAvailabilityZones: true},
+		{Name: "ukwest", DisplayName: "UK West", PairedRegion: "uksouth"},
+		{Name: "westcentralus", DisplayName: "West Central US", PairedRegion: "westus2"},
+		{Name: "westeurope", DisplayName: "West Europe", PairedRegion: "northeurope", SupportsAvailabilityZones: true},
+		{Name: "westindia", DisplayName: "West India", PairedRegion: "southindia"},
+		{Name: "westus", DisplayName: "West US", PairedRegion: "eastus"},
+		{Name: "westus2", DisplayName: "West US 2", PairedRegion: "westcentralus", SupportsAvailabilityZones: true},
+		{Name: "westus3", DisplayName: "West US 3", PairedRegion: "eastus", SupportsAvailabilityZones: true},
+	},
+	azure.USGovernmentCloud.Name: {
+		{Name: "usdodcentral", DisplayName: "US DoD Central", PairedRegion: "usdodeast"},
+		{Name: "usdodeast", DisplayName: "US DoD East", PairedRegion: "usdodcentral"},
+		{Name: "usgovarizona", DisplayName: "USGov Arizona", PairedRegion: "usgovtexas"},
+		{Name: "usgoviowa", DisplayName: "USGov Iowa", PairedRegion: "usgovvirginia"},
+		{Name: "usgovtexas", DisplayName: "USGov Texas", PairedRegion: "usgovarizona"},
+		{Name: "usgovvirginia", DisplayName: "USGov Virginia", PairedRegion: "usgovtexas", SupportsAvailabilityZones: true},
+	},
+}
+
+// environmentName is the name of the Azure Environment being used, which can be (validly) empty
+// when the Provider hasn't been configured (e.g. during `terraform validate`)
+var environmentName string
+
+// SetEnvironment sets the name of the Azure Environment being used (e.g. `AzurePublicCloud`), such that
+// only the Regions available within this Azure Environment are used during validation
+func SetEnvironment(name string) {
+	environmentName = name
+}
+
+// Regions returns the Regions available within the specified Azure Environment, which is nil when
+// the Azure Environment isn't known (for example Azure Stack)
+func Regions(environment string) []Region {
+	for name, values := range regions {
+		if strings.EqualFold(name, environment) {
+			return values
+		}
+	}
+
+	return nil
+}
+
+// FindRegion returns the Region within the specified Azure Environment which matches either the canonical
+// name (e.g. `westeurope`) or the display name (e.g. `West Europe`) - when the Azure Environment is empty
+// the Regions available within all of the Azure Environments are searched
+func FindRegion(environment string, input string) (*Region, bool) {
+	for _, region := range availableRegions(environment) {
+		// the Display Name of each Region normalizes to the canonical name
+		if region.Name == Normalize(input) {
+			return &region, true
+		}
+	}
+
+	return nil, false
+}
+
+// availableRegions returns the Regions available within the specified Azure Environment - or all of the
+// Regions when the Azure Environment is empty
+func availableRegions(environment string) []Region {
+	if environment != "" {
+		return Regions(environment)
+	}
+
+	environments := make([]string, 0)
+	for name := range regions {
+		environments = append(environments, name)
+	}
+	sort.Strings(environments)
+
+	out := make([]Region, 0)
+	for _, name := range environments {
+		out = append(out, regions[name]...)
+	}
+	return out
+}
+
+// closestRegionName returns the canonical name of the Region within the specified Azure Environment
+// which most closely matches the input, to suggest a fix for typo's - or an empty string when none are close
+func closestRegionName(environment string, input string) string {
+	closest := ""
+	closestDistance := 3
+	for _, region := range availableRegions(environment) {
+		if distance := levenshteinDistance(input, region.Name); distance < closestDistance {
+			closest = region.Name
+			closestDistance = distance
+		}
+	}
+	return closest
+}
+
+func levenshteinDistance(first string, second string) int {
+	previous := make([]int, len(second)+1)
+	for i := range previous {
+		previous[i] = i
+	}
+
+	for i := 1; i <= len(first); i++ {
+		current := make([]int, len(second)+1)
+		current[0] = i
+		for j := 1; j <= len(second); j++ {
+			cost := 1
+			if first[i-1] == second[j-1] {
+				cost = 0
+			}
+
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+
+	return previous[len(second)]
+}
+
+func minInt(values ...int) int {
+	out := values[0]
+	for _, v := range values[1:] {
+		if v < out {
+			out = v
+		}
+	}
+	return out
+}
@@ -0,0 +1,113 @@
+package location
+
+import (
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestRegionsCatalogue(t *testing.T) {
+	for environment, values := range regions {
+		names := make(map[string]struct{})
+		for _, region := range values {
+			if _, exists := names[region.Name]; exists {
+				t.Fatalf("Region %q is defined multiple times for %q", region.Name, environment)
+			}
+			names[region.Name] = struct{}{}
+
+			if actual := Normalize(region.DisplayName); actual != region.Name {
+				t.Fatalf("Expected the Display Name %q to normalize to %q but got %q", region.DisplayName, region.Name, actual)
+			}
+		}
+
+		for _, region := range values {
+			if region.PairedRegion == "" {
+				continue
+			}
+
+			if _, exists := names[region.PairedRegion]; !exists {
+				t.Fatalf("Region %q is paired with %q which isn't defined for %q", region.Name, region.PairedRegion, environment)
+			}
+		}
+	}
+}
+
+func TestFindRegion(t *testing.T) {
+	testData := []struct {
+		Environment string
+		Input       string
+		Expected    string
+	}{
+		{
+			Environment: azure.PublicCloud.Name,
+			Input:       "westeurope",
+			Expected:    "westeurope",
+		},
+		{
+			Environment: azure.PublicCloud.Name,
+			Input:       "West Europe",
+			Expected:    "westeurope",
+		},
+		{
+			Environment: azure.PublicCloud.Name,
+			Input:       "westeurpoe",
+		},
+		{
+			Environment: azure.PublicCloud.Name,
+			Input:       "chinanorth",
+		},
+		{
+			Environment: azure.ChinaCloud.Name,
+			Input:       "China North",
+			Expected:    "chinanorth",
+		},
+		{
+			Environment: azure.USGovernmentCloud.Name,
+			Input:       "USGov Virginia",
+			Expected:    "usgovvirginia",
+		},
+		{
+			Environment: "",
+			Input:       "chinanorth",
+			Expected:    "chinanorth",
+		},
+		{
+			Environment: "AzureStackCloud",
+			Input:       "westeurope",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q in %q", v.Input, v.Environment)
+
+		actual, found := FindRegion(v.Environment, v.Input)
+		if v.Expected == "" {
+			if found {
+				t.Fatalf("Expected no Region to be found but got %q", actual.Name)
+			}
+			continue
+		}
+
+		if !found {
+			t.Fatalf("Expected the Region %q to be found but it wasn't", v.Expected)
+		}
+		if actual.Name != v.Expected {
+			t.Fatalf("Expected the Region %q but got %q", v.Expected, actual.Name)
+		}
+	}
+}
+
+func TestClosestRegionName(t *testing.T) {
+	testData := map[string]string{
+		"westeurpoe":    "westeurope",
+		"estus2":        "eastus2",
+		"uksuoth":       "uksouth",
+		"somewhereelse": "",
+	}
+
+	for input, expected := range testData {
+		if actual := closestRegionName(azure.PublicCloud.Name, input); actual != expected {
+			t.Fatalf("Expected the closest Region to %q to be %q but got %q", input, expected, actual)
+		}
+	}
+}
@@ -21,3 +21,14 @@ func CacheSupportedLocations(ctx context.Context, env *azure.Environment) {
 
 	supportedLocations = locs.Locations
 }
+
+// CachedSupportedLocations returns the cached list of supported locations, which can be (validly) nil
+func CachedSupportedLocations() *[]string {
+	return supportedLocations
+}
+
+// SetSupportedLocations caches the specified supported locations, for use in enhanced validation
+// this allows the supported locations to be loaded from elsewhere, for example from an on-disk cache
+func SetSupportedLocations(locations []string) {
+	supportedLocations = &locations
+}
@@ -15,15 +15,72 @@ var enhancedEnabled = features.EnhancedValidationEnabled()
 // against the list of Locations supported by this Azure Location.
 //
 // NOTE: this is best-effort - if the users offline, or the API doesn't return it we'll
-// fall back to the original approach
+// fall back to validating the location against the built-in catalogue of Regions
 func EnhancedValidate(i interface{}, k string) ([]string, []error) {
-	if !enhancedEnabled || supportedLocations == nil {
+	if !enhancedEnabled {
 		return validation.StringIsNotEmpty(i, k)
 	}
 
+	if supportedLocations == nil {
+		return offlineValidation(i, k)
+	}
+
 	return enhancedValidation(i, k)
 }
 
+// offlineValidation validates the location against the built-in catalogue of Regions for the
+// Azure Environment being used - or for all Azure Environments when this isn't known yet (for
+// example when the Provider hasn't been configured during `terraform validate`)
+func offlineValidation(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	normalizedUserInput := Normalize(v)
+	if normalizedUserInput == "" {
+		return nil, []error{fmt.Errorf("%q must not be empty", k)}
+	}
+
+	// there's no catalogue of Regions for this Azure Environment, so we can't validate this
+	if environmentName != "" && Regions(environmentName) == nil {
+		return nil, nil
+	}
+
+	// Some resources use a location named "global".
+	if normalizedUserInput == "global" {
+		return nil, nil
+	}
+
+	if _, found := FindRegion(environmentName, normalizedUserInput); found {
+		return nil, nil
+	}
+
+	// since the catalogue of Regions is best-effort (and so may not contain newer Regions) we reject Regions which
+	// are available in another Azure Environment, or which look like a typo of a known Region - and otherwise warn
+	if _, found := FindRegion("", normalizedUserInput); found {
+		return nil, []error{
+			fmt.Errorf("%q is not available in the Azure Environment %q", normalizedUserInput, environmentName),
+		}
+	}
+	if closest := closestRegionName(environmentName, normalizedUserInput); closest != "" && !isNumberedVariantOfRegion(normalizedUserInput, closest) {
+		return nil, []error{
+			fmt.Errorf("%q was not found in the built-in list of Azure Locations - did you mean %q?", normalizedUserInput, closest),
+		}
+	}
+
+	return []string{
+		fmt.Sprintf("%q was not found in the built-in list of Azure Locations - this may be a newer Region, otherwise this will fail when the resource is created", normalizedUserInput),
+	}, nil
+}
+
+// isNumberedVariantOfRegion returns whether the input only differs from the Region by a trailing number (for
+// example `chinanorth4` and `chinanorth3`) - which is likely a newer Region, rather than a typo
+func isNumberedVariantOfRegion(input string, region string) bool {
+	trimmedInput := strings.TrimRight(input, "0123456789")
+	return trimmedInput != input && trimmedInput == strings.TrimRight(region, "0123456789")
+}
+
 func enhancedValidation(i interface{}, k string) ([]string, []error) {
 	v, ok := i.(string)
 	if !ok {
@@ -53,10 +110,20 @@ func enhancedValidation(i interface{}, k string) ([]string, []error) {
 
 			locations := strings.Join(*supportedLocations, ",")
 			return nil, []error{
-				fmt.Errorf("%q was not found in the list of supported Azure Locations: %q", normalizedUserInput, locations),
+				fmt.Errorf("%q was not found in the list of supported Azure Locations: %q%s", normalizedUserInput, locations, suggestedRegion(normalizedUserInput)),
 			}
 		}
 	}
 
 	return nil, nil
 }
+
+// suggestedRegion returns a suggestion for the Region the user may have meant when the location
+// contains a typo (e.g. `westeurpoe`), or an empty string when there's no similar Region
+func suggestedRegion(input string) string {
+	if closest := closestRegionName(environmentName, input); closest != "" {
+		return fmt.Sprintf(" - did you mean %q?", closest)
+	}
+
+	return ""
+}
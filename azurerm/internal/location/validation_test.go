@@ -100,6 +100,105 @@ func TestEnhancedValidationEnabledButIsOffline(t *testing.T) {
 	}
 }
 
+func TestEnhancedValidationEnabledButIsOfflineForEnvironment(t *testing.T) {
+	testCases := []struct {
+		environment string
+		input       string
+		valid       bool
+		warning     bool
+	}{
+		{
+			environment: "AzurePublicCloud",
+			input:       "westeurope",
+			valid:       true,
+		},
+		{
+			// typos of a known Region are rejected
+			environment: "AzurePublicCloud",
+			input:       "westeurpoe",
+			valid:       false,
+		},
+		{
+			environment: "AzurePublicCloud",
+			input:       "chinanorth",
+			valid:       false,
+		},
+		{
+			environment: "AzureChinaCloud",
+			input:       "China North",
+			valid:       true,
+		},
+		{
+			environment: "AzureChinaCloud",
+			input:       "chinanorth3",
+			valid:       true,
+		},
+		{
+			environment: "AzureChinaCloud",
+			input:       "China East 3",
+			valid:       true,
+		},
+		{
+			// this only differs from a known Region by the number, so could be a newer Region
+			environment: "AzureChinaCloud",
+			input:       "chinanorth4",
+			valid:       true,
+			warning:     true,
+		},
+		{
+			environment: "",
+			input:       "westeurpoe",
+			valid:       false,
+		},
+		{
+			environment: "AzurePublicCloud",
+			input:       "westus4",
+			valid:       true,
+			warning:     true,
+		},
+		{
+			environment: "AzurePublicCloud",
+			input:       "global",
+			valid:       true,
+		},
+		{
+			// this could be a newer Region which isn't in the catalogue yet
+			environment: "AzurePublicCloud",
+			input:       "polandcentral",
+			valid:       true,
+			warning:     true,
+		},
+		{
+			// there's no catalogue of Regions for this Environment
+			environment: "SomeOtherCloud",
+			input:       "westeurpoe",
+			valid:       true,
+		},
+	}
+	enhancedEnabled = true
+	supportedLocations = nil
+	defer func() {
+		enhancedEnabled = features.EnhancedValidationEnabled()
+		environmentName = ""
+	}()
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q in %q..", testCase.input, testCase.environment)
+		environmentName = testCase.environment
+
+		warnings, errors := EnhancedValidate(testCase.input, "location")
+		valid := len(errors) == 0
+		if testCase.valid != valid {
+			t.Logf("Expected %t but got %t", testCase.valid, valid)
+			t.Fail()
+		}
+		if warning := len(warnings) > 0; testCase.warning != warning {
+			t.Logf("Expected a warning to be %t but got %t: %+v", testCase.warning, warning, warnings)
+			t.Fail()
+		}
+	}
+}
+
 func TestEnhancedValidationEnabled(t *testing.T) {
 	testCases := []struct {
 		availableLocations []string
@@ -0,0 +1,267 @@
+package location
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+// availabilityZones is the list of Availability Zones which are available within a Region which
+// supports Availability Zones
+var availabilityZones = []string{"1", "2", "3"}
+
+// SkuAvailabilityZones is the list of Availability Zones which a SKU (e.g. a Virtual Machine Size)
+// is available in within a Location
+type SkuAvailabilityZones struct {
+	// ResourceType is the type of Resource which this SKU applies to (e.g. `virtualMachines` or `disks`)
+	ResourceType string
+
+	// Name is the name of this SKU (e.g. `Standard_F2`)
+	Name string
+
+	// Zones is the list of Availability Zones this SKU is available in, which is empty when
+	// this SKU isn't available in any Availability Zones in this Location
+	Zones []string
+}
+
+// SkuAvailabilityZonesFunc retrieves the Availability Zones which each SKU is available in within the
+// specified Location, for example from the Resource SKUs API
+type SkuAvailabilityZonesFunc func(ctx context.Context, location string) (*[]SkuAvailabilityZones, error)
+
+var (
+	skuAvailabilityZonesFunc SkuAvailabilityZonesFunc
+
+	// skuAvailabilityZones is a map of the normalized Location to the key for the SKU (see skuKey)
+	// to the Availability Zones which the SKU is available in - where the Location exists in this
+	// map but is nil the SKU information couldn't be retrieved
+	skuAvailabilityZones     = map[string]map[string][]string{}
+	skuAvailabilityZonesLock = &sync.Mutex{}
+
+	// skuAvailabilityZonesLocationLocks ensures that the SKUs within a Location are only retrieved once at a time
+	skuAvailabilityZonesLocationLocks     = map[string]*sync.Mutex{}
+	skuAvailabilityZonesLocationLocksLock = &sync.Mutex{}
+)
+
+// SetSkuAvailabilityZonesFunc sets the function used to retrieve the Availability Zones which each SKU is
+// available in within a Location - which is called (once per Location) when validating Availability Zones.
+// When this isn't set only the built-in catalogue of Regions is used to validate Availability Zones.
+func SetSkuAvailabilityZonesFunc(f SkuAvailabilityZonesFunc) {
+	skuAvailabilityZonesLock.Lock()
+	defer skuAvailabilityZonesLock.Unlock()
+
+	skuAvailabilityZonesFunc = f
+	skuAvailabilityZones = map[string]map[string][]string{}
+}
+
+// ValidateAvailabilityZones validates that the specified Availability Zones are available within the
+// Location - and, when the SKU is specified and the SKU information is available, that the SKU is
+// available in each of these Availability Zones.
+//
+// NOTE: this is best-effort - Locations and SKUs which aren't known are assumed to be valid
+func ValidateAvailabilityZones(ctx context.Context, location string, zones []string, resourceType string, sku string) error {
+	if len(zones) == 0 {
+		return nil
+	}
+
+	normalizedLocation := Normalize(location)
+	skus := cachedSkuAvailabilityZones(ctx, normalizedLocation)
+
+	// whilst the built-in catalogue of Regions is used by default, newer Regions may support
+	// Availability Zones - which the information for the SKUs (when available) accounts for
+	if region, found := FindRegion(environmentName, normalizedLocation); found && !region.SupportsAvailabilityZones && !anySkuAvailableInZones(skus) {
+		return fmt.Errorf("the Location %q doesn't support Availability Zones - remove the Availability Zones (%s) or use a Location which supports Availability Zones", normalizedLocation, strings.Join(zones, ", "))
+	}
+
+	for _, zone := range zones {
+		if !containsZone(availabilityZones, zone) {
+			return fmt.Errorf("%q isn't a valid Availability Zone - possible values are %s", zone, strings.Join(availabilityZones, ", "))
+		}
+	}
+
+	if sku == "" || skus == nil {
+		return nil
+	}
+
+	available, found := skus[skuKey(resourceType, sku)]
+	if !found {
+		return nil
+	}
+
+	if len(available) == 0 {
+		return fmt.Errorf("the SKU %q isn't available in any Availability Zones in the Location %q", sku, normalizedLocation)
+	}
+	for _, zone := range zones {
+		if !containsZone(available, zone) {
+			return fmt.Errorf("the SKU %q isn't available in Availability Zone %q in the Location %q - this SKU is available in the Availability Zones %s", sku, zone, normalizedLocation, strings.Join(available, ", "))
+		}
+	}
+
+	return nil
+}
+
+// ValidateAvailabilityZonesDiff returns a CustomizeDiffFunc which validates that the Availability Zones
+// defined in `zonesKey` (either a single Zone, or a list/set of Zones) are available within the Location
+// defined in `locationKey` - and, when `skuKey` is specified, that the SKU of the `resourceType` (within
+// the Resource SKUs API, e.g. `virtualMachines`) defined in `skuKey` is available within these Zones.
+func ValidateAvailabilityZonesDiff(locationKey string, zonesKey string, resourceType string, skuKey string) pluginsdk.CustomizeDiffFunc {
+	locationFunc := func(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) (*string, error) {
+		if !d.NewValueKnown(locationKey) {
+			return nil, nil
+		}
+
+		location := d.Get(locationKey).(string)
+		return &location, nil
+	}
+
+	return ValidateAvailabilityZonesDiffWithLocationFunc(locationKey, locationFunc, zonesKey, resourceType, skuKey)
+}
+
+// LocationFunc returns the Location used by a Resource - or nil when this isn't known yet
+type LocationFunc func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) (*string, error)
+
+// ValidateAvailabilityZonesDiffWithLocationFunc returns a CustomizeDiffFunc which validates the Availability
+// Zones in the same manner as ValidateAvailabilityZonesDiff - for Resources which don't define a Location
+// (for example a Kubernetes Cluster Node Pool, which uses the Location of the Kubernetes Cluster). The Location
+// is retrieved using `locationFunc`, which is only called when either the field defined in `locationSourceKey`
+// (e.g. the ID of the parent Resource), the Availability Zones or the SKU have changed.
+func ValidateAvailabilityZonesDiffWithLocationFunc(locationSourceKey string, locationFunc LocationFunc, zonesKey string, resourceType string, skuKey string) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		// only changes are validated, since Locations may start supporting Availability Zones
+		// after the built-in catalogue of Regions was last updated
+		if !d.HasChange(locationSourceKey) && !d.HasChange(zonesKey) && (skuKey == "" || !d.HasChange(skuKey)) {
+			return nil
+		}
+
+		// these can't be validated until the values are known
+		if !d.NewValueKnown(zonesKey) {
+			return nil
+		}
+
+		zones := make([]string, 0)
+		switch v := d.Get(zonesKey).(type) {
+		case string:
+			if v != "" {
+				zones = append(zones, v)
+			}
+		case []interface{}:
+			for _, item := range v {
+				zones = append(zones, item.(string))
+			}
+		case *pluginsdk.Set:
+			for _, item := range v.List() {
+				zones = append(zones, item.(string))
+			}
+		}
+		if len(zones) == 0 {
+			return nil
+		}
+
+		location, err := locationFunc(ctx, d, meta)
+		if err != nil {
+			return fmt.Errorf("determining the Location to validate the Availability Zones defined in `%s`: %+v", zonesKey, err)
+		}
+		if location == nil || *location == "" {
+			return nil
+		}
+
+		sku := ""
+		if skuKey != "" && d.NewValueKnown(skuKey) {
+			sku = d.Get(skuKey).(string)
+		}
+
+		if err := ValidateAvailabilityZones(ctx, *location, zones, resourceType, sku); err != nil {
+			return fmt.Errorf("validating the Availability Zones defined in `%s`: %+v", zonesKey, err)
+		}
+
+		return nil
+	}
+}
+
+// cachedSkuAvailabilityZones returns the Availability Zones for each SKU within the Location, retrieving
+// these (once) when a SkuAvailabilityZonesFunc has been set - which returns nil when unavailable
+func cachedSkuAvailabilityZones(ctx context.Context, normalizedLocation string) map[string][]string {
+	skuAvailabilityZonesLock.Lock()
+	f := skuAvailabilityZonesFunc
+	existing, ok := skuAvailabilityZones[normalizedLocation]
+	skuAvailabilityZonesLock.Unlock()
+
+	if f == nil {
+		return nil
+	}
+	if ok {
+		return existing
+	}
+
+	// the SKUs are retrieved whilst holding a lock for this Location (rather than the cache) so that
+	// validating the Availability Zones within other Locations isn't blocked by this
+	locationLock := lockForLocation(normalizedLocation)
+	locationLock.Lock()
+	defer locationLock.Unlock()
+
+	skuAvailabilityZonesLock.Lock()
+	existing, ok = skuAvailabilityZones[normalizedLocation]
+	skuAvailabilityZonesLock.Unlock()
+	if ok {
+		return existing
+	}
+
+	var out map[string][]string
+	skus, err := f(ctx, normalizedLocation)
+	if err != nil || skus == nil {
+		// we only try this once per Location, since this is best-effort
+		log.Printf("[DEBUG] Unable to retrieve the Availability Zones for the SKUs in %q - only the Location will be validated: %+v", normalizedLocation, err)
+	} else {
+		out = make(map[string][]string)
+		for _, v := range *skus {
+			zones := append([]string{}, v.Zones...)
+			sort.Strings(zones)
+			out[skuKey(v.ResourceType, v.Name)] = zones
+		}
+	}
+
+	skuAvailabilityZonesLock.Lock()
+	skuAvailabilityZones[normalizedLocation] = out
+	skuAvailabilityZonesLock.Unlock()
+
+	return out
+}
+
+func lockForLocation(normalizedLocation string) *sync.Mutex {
+	skuAvailabilityZonesLocationLocksLock.Lock()
+	defer skuAvailabilityZonesLocationLocksLock.Unlock()
+
+	if skuAvailabilityZonesLocationLocks[normalizedLocation] == nil {
+		skuAvailabilityZonesLocationLocks[normalizedLocation] = &sync.Mutex{}
+	}
+
+	return skuAvailabilityZonesLocationLocks[normalizedLocation]
+}
+
+func anySkuAvailableInZones(skus map[string][]string) bool {
+	for _, zones := range skus {
+		if len(zones) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsZone(zones []string, zone string) bool {
+	for _, v := range zones {
+		if v == zone {
+			return true
+		}
+	}
+
+	return false
+}
+
+func skuKey(resourceType string, name string) string {
+	return strings.ToLower(fmt.Sprintf("%s/%s", resourceType, name))
+}
@@ -0,0 +1,266 @@
+package location
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+func TestValidateAvailabilityZones(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Location string
+		Zones    []string
+		Sku      string
+		Skus     *[]SkuAvailabilityZones
+		Error    bool
+	}{
+		{
+			Name:     "no zones",
+			Location: "westus",
+		},
+		{
+			Name:     "location supporting zones",
+			Location: "West Europe",
+			Zones:    []string{"1", "2"},
+		},
+		{
+			Name:     "location not supporting zones",
+			Location: "westus",
+			Zones:    []string{"1"},
+			Error:    true,
+		},
+		{
+			Name:     "unknown location",
+			Location: "somenewregion",
+			Zones:    []string{"1"},
+		},
+		{
+			Name:     "invalid zone",
+			Location: "westeurope",
+			Zones:    []string{"4"},
+			Error:    true,
+		},
+		{
+			Name:     "sku available in the zones",
+			Location: "westeurope",
+			Zones:    []string{"1", "3"},
+			Sku:      "Standard_F2",
+			Skus: &[]SkuAvailabilityZones{
+				{ResourceType: "virtualMachines", Name: "Standard_F2", Zones: []string{"3", "2", "1"}},
+			},
+		},
+		{
+			Name:     "sku not available in a zone",
+			Location: "westeurope",
+			Zones:    []string{"1", "3"},
+			Sku:      "standard_f2",
+			Skus: &[]SkuAvailabilityZones{
+				{ResourceType: "virtualMachines", Name: "Standard_F2", Zones: []string{"1", "2"}},
+			},
+			Error: true,
+		},
+		{
+			Name:     "sku not available in any zones",
+			Location: "westeurope",
+			Zones:    []string{"1"},
+			Sku:      "Standard_F2",
+			Skus: &[]SkuAvailabilityZones{
+				{ResourceType: "virtualMachines", Name: "Standard_F2"},
+			},
+			Error: true,
+		},
+		{
+			Name:     "unknown sku",
+			Location: "westeurope",
+			Zones:    []string{"1"},
+			Sku:      "Standard_Unknown",
+			Skus: &[]SkuAvailabilityZones{
+				{ResourceType: "virtualMachines", Name: "Standard_F2"},
+			},
+		},
+		{
+			Name:     "location which recently started supporting zones",
+			Location: "westus",
+			Zones:    []string{"1"},
+			Skus: &[]SkuAvailabilityZones{
+				{ResourceType: "virtualMachines", Name: "Standard_F2", Zones: []string{"1"}},
+			},
+		},
+	}
+
+	SetEnvironment(azure.PublicCloud.Name)
+	defer func() {
+		SetEnvironment("")
+		SetSkuAvailabilityZonesFunc(nil)
+	}()
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		SetSkuAvailabilityZonesFunc(nil)
+		if v.Skus != nil {
+			skus := v.Skus
+			SetSkuAvailabilityZonesFunc(func(ctx context.Context, location string) (*[]SkuAvailabilityZones, error) {
+				return skus, nil
+			})
+		}
+
+		err := ValidateAvailabilityZones(context.TODO(), v.Location, v.Zones, "virtualMachines", v.Sku)
+		if err != nil && !v.Error {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if err == nil && v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
+
+func TestValidateAvailabilityZonesRetrievesSkusOnce(t *testing.T) {
+	calls := 0
+	SetSkuAvailabilityZonesFunc(func(ctx context.Context, location string) (*[]SkuAvailabilityZones, error) {
+		calls++
+		return nil, fmt.Errorf("unavailable")
+	})
+	defer SetSkuAvailabilityZonesFunc(nil)
+
+	for i := 0; i < 3; i++ {
+		if err := ValidateAvailabilityZones(context.TODO(), "westeurope", []string{"1"}, "virtualMachines", "Standard_F2"); err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+
+	if calls != 1 {
+		t.Fatalf("Expected the SKUs to be retrieved once but got %d", calls)
+	}
+}
+
+func TestValidateAvailabilityZonesRetrievesSkusPerLocationConcurrently(t *testing.T) {
+	westEuropeStarted := make(chan struct{})
+	westEuropeReleased := make(chan struct{})
+	calls := map[string]int{}
+	callsLock := &sync.Mutex{}
+	SetSkuAvailabilityZonesFunc(func(ctx context.Context, location string) (*[]SkuAvailabilityZones, error) {
+		callsLock.Lock()
+		calls[location]++
+		callsLock.Unlock()
+
+		if location == "westeurope" {
+			close(westEuropeStarted)
+			<-westEuropeReleased
+		}
+
+		return &[]SkuAvailabilityZones{}, nil
+	})
+	defer SetSkuAvailabilityZonesFunc(nil)
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := ValidateAvailabilityZones(context.TODO(), "westeurope", []string{"1"}, "virtualMachines", "Standard_F2"); err != nil {
+				t.Errorf("Expected no error but got: %+v", err)
+			}
+		}()
+	}
+	<-westEuropeStarted
+
+	// retrieving the SKUs in another Location shouldn't wait for those in West Europe
+	done := make(chan struct{})
+	go func() {
+		if err := ValidateAvailabilityZones(context.TODO(), "northeurope", []string{"1"}, "virtualMachines", "Standard_F2"); err != nil {
+			t.Errorf("Expected no error but got: %+v", err)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected the SKUs in North Europe to be retrieved whilst those in West Europe were being retrieved")
+	}
+
+	close(westEuropeReleased)
+	wg.Wait()
+
+	if calls["westeurope"] != 1 || calls["northeurope"] != 1 {
+		t.Fatalf("Expected the SKUs to be retrieved once per Location but got %+v", calls)
+	}
+}
+
+func TestValidateAvailabilityZonesDiffWithLocationFunc(t *testing.T) {
+	SetSkuAvailabilityZonesFunc(nil)
+
+	testData := []struct {
+		Name     string
+		Location string
+		Zones    []interface{}
+		Called   bool
+		Error    bool
+	}{
+		{
+			Name:     "no zones",
+			Location: "westus",
+		},
+		{
+			Name:     "location supporting zones",
+			Location: "westeurope",
+			Zones:    []interface{}{"1", "2"},
+			Called:   true,
+		},
+		{
+			Name:     "location not supporting zones",
+			Location: "westus",
+			Zones:    []interface{}{"1"},
+			Called:   true,
+			Error:    true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		called := false
+		locationFunc := func(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) (*string, error) {
+			called = true
+			return &v.Location, nil
+		}
+		resource := &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"parent_id": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+				},
+				"zones": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+			CustomizeDiff: pluginsdk.CustomizeDiffShim(ValidateAvailabilityZonesDiffWithLocationFunc("parent_id", locationFunc, "zones", "virtualMachines", "")),
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"parent_id": "example",
+			"zones":     v.Zones,
+		})
+
+		_, err := resource.Diff(context.TODO(), nil, config, nil)
+		if v.Error && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Called != called {
+			t.Fatalf("expected the Location Func to be called to be %t but got %t", v.Called, called)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package locks
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// IDKey returns the key used to lock the specified Resource ID, for use with Acquire
+func IDKey(id string) string {
+	return id
+}
+
+// NameKey returns the key used to lock the specified name of the specified Resource Type,
+// for use with Acquire. This handles the case of using the same name for different kinds of resources
+func NameKey(name string, resourceType string) string {
+	return resourceType + "." + name
+}
+
+// NameKeys returns the keys used to lock each of the specified names of the specified
+// Resource Type, for use with Acquire
+func NameKeys(names []string, resourceType string) []string {
+	out := make([]string, 0, len(names))
+	for _, name := range names {
+		out = append(out, NameKey(name, resourceType))
+	}
+	return out
+}
+
+// resourceTypeLockOrder is the order in which the locks for these Resource Types are acquired, which follows
+// the hierarchy of these resources - since resources which lock these individually (for example the Subnet
+// associations) lock the Network Security Group/Route Table/NAT Gateway, then the Virtual Network and then
+// the Subnet. Locks for any other Resource Types (and Resource IDs) are acquired before these.
+var resourceTypeLockOrder = map[string]int{
+	"azurerm_nat_gateway":            1,
+	"azurerm_network_security_group": 1,
+	"azurerm_route_table":            1,
+	"azurerm_virtual_network":        2,
+	"azurerm_subnet":                 3,
+}
+
+// lockOrderForKey returns the position of the specified key within the resourceTypeLockOrder
+func lockOrderForKey(key string) int {
+	resourceType := strings.SplitN(key, ".", 2)[0]
+	return resourceTypeLockOrder[resourceType]
+}
+
+// sortKeys sorts the keys into the canonical order in which these are locked - which is the order of
+// the Resource Type within the resourceTypeLockOrder, and then alphabetically
+func sortKeys(keys []string) {
+	sort.SliceStable(keys, func(i, j int) bool {
+		if orderI, orderJ := lockOrderForKey(keys[i]), lockOrderForKey(keys[j]); orderI != orderJ {
+			return orderI < orderJ
+		}
+
+		return keys[i] < keys[j]
+	})
+}
+
+// Handle is a reference to a set of locks which were acquired together
+type Handle struct {
+	keys    []string
+	mutexKV *mutexKV
+	once    sync.Once
+}
+
+// Release unlocks each of the locks held by this Handle in the reverse order they were acquired
+// it's safe to call this multiple times, subsequent calls are a no-op
+func (h *Handle) Release() {
+	h.once.Do(func() {
+		for i := len(h.keys) - 1; i >= 0; i-- {
+			h.mutexKV.Unlock(h.keys[i])
+		}
+	})
+}
+
+// Acquire locks each of the specified keys, returning a Handle which must be Released
+// once the operation is complete.
+//
+// To avoid deadlocks between resources locking overlapping keys, the keys are deduplicated
+// and locked in a single canonical order (following the hierarchy of the resources, see resourceTypeLockOrder,
+// and then sorted alphabetically) - as such all of the locks needed for an
+// operation should be acquired in a single call to Acquire, rather than in separate calls.
+//
+// If the Context is cancelled (or it's deadline is exceeded) before all of the locks are
+// acquired, any locks already acquired are released and an error is returned.
+func Acquire(ctx context.Context, keys ...string) (*Handle, error) {
+	return armMutexKV.acquire(ctx, keys)
+}
+
+func (m *mutexKV) acquire(ctx context.Context, keys []string) (*Handle, error) {
+	sortedKeys := removeDuplicatesFromStringArray(keys)
+	sortKeys(sortedKeys)
+
+	handle := &Handle{
+		keys:    make([]string, 0, len(sortedKeys)),
+		mutexKV: m,
+	}
+	for _, key := range sortedKeys {
+		if err := m.LockWithContext(ctx, key); err != nil {
+			heldLocks := m.HeldLocks()
+			log.Printf("[DEBUG] Timed out waiting for lock %q - currently held locks: %s", key, strings.Join(heldLocks, ", "))
+
+			handle.Release()
+			return nil, fmt.Errorf("timed out waiting to acquire the lock %q: %+v", key, err)
+		}
+
+		handle.keys = append(handle.keys, key)
+	}
+
+	log.Printf("[DEBUG] Acquired locks %q", handle.keys)
+	return handle, nil
+}
@@ -0,0 +1,115 @@
+package locks
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAcquireOrdersAndDeduplicatesKeys(t *testing.T) {
+	kv := NewMutexKV()
+	handle, err := kv.acquire(context.TODO(), []string{"vnet.second", "subnet.first", "vnet.second", "nic.third"})
+	if err != nil {
+		t.Fatalf("acquiring: %+v", err)
+	}
+
+	expected := []string{"nic.third", "subnet.first", "vnet.second"}
+	if !reflect.DeepEqual(handle.keys, expected) {
+		t.Fatalf("expected the keys %q but got %q", expected, handle.keys)
+	}
+	if held := len(kv.HeldLocks()); held != 3 {
+		t.Fatalf("expected 3 held locks but got %d", held)
+	}
+
+	handle.Release()
+	// releasing multiple times should be a no-op
+	handle.Release()
+
+	if held := len(kv.HeldLocks()); held != 0 {
+		t.Fatalf("expected 0 held locks but got %d", held)
+	}
+}
+
+func TestAcquireOverlappingKeysInDifferentOrders(t *testing.T) {
+	kv := NewMutexKV()
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 100)
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			handle, err := kv.acquire(ctx, []string{"subnet.a", "vnet.b"})
+			if err != nil {
+				errs <- err
+				return
+			}
+			handle.Release()
+		}()
+		go func() {
+			defer wg.Done()
+			handle, err := kv.acquire(ctx, []string{"vnet.b", "subnet.a"})
+			if err != nil {
+				errs <- err
+				return
+			}
+			handle.Release()
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
+
+func TestAcquireTimesOut(t *testing.T) {
+	kv := NewMutexKV()
+	kv.Lock("vnet.b")
+	defer kv.Unlock("vnet.b")
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := kv.acquire(ctx, []string{"subnet.a", "vnet.b"}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	// the lock which was acquired should have been released
+	held := kv.HeldLocks()
+	if len(held) != 1 {
+		t.Fatalf("expected 1 held lock but got %d: %q", len(held), held)
+	}
+}
+
+func TestAcquireOrdersKeysByResourceHierarchy(t *testing.T) {
+	kv := NewMutexKV()
+	keys := []string{
+		NameKey("a", "azurerm_subnet"),
+		NameKey("b", "azurerm_virtual_network"),
+		NameKey("c", "azurerm_network_security_group"),
+		NameKey("d", "azurerm_network_interface"),
+		NameKey("a", "azurerm_virtual_network"),
+	}
+	handle, err := kv.acquire(context.TODO(), keys)
+	if err != nil {
+		t.Fatalf("acquiring: %+v", err)
+	}
+	defer handle.Release()
+
+	expected := []string{
+		"azurerm_network_interface.d",
+		"azurerm_network_security_group.c",
+		"azurerm_virtual_network.a",
+		"azurerm_virtual_network.b",
+		"azurerm_subnet.a",
+	}
+	if !reflect.DeepEqual(handle.keys, expected) {
+		t.Fatalf("expected the keys %q but got %q", expected, handle.keys)
+	}
+}
@@ -1,5 +1,7 @@
 package locks
 
+import "sort"
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = NewMutexKV()
 
@@ -9,12 +11,13 @@ func ByID(id string) {
 
 // handle the case of using the same name for different kinds of resources
 func ByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Lock(updatedName)
+	armMutexKV.Lock(NameKey(name, resourceType))
 }
 
 func MultipleByName(names *[]string, resourceType string) {
 	newSlice := removeDuplicatesFromStringArray(*names)
+	// the names are locked in the same order as Acquire, to avoid deadlocks
+	sort.Strings(newSlice)
 
 	for _, name := range newSlice {
 		ByName(name, resourceType)
@@ -26,8 +29,7 @@ func UnlockByID(id string) {
 }
 
 func UnlockByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Unlock(updatedName)
+	armMutexKV.Unlock(NameKey(name, resourceType))
 }
 
 func UnlockMultipleByName(names *[]string, resourceType string) {
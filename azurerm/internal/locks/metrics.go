@@ -0,0 +1,154 @@
+package locks
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// metricsPathEnvVar is the environment variable used to specify the path which the
+	// summary of the lock contention metrics should be written to when the Provider shuts down
+	metricsPathEnvVar = "ARM_PROVIDER_LOCK_METRICS_PATH"
+
+	// waitThresholdEnvVar is the environment variable used to specify the duration (e.g. `5m`)
+	// after which, if a lock is still being waited on, the held and waited on locks are logged
+	waitThresholdEnvVar = "ARM_PROVIDER_LOCK_WAIT_THRESHOLD"
+)
+
+// keyMetrics are the contention metrics for a single key
+type keyMetrics struct {
+	// Acquisitions is the number of times this lock has been acquired
+	Acquisitions int64
+
+	// MaxWaiters is the highest number of callers waiting on this lock at once
+	MaxWaiters int
+
+	// TotalWait is the total time spent waiting to acquire this lock
+	TotalWait time.Duration
+
+	// MaxWait is the longest time spent waiting to acquire this lock
+	MaxWait time.Duration
+
+	// TotalHold is the total time this lock has been held for
+	TotalHold time.Duration
+
+	// MaxHold is the longest time this lock has been held for
+	MaxHold time.Duration
+}
+
+// Dump returns a description of the locks which are currently held and waited on
+func (m *mutexKV) Dump() string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	keys := make([]string, 0)
+	for key := range m.held {
+		keys = append(keys, key)
+	}
+	for key := range m.waiting {
+		if _, isHeld := m.held[key]; !isHeld {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	lines := []string{
+		fmt.Sprintf("Lock Dump (%d held/waited on):", len(keys)),
+	}
+	for _, key := range keys {
+		line := fmt.Sprintf("  %q:", key)
+		if since, isHeld := m.held[key]; isHeld {
+			line += fmt.Sprintf(" held for %s", time.Since(since).Round(time.Millisecond))
+		} else {
+			line += " not held"
+		}
+
+		if waiters := m.waiting[key]; len(waiters) > 0 {
+			longestWait := time.Duration(0)
+			for _, since := range waiters {
+				if wait := time.Since(since); wait > longestWait {
+					longestWait = wait
+				}
+			}
+			line += fmt.Sprintf(", %d waiting (longest for %s)", len(waiters), longestWait.Round(time.Millisecond))
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Summary returns a summary of the contention metrics for each key, ordered by the total
+// time spent waiting to acquire the lock (e.g. the most contended lock first)
+func (m *mutexKV) Summary() string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	keys := make([]string, 0, len(m.metrics))
+	for key := range m.metrics {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		first := m.metrics[keys[i]]
+		second := m.metrics[keys[j]]
+		if first.TotalWait == second.TotalWait {
+			return keys[i] < keys[j]
+		}
+		return first.TotalWait > second.TotalWait
+	})
+
+	lines := []string{
+		fmt.Sprintf("Lock Contention Summary (%d keys):", len(keys)),
+	}
+	for _, key := range keys {
+		metrics := m.metrics[key]
+		lines = append(lines, fmt.Sprintf("  %q: acquired %d times, max %d waiting, waited %s (max %s), held %s (max %s)",
+			key,
+			metrics.Acquisitions,
+			metrics.MaxWaiters,
+			metrics.TotalWait.Round(time.Millisecond),
+			metrics.MaxWait.Round(time.Millisecond),
+			metrics.TotalHold.Round(time.Millisecond),
+			metrics.MaxHold.Round(time.Millisecond)))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// WriteMetricsSummary writes a summary of the lock contention metrics to the path specified in
+// the `ARM_PROVIDER_LOCK_METRICS_PATH` environment variable, if set - this is intended to be
+// called when the Provider shuts down.
+func WriteMetricsSummary() {
+	path := os.Getenv(metricsPathEnvVar)
+	if path == "" {
+		return
+	}
+
+	summary := armMutexKV.Summary()
+	log.Printf("[DEBUG] %s", summary)
+
+	if err := ioutil.WriteFile(path, []byte(summary+"\n"), 0600); err != nil {
+		log.Printf("[WARN] writing the Lock Contention Summary to %q: %+v", path, err)
+	}
+}
+
+func waitThresholdFromEnvironment() time.Duration {
+	value := os.Getenv(waitThresholdEnvVar)
+	if value == "" {
+		return 0
+	}
+
+	threshold, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("[WARN] parsing %q as a duration for %s: %+v - lock dumps will be disabled", value, waitThresholdEnvVar, err)
+		return 0
+	}
+
+	return threshold
+}
@@ -0,0 +1,100 @@
+package locks
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMetricsRecordWaitAndHoldTimes(t *testing.T) {
+	kv := NewMutexKV()
+	kv.Lock("vnet.a")
+
+	acquired := make(chan struct{})
+	go func() {
+		kv.Lock("vnet.a")
+		close(acquired)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	dump := kv.Dump()
+	if !strings.Contains(dump, `"vnet.a": held for`) || !strings.Contains(dump, "1 waiting") {
+		t.Fatalf("expected the dump to contain the held and waiting lock but got:\n%s", dump)
+	}
+
+	kv.Unlock("vnet.a")
+	<-acquired
+	kv.Unlock("vnet.a")
+
+	metrics := kv.metrics["vnet.a"]
+	if metrics.Acquisitions != 2 {
+		t.Fatalf("expected 2 acquisitions but got %d", metrics.Acquisitions)
+	}
+	if metrics.MaxWaiters != 1 {
+		t.Fatalf("expected a max of 1 waiter but got %d", metrics.MaxWaiters)
+	}
+	if metrics.MaxWait < 50*time.Millisecond {
+		t.Fatalf("expected a max wait of at least 50ms but got %s", metrics.MaxWait)
+	}
+	if metrics.MaxHold < 50*time.Millisecond {
+		t.Fatalf("expected a max hold of at least 50ms but got %s", metrics.MaxHold)
+	}
+	if len(kv.waiting) != 0 || len(kv.held) != 0 {
+		t.Fatalf("expected no held or waiting locks but got %d held and %d waiting", len(kv.held), len(kv.waiting))
+	}
+}
+
+func TestMetricsUncontendedLocksHaveNoWaiters(t *testing.T) {
+	kv := NewMutexKV()
+	for i := 0; i < 3; i++ {
+		kv.Lock("vnet.a")
+		kv.Unlock("vnet.a")
+	}
+
+	metrics := kv.metrics["vnet.a"]
+	if metrics.Acquisitions != 3 {
+		t.Fatalf("expected 3 acquisitions but got %d", metrics.Acquisitions)
+	}
+	if metrics.MaxWaiters != 0 {
+		t.Fatalf("expected a max of 0 waiters but got %d", metrics.MaxWaiters)
+	}
+	if metrics.TotalWait != 0 {
+		t.Fatalf("expected no time spent waiting but got %s", metrics.TotalWait)
+	}
+}
+
+func TestMetricsSummaryOrderedByWaitTime(t *testing.T) {
+	kv := NewMutexKV()
+	kv.metrics["uncontended"] = &keyMetrics{
+		Acquisitions: 10,
+	}
+	kv.metrics["contended"] = &keyMetrics{
+		Acquisitions: 2,
+		TotalWait:    time.Minute,
+	}
+
+	lines := strings.Split(kv.Summary(), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines but got %d: %q", len(lines), lines)
+	}
+	if !strings.HasPrefix(strings.TrimSpace(lines[1]), `"contended"`) {
+		t.Fatalf("expected the most contended key first but got %q", lines[1])
+	}
+}
+
+func TestMetricsTimedOutWaitersAreRemoved(t *testing.T) {
+	kv := NewMutexKV()
+	kv.Lock("vnet.a")
+	defer kv.Unlock("vnet.a")
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	if err := kv.LockWithContext(ctx, "vnet.a"); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	if len(kv.waiting) != 0 {
+		t.Fatalf("expected no waiting locks but got %d", len(kv.waiting))
+	}
+}
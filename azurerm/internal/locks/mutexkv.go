@@ -1,8 +1,12 @@
 package locks
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"sort"
 	"sync"
+	"time"
 )
 
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
@@ -10,39 +14,213 @@ import (
 // keys they must serialize on.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*keyMutex
+
+	// held is a map of the keys which are currently locked, to the time they were locked at
+	held map[string]time.Time
+
+	// waiting is a map of the keys which are currently being waited on, to the time each waiter started waiting
+	waiting map[string]map[uint64]time.Time
+
+	// lastWaiterId is used to uniquely identify each waiter
+	lastWaiterId uint64
+
+	// metrics contains the contention metrics for each key
+	metrics map[string]*keyMetrics
+
+	// waitThreshold is the duration after which, if a lock is still being waited on,
+	// the currently held and waited on locks are logged - a zero value disables this
+	waitThreshold time.Duration
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
+	// a Context which is never done means this can't fail
+	_ = m.LockWithContext(context.Background(), key)
+}
+
+// LockWithContext locks the mutex for the given key, returning an error if the
+// Context is cancelled (or it's deadline is exceeded) before the lock is acquired.
+// Caller is responsible for calling Unlock for the same key if this is successful
+func (m *mutexKV) LockWithContext(ctx context.Context, key string) error {
 	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
+	mutex := m.get(key)
+
+	// when the lock is uncontended it's acquired without waiting, so this caller isn't a waiter
+	if mutex.TryLock() {
+		m.markAsHeld(key, 0)
+		log.Printf("[DEBUG] Locked %q", key)
+		return nil
+	}
+
+	waiterId, waitingSince := m.markAsWaiting(key)
+
+	if m.waitThreshold > 0 {
+		timer := time.AfterFunc(m.waitThreshold, func() {
+			log.Printf("[WARN] Waited over %s to lock %q\n%s", m.waitThreshold, key, m.Dump())
+		})
+		defer timer.Stop()
+	}
+
+	err := mutex.LockWithContext(ctx)
+	m.markAsNoLongerWaiting(key, waiterId)
+	if err != nil {
+		return err
+	}
+
+	m.markAsHeld(key, time.Since(waitingSince))
 	log.Printf("[DEBUG] Locked %q", key)
+	return nil
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
+	m.markAsReleased(key)
 	m.get(key).Unlock()
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
+// HeldLocks returns a description of each lock which is currently held, and for how long
+func (m *mutexKV) HeldLocks() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	out := make([]string, 0, len(m.held))
+	for key, since := range m.held {
+		out = append(out, fmt.Sprintf("%q (held for %s)", key, time.Since(since).Round(time.Millisecond)))
+	}
+	sort.Strings(out)
+	return out
+}
+
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+func (m *mutexKV) get(key string) *keyMutex {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = newKeyMutex()
 		m.store[key] = mutex
 	}
 	return mutex
 }
 
+// metricsFor returns the metrics for the specified key - the caller must hold m.lock
+func (m *mutexKV) metricsFor(key string) *keyMetrics {
+	metrics, ok := m.metrics[key]
+	if !ok {
+		metrics = &keyMetrics{}
+		m.metrics[key] = metrics
+	}
+	return metrics
+}
+
+func (m *mutexKV) markAsWaiting(key string) (uint64, time.Time) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := time.Now()
+	m.lastWaiterId++
+	waiterId := m.lastWaiterId
+	if _, ok := m.waiting[key]; !ok {
+		m.waiting[key] = make(map[uint64]time.Time)
+	}
+	m.waiting[key][waiterId] = now
+
+	metrics := m.metricsFor(key)
+	if waiters := len(m.waiting[key]); waiters > metrics.MaxWaiters {
+		metrics.MaxWaiters = waiters
+	}
+
+	return waiterId, now
+}
+
+func (m *mutexKV) markAsNoLongerWaiting(key string, waiterId uint64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.waiting[key], waiterId)
+	if len(m.waiting[key]) == 0 {
+		delete(m.waiting, key)
+	}
+}
+
+func (m *mutexKV) markAsHeld(key string, waited time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.held[key] = time.Now()
+
+	metrics := m.metricsFor(key)
+	metrics.Acquisitions++
+	metrics.TotalWait += waited
+	if waited > metrics.MaxWait {
+		metrics.MaxWait = waited
+	}
+}
+
+func (m *mutexKV) markAsReleased(key string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if since, ok := m.held[key]; ok {
+		held := time.Since(since)
+		metrics := m.metricsFor(key)
+		metrics.TotalHold += held
+		if held > metrics.MaxHold {
+			metrics.MaxHold = held
+		}
+	}
+	delete(m.held, key)
+}
+
 // Returns a properly initialized mutexKV
 func NewMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store:         make(map[string]*keyMutex),
+		held:          make(map[string]time.Time),
+		waiting:       make(map[string]map[uint64]time.Time),
+		metrics:       make(map[string]*keyMetrics),
+		waitThreshold: waitThresholdFromEnvironment(),
+	}
+}
+
+// keyMutex is a mutex which, unlike a sync.Mutex, can also be locked with a Context
+// so that callers can stop waiting for the lock once the Context is done
+type keyMutex struct {
+	ch chan struct{}
+}
+
+func newKeyMutex() *keyMutex {
+	return &keyMutex{
+		ch: make(chan struct{}, 1),
+	}
+}
+
+func (m *keyMutex) LockWithContext(ctx context.Context) error {
+	select {
+	case m.ch <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// TryLock locks the mutex if it's not already locked, returning whether it was locked
+func (m *keyMutex) TryLock() bool {
+	select {
+	case m.ch <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (m *keyMutex) Unlock() {
+	select {
+	case <-m.ch:
+	default:
+		panic("unlock of unlocked mutex")
 	}
 }
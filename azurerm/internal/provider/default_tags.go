@@ -0,0 +1,316 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+func schemaDefaultTags() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Tags which should be assigned to all resources which support Tags.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": {
+					Type:         pluginsdk.TypeMap,
+					Required:     true,
+					ValidateFunc: tags.Validate,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandDefaultTags(input []interface{}) map[string]string {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := make(map[string]string)
+	for k, v := range tags.Expand(raw["tags"].(map[string]interface{})) {
+		output[k] = *v
+	}
+	return output
+}
+
+// supportsDefaultTags returns whether the Resource uses the `tags` Schema (`tags.Schema()`), meaning
+// that the Provider's `default_tags` should be assigned to this Resource
+func supportsDefaultTags(resource *schema.Resource) bool {
+	if _, exists := resource.Schema["tags_all"]; exists {
+		return false
+	}
+
+	v, ok := resource.Schema["tags"]
+	if !ok {
+		return false
+	}
+
+	// resources where Tags are ForceNew are intentionally excluded, since changing the
+	// `default_tags` would otherwise require every one of these resources to be recreated
+	if v.Type != schema.TypeMap || !v.Optional || v.Computed || v.ForceNew || v.ValidateFunc == nil {
+		return false
+	}
+
+	elem, ok := v.Elem.(*schema.Schema)
+	return ok && elem.Type == schema.TypeString
+}
+
+// withDefaultTags assigns the Provider's `default_tags` to the Resource, by merging these into the `tags`
+// prior to the Create/Update - and then removing these from the `tags` after the Create/Read/Update, such
+// that these don't show in the diff for the `tags`. All of the Tags assigned to the resource are exposed
+// via the computed `tags_all` attribute.
+//
+// Tags which are ignored (via the Provider's `ignore_tags`) are retained on the resource during an Update.
+func withDefaultTags(resource *schema.Resource) {
+	if !supportsDefaultTags(resource) {
+		return
+	}
+
+	resource.Schema["tags_all"] = tags.SchemaTagsAll()
+	if existing := resource.CustomizeDiff; existing != nil {
+		resource.CustomizeDiff = pluginsdk.CustomDiffInSequence(defaultTagsCustomizeDiff, existing)
+	} else {
+		resource.CustomizeDiff = defaultTagsCustomizeDiff
+	}
+
+	if resource.Create != nil {
+		resource.Create = withDefaultTagsOnWrite(resource.Create)
+	}
+	if resource.CreateContext != nil {
+		resource.CreateContext = withDefaultTagsOnWriteContext(resource.CreateContext)
+	}
+	if resource.Update != nil {
+		resource.Update = withDefaultTagsOnWrite(resource.Update)
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = withDefaultTagsOnWriteContext(resource.UpdateContext)
+	}
+	if resource.Read != nil {
+		resource.Read = withDefaultTagsOnRead(resource.Read)
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = withDefaultTagsOnReadContext(resource.ReadContext)
+	}
+}
+
+func defaultTagsFromMeta(meta interface{}) map[string]string {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		return client.DefaultTags
+	}
+
+	return nil
+}
+
+func defaultTagsCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	merged := tags.MergeDefaults(defaultTagsFromMeta(meta), diff.Get("tags").(map[string]interface{}))
+	if _, errs := tags.Validate(merged, "tags"); len(errs) > 0 {
+		messages := make([]string, 0, len(errs))
+		for _, err := range errs {
+			messages = append(messages, err.Error())
+		}
+		return fmt.Errorf("validating the `tags` merged with the Provider's `default_tags`: %s", strings.Join(messages, ", "))
+	}
+
+	return diff.SetNew("tags_all", merged)
+}
+
+// mergeDefaultTags merges the `default_tags` (and when updating, any ignored Tags assigned to the
+// resource) into the `tags`, returning the `tags` which were specified and the `tags` which were merged
+func mergeDefaultTags(ctx context.Context, d *schema.ResourceData, meta interface{}) (map[string]interface{}, map[string]interface{}, error) {
+	specified := d.Get("tags").(map[string]interface{})
+
+	defaults := defaultTagsFromMeta(meta)
+	merged := tags.MergeDefaults(defaults, specified)
+
+	// when updating, the ignored Tags are retained so that these aren't removed from the resource
+	var ignored map[string]interface{}
+	if d.Id() != "" {
+		var err error
+		ignored, err = ignoredTagsForResource(ctx, d.Id(), meta)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for k, v := range ignored {
+			if _, exists := merged[k]; !exists {
+				merged[k] = v
+			}
+		}
+	}
+
+	if len(defaults) == 0 && len(ignored) == 0 {
+		return specified, merged, nil
+	}
+
+	if err := d.Set("tags", merged); err != nil {
+		return nil, nil, fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return specified, merged, nil
+}
+
+// applyDefaultTags assigns the merged `tags` to the resource using the Tags API when only the `default_tags`
+// have changed. Since the `tags` set by mergeDefaultTags aren't part of the diff, resources which only send
+// the `tags` when `d.HasChange("tags")` wouldn't otherwise update the Tags assigned to the resource.
+func applyDefaultTags(ctx context.Context, d *schema.ResourceData, meta interface{}, merged map[string]interface{}) error {
+	if !d.HasChange("tags_all") || d.HasChange("tags") || !isResourceManagerId(d.Id()) {
+		return nil
+	}
+
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || client.Resource == nil || client.Resource.TagsClient == nil {
+		return nil
+	}
+
+	resp, err := client.Resource.TagsClient.GetAtScope(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("retrieving the Tags for %q: %+v", d.Id(), err)
+	}
+
+	existing := make(map[string]interface{})
+	if resp.Properties != nil {
+		for k, v := range resp.Properties.Tags {
+			if v != nil {
+				existing[k] = *v
+			}
+		}
+	}
+
+	if !reflect.DeepEqual(existing, merged) {
+		log.Printf("[DEBUG] Updating the Tags for %q to the `tags` merged with the Provider's `default_tags`..", d.Id())
+		parameters := resources.TagsPatchResource{
+			Operation: resources.TagsPatchOperationReplace,
+			Properties: &resources.Tags{
+				Tags: tags.Expand(merged),
+			},
+		}
+		if _, err := client.Resource.TagsClient.UpdateAtScope(ctx, d.Id(), parameters); err != nil {
+			return fmt.Errorf("updating the Tags for %q: %+v", d.Id(), err)
+		}
+	}
+
+	if err := d.Set("tags", merged); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
+
+// removeDefaultTags sets `tags_all` to all of the Tags assigned to the resource, and removes
+// the `default_tags` which weren't specified from the `tags`
+func removeDefaultTags(d *schema.ResourceData, meta interface{}, specified map[string]interface{}) error {
+	// the resource either wasn't created or is gone
+	if d.Id() == "" {
+		return nil
+	}
+
+	all := tags.RemoveIgnored(d.Get("tags").(map[string]interface{}))
+	if err := d.Set("tags_all", all); err != nil {
+		return fmt.Errorf("setting `tags_all`: %+v", err)
+	}
+
+	if err := d.Set("tags", tags.RemoveDefaults(defaultTagsFromMeta(meta), all, specified)); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
+
+// isResourceManagerId returns whether the ID is a Resource Manager ID, rather than (for example) a Data Plane URI
+func isResourceManagerId(id string) bool {
+	return strings.HasPrefix(id, "/subscriptions/") || strings.HasPrefix(id, "/providers/")
+}
+
+func withDefaultTagsOnWrite(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		ctx := context.Background()
+		if client, ok := meta.(*clients.Client); ok && client != nil && client.StopContext != nil {
+			ctx = client.StopContext
+		}
+
+		isUpdate := d.Id() != ""
+		specified, merged, err := mergeDefaultTags(ctx, d, meta)
+		if err != nil {
+			return err
+		}
+
+		err = f(d, meta)
+		if err == nil && isUpdate {
+			err = applyDefaultTags(ctx, d, meta, merged)
+		}
+		if removeErr := removeDefaultTags(d, meta, specified); err == nil {
+			err = removeErr
+		}
+		return err
+	}
+}
+
+func withDefaultTagsOnWriteContext(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		isUpdate := d.Id() != ""
+		specified, merged, err := mergeDefaultTags(ctx, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		diags := f(ctx, d, meta)
+		if !diags.HasError() && isUpdate {
+			if err := applyDefaultTags(ctx, d, meta, merged); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
+		if err := removeDefaultTags(d, meta, specified); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+func withDefaultTagsOnRead(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		// the `tags` in the state only contain the `default_tags` which were specified on the resource
+		specified := d.Get("tags").(map[string]interface{})
+
+		if err := f(d, meta); err != nil {
+			return err
+		}
+
+		return removeDefaultTags(d, meta, specified)
+	}
+}
+
+func withDefaultTagsOnReadContext(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		specified := d.Get("tags").(map[string]interface{})
+
+		diags := f(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+
+		if err := removeDefaultTags(d, meta, specified); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	resourceClient "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/client"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestResourcesWithTagsSupportDefaultTags(t *testing.T) {
+	provider := TestAzureProvider()
+
+	for resourceName, resource := range provider.ResourcesMap {
+		v, ok := resource.Schema["tags"]
+		if !ok || v.ForceNew || v.Computed {
+			continue
+		}
+
+		if _, ok := resource.Schema["tags_all"]; !ok && v.ValidateFunc != nil {
+			t.Errorf("expected the resource %q to expose `tags_all`", resourceName)
+		}
+	}
+}
+
+func TestWithDefaultTags(t *testing.T) {
+	var sentTags map[string]interface{}
+	remoteTags := map[string]interface{}{}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tags.Schema(),
+		},
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			sentTags = d.Get("tags").(map[string]interface{})
+			remoteTags = sentTags
+			d.SetId("example")
+			return d.Set("tags", remoteTags)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return d.Set("tags", remoteTags)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+	withDefaultTags(resource)
+
+	if _, ok := resource.Schema["tags_all"]; !ok {
+		t.Fatalf("expected `tags_all` to be added to the resource")
+	}
+
+	meta := &clients.Client{
+		DefaultTags: map[string]string{
+			"env":   "prod",
+			"owner": "platform",
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"env":   "dev",
+			"hello": "world",
+		},
+	})
+
+	if err := resource.Create(d, meta); err != nil {
+		t.Fatalf("creating: %+v", err)
+	}
+
+	expectedAll := map[string]interface{}{
+		"env":   "dev",
+		"hello": "world",
+		"owner": "platform",
+	}
+	if !reflect.DeepEqual(sentTags, expectedAll) {
+		t.Fatalf("expected the tags %+v to be sent but got %+v", expectedAll, sentTags)
+	}
+
+	expectedTags := map[string]interface{}{
+		"env":   "dev",
+		"hello": "world",
+	}
+	assertTags := func() {
+		if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+			t.Fatalf("expected `tags` to be %+v but got %+v", expectedTags, actual)
+		}
+		if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expectedAll) {
+			t.Fatalf("expected `tags_all` to be %+v but got %+v", expectedAll, actual)
+		}
+	}
+	assertTags()
+
+	if err := resource.Read(d, meta); err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+	assertTags()
+}
+
+func TestWithDefaultTagsUpdatesTagsWhenOnlyDefaultTagsChange(t *testing.T) {
+	id := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"
+	remoteTags := map[string]*string{
+		"env":   utils.String("dev"),
+		"hello": utils.String("world"),
+	}
+	patches := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			var body resources.TagsPatchResource
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("decoding the request: %+v", err)
+			}
+			patches++
+			remoteTags = body.Properties.Tags
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resources.TagsResource{
+			Properties: &resources.Tags{
+				Tags: remoteTags,
+			},
+		})
+	}))
+	defer server.Close()
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tags.Schema(),
+		},
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return fmt.Errorf("unexpected Create")
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return tags.FlattenAndSet(d, remoteTags)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			// the Tags are intentionally only sent when these change
+			if d.HasChange("tags") {
+				remoteTags = tags.Expand(d.Get("tags").(map[string]interface{}))
+			}
+			return tags.FlattenAndSet(d, remoteTags)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+	withDefaultTags(resource)
+
+	tagsClient := resources.NewTagsClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+	meta := &clients.Client{
+		DefaultTags: map[string]string{
+			"owner": "platform",
+		},
+		Resource: &resourceClient.Client{
+			TagsClient: &tagsClient,
+		},
+		StopContext: context.TODO(),
+	}
+	state := &terraform.InstanceState{
+		ID: id,
+		Attributes: map[string]string{
+			"id":             id,
+			"tags.%":         "1",
+			"tags.hello":     "world",
+			"tags_all.%":     "2",
+			"tags_all.env":   "dev",
+			"tags_all.hello": "world",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tags": map[string]interface{}{
+			"hello": "world",
+		},
+	})
+
+	diff, err := resource.Diff(context.TODO(), state, config, meta)
+	if err != nil {
+		t.Fatalf("running Diff: %+v", err)
+	}
+	if diff == nil || diff.Attributes["tags_all.owner"] == nil {
+		t.Fatalf("expected a diff for `tags_all` but got %+v", diff)
+	}
+
+	newState, diags := resource.Apply(context.TODO(), state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("running Apply: %+v", diags)
+	}
+
+	expectedRemote := map[string]interface{}{
+		"hello": "world",
+		"owner": "platform",
+	}
+	if actual := tags.Flatten(remoteTags); !reflect.DeepEqual(actual, expectedRemote) {
+		t.Fatalf("expected the remote tags to be %+v but got %+v", expectedRemote, actual)
+	}
+	if patches != 1 {
+		t.Fatalf("expected the Tags to be updated once but got %d", patches)
+	}
+	if v := newState.Attributes["tags.%"]; v != "1" {
+		t.Fatalf("expected `tags` to contain 1 tag but got %s", v)
+	}
+	if v := newState.Attributes["tags_all.owner"]; v != "platform" {
+		t.Fatalf("expected `tags_all.owner` to be `platform` but got %q", v)
+	}
+}
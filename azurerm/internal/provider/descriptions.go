@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/docs"
+)
+
+// documentationPathFromEnvironment returns the path to the `website/docs` directory of this repository, which
+// is specified using the Environment Variable `ARM_PROVIDER_DOCUMENTATION_PATH` - since the documentation isn't
+// shipped with the Provider, this is intended to be set by editor tooling (e.g. the Language Server)
+func documentationPathFromEnvironment() string {
+	return os.Getenv("ARM_PROVIDER_DOCUMENTATION_PATH")
+}
+
+// withDescriptionsFromDocumentation loads the descriptions for each Data Source and Resource, and their
+// Arguments/Attributes, from the documentation within the specified `website/docs` directory. Descriptions
+// defined in the Schema take precedence over those defined in the documentation.
+//
+// Since the descriptions are only used by editor tooling, documentation which doesn't exist or can't be
+// read is logged and skipped rather than returning an error.
+func withDescriptionsFromDocumentation(docsPath string, dataSources map[string]*schema.Resource, resources map[string]*schema.Resource) {
+	load := func(name string, isDataSource bool, resource *schema.Resource) {
+		filePath := docs.PagePath(docsPath, name, isDataSource)
+		contents, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to load the descriptions for %q from %q: %+v", name, filePath, err)
+			return
+		}
+
+		parseDescriptions(string(contents)).apply(resource)
+	}
+
+	for name, dataSource := range dataSources {
+		load(name, true, dataSource)
+	}
+	for name, resource := range resources {
+		load(name, false, resource)
+	}
+}
+
+type documentedDescriptions struct {
+	// description is the description of the Data Source/Resource, from the Front Matter
+	description string
+
+	// fields is a map of the Block Name (which is empty for top-level fields) to the
+	// Field Name to the description of this field
+	fields map[string]map[string]string
+}
+
+// parseDescriptions parses the description of the Data Source/Resource and the descriptions of
+// each of the fields within the Arguments and Attributes sections of the documentation
+func parseDescriptions(contents string) documentedDescriptions {
+	page := docs.ParsePage(contents)
+	out := documentedDescriptions{
+		description: page.Description(),
+		fields: map[string]map[string]string{
+			"": {},
+		},
+	}
+
+	for _, sectionName := range []string{docs.SectionArguments, docs.SectionAttributes} {
+		for blockName, block := range page.Fields(sectionName) {
+			if _, exists := out.fields[blockName]; !exists {
+				out.fields[blockName] = map[string]string{}
+			}
+
+			for fieldName, field := range block.Fields {
+				// the first definition wins, since the Attributes can re-document the Arguments
+				if _, exists := out.fields[blockName][fieldName]; !exists {
+					out.fields[blockName][fieldName] = field.Description
+				}
+			}
+		}
+	}
+
+	return out
+}
+
+// apply sets the descriptions on the Data Source/Resource and each of it's fields (including
+// those within nested blocks) which don't already define a Description in the Schema
+func (d documentedDescriptions) apply(resource *schema.Resource) {
+	if resource.Description == "" {
+		resource.Description = d.description
+	}
+
+	d.applyToFields("", resource.Schema)
+}
+
+func (d documentedDescriptions) applyToFields(blockName string, fields map[string]*schema.Schema) {
+	for fieldName, field := range fields {
+		if field.Description == "" {
+			field.Description = d.fields[blockName][fieldName]
+		}
+
+		if v, ok := field.Elem.(*schema.Resource); ok {
+			d.applyToFields(fieldName, v.Schema)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const testDescriptionsDocumentation = `---
+subcategory: "Example"
+layout: "azurerm"
+page_title: "Azure Resource Manager: azurerm_example"
+description: |-
+  Manages an Example.
+---
+
+# azurerm_example
+
+Manages an Example.
+
+## Example Usage
+
+'''hcl
+* 'name' - (Required) Not a field.
+'''
+
+## Arguments Reference
+
+The following arguments are supported:
+
+* 'name' - (Required) The name of the Example. Changing this forces a new Example
+  to be created.
+
+* 'rule' - (Optional) One or more 'rule' blocks as defined below.
+
+~> **NOTE:** This is a note.
+
+---
+
+A 'rule' block supports the following:
+
+* 'name' - (Required) The name of the Rule.
+
+## Attributes Reference
+
+The following attributes are exported:
+
+* 'id' - The ID of the Example.
+
+* 'name' - Not the first definition.
+
+* 'fqdn' - The FQDN of the Example.
+`
+
+func testDescriptionsResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Defined in the Schema.",
+						},
+					},
+				},
+			},
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"undocumented": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func TestParseDescriptions(t *testing.T) {
+	actual := parseDescriptions(strings.ReplaceAll(testDescriptionsDocumentation, "'", "`"))
+
+	if expected := "Manages an Example."; actual.description != expected {
+		t.Fatalf("Expected the description to be %q but got %q", expected, actual.description)
+	}
+
+	expected := map[string]map[string]string{
+		"": {
+			"name": "The name of the Example. Changing this forces a new Example to be created.",
+			"rule": "One or more `rule` blocks as defined below.",
+			"id":   "The ID of the Example.",
+			"fqdn": "The FQDN of the Example.",
+		},
+		"rule": {
+			"name": "The name of the Rule.",
+		},
+	}
+	for blockName, fields := range expected {
+		for fieldName, description := range fields {
+			if v := actual.fields[blockName][fieldName]; v != description {
+				t.Fatalf("Expected the description for %q in the block %q to be %q but got %q", fieldName, blockName, description, v)
+			}
+		}
+		if len(actual.fields[blockName]) != len(fields) {
+			t.Fatalf("Expected %d fields in the block %q but got %d: %+v", len(fields), blockName, len(actual.fields[blockName]), actual.fields[blockName])
+		}
+	}
+}
+
+func TestWithDescriptionsFromDocumentation(t *testing.T) {
+	docsPath, err := ioutil.TempDir("", "descriptions")
+	if err != nil {
+		t.Fatalf("creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(docsPath)
+
+	if err := os.Mkdir(filepath.Join(docsPath, "r"), 0755); err != nil {
+		t.Fatalf("creating directory: %+v", err)
+	}
+	contents := strings.ReplaceAll(testDescriptionsDocumentation, "'", "`")
+	if err := ioutil.WriteFile(filepath.Join(docsPath, "r", "example.html.markdown"), []byte(contents), 0644); err != nil {
+		t.Fatalf("writing documentation: %+v", err)
+	}
+
+	resource := testDescriptionsResource()
+	undocumented := testDescriptionsResource()
+	resources := map[string]*schema.Resource{
+		"azurerm_example":      resource,
+		"azurerm_undocumented": undocumented,
+	}
+	withDescriptionsFromDocumentation(docsPath, map[string]*schema.Resource{}, resources)
+
+	if expected := "Manages an Example."; resource.Description != expected {
+		t.Fatalf("Expected the Resource description to be %q but got %q", expected, resource.Description)
+	}
+	if expected := "The FQDN of the Example."; resource.Schema["fqdn"].Description != expected {
+		t.Fatalf("Expected the description for `fqdn` to be %q but got %q", expected, resource.Schema["fqdn"].Description)
+	}
+	if v := resource.Schema["undocumented"].Description; v != "" {
+		t.Fatalf("Expected no description for `undocumented` but got %q", v)
+	}
+
+	// descriptions defined in the Schema take precedence
+	nested := resource.Schema["rule"].Elem.(*schema.Resource)
+	if expected := "Defined in the Schema."; nested.Schema["name"].Description != expected {
+		t.Fatalf("Expected the description for `rule.name` to be %q but got %q", expected, nested.Schema["name"].Description)
+	}
+
+	if undocumented.Description != "" || undocumented.Schema["name"].Description != "" {
+		t.Fatalf("Expected no descriptions for a Resource without documentation")
+	}
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func schemaIgnoreTags() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Tags which are managed outside of Terraform (for example by Azure Policy) and which should be ignored.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"keys": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					AtLeastOneOf: []string{"ignore_tags.0.keys", "ignore_tags.0.key_prefixes"},
+					Description:  "A list of Tag Keys which should be ignored.",
+				},
+
+				"key_prefixes": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					AtLeastOneOf: []string{"ignore_tags.0.keys", "ignore_tags.0.key_prefixes"},
+					Description:  "A list of prefixes for Tag Keys which should be ignored.",
+				},
+			},
+		},
+	}
+}
+
+func expandIgnoreTags(input []interface{}) *tags.IgnoreConfig {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &tags.IgnoreConfig{
+		Keys:        *utils.ExpandStringSlice(raw["keys"].(*pluginsdk.Set).List()),
+		KeyPrefixes: *utils.ExpandStringSlice(raw["key_prefixes"].(*pluginsdk.Set).List()),
+	}
+}
+
+// ignoredTagsForResource returns the Tags which are ignored that are currently assigned to the resource,
+// such that these can be retained when the resource is updated
+func ignoredTagsForResource(ctx context.Context, id string, meta interface{}) (map[string]interface{}, error) {
+	if tags.Ignored() == nil {
+		return nil, nil
+	}
+
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || client.Resource == nil || client.Resource.TagsClient == nil {
+		return nil, nil
+	}
+
+	// the Tags of Data Plane resources (e.g. Key Vault Secrets) aren't available via the Tags API
+	if !isResourceManagerId(id) {
+		log.Printf("[DEBUG] %q isn't a Resource Manager ID - unable to retain the ignored Tags", id)
+		return nil, nil
+	}
+
+	// the Tags API is available for all ARM resources, so this is used rather than the resource's API
+	resp, err := client.Resource.TagsClient.GetAtScope(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Tags for %q to retain the ignored Tags: %+v", id, err)
+	}
+
+	if resp.Properties == nil {
+		return nil, nil
+	}
+
+	return tags.OnlyIgnored(resp.Properties.Tags), nil
+}
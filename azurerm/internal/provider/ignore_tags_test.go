@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	resourceClient "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/client"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+)
+
+func TestIgnoredTagsForResource(t *testing.T) {
+	tags.SetIgnoreConfig(&tags.IgnoreConfig{
+		KeyPrefixes: []string{"hidden-"},
+	})
+	defer tags.SetIgnoreConfig(nil)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(r.URL.Path, "/resourceGroups/forbidden/") {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error": {"code": "AuthorizationFailed"}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"properties": {"tags": {"hello": "world", "hidden-owner": "policy"}}}`))
+	}))
+	defer server.Close()
+
+	tagsClient := resources.NewTagsClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+	meta := &clients.Client{
+		Resource: &resourceClient.Client{
+			TagsClient: &tagsClient,
+		},
+	}
+
+	testData := []struct {
+		id       string
+		expected map[string]interface{}
+		error    bool
+	}{
+		{
+			id: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			expected: map[string]interface{}{
+				"hidden-owner": "policy",
+			},
+		},
+		{
+			// the Tags for Data Plane resources aren't available from the Tags API
+			id: "https://example.vault.azure.net/secrets/example/abc123",
+		},
+		{
+			// the ignored Tags would be removed from the resource, so this should be surfaced
+			id:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/forbidden",
+			error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.id)
+
+		actual, err := ignoredTagsForResource(context.TODO(), v.id, meta)
+		if v.error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("retrieving the ignored Tags: %+v", err)
+		}
+		if len(actual) != 0 || len(v.expected) != 0 {
+			if !reflect.DeepEqual(actual, v.expected) {
+				t.Fatalf("expected %+v but got %+v", v.expected, actual)
+			}
+		}
+	}
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -75,9 +77,17 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 			}
 			resources[key] = resource
 		}
+
+		if v, ok := service.(sdk.ServiceRegistrationWithResourceProviders); ok {
+			for _, r := range service.Resources() {
+				key := r.ResourceType()
+				resources[key].CustomizeDiff = resourceProviderRegisteredCustomizeDiff(key, v.ResourceProviderNamespace(key), resources[key].CustomizeDiff)
+			}
+		}
 	}
 
 	// then handle the untyped services
+	untypedResources := make([]string, 0)
 	for _, service := range SupportedUntypedServices() {
 		debugLog("[DEBUG] Registering Data Sources for %q..", service.Name())
 		for k, v := range service.SupportedDataSources() {
@@ -95,7 +105,30 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 			}
 
 			resources[k] = v
+			untypedResources = append(untypedResources, k)
 		}
+
+		if v, ok := service.(sdk.ServiceRegistrationWithResourceProviders); ok {
+			for k := range service.SupportedResources() {
+				resources[k].CustomizeDiff = resourceProviderRegisteredCustomizeDiff(k, v.ResourceProviderNamespace(k), resources[k].CustomizeDiff)
+			}
+		}
+	}
+
+	// assign the Provider's `default_tags` to all of the resources which support Tags
+	for _, resource := range resources {
+		withDefaultTags(resource)
+	}
+
+	// Typed Resources use a StructuredLogger - so the untyped resources are wrapped to do the same
+	for _, k := range untypedResources {
+		withStructuredLogging(k, resources[k])
+	}
+
+	// the descriptions for fields which don't define one in the Schema can be loaded from the documentation
+	schema.DescriptionKind = pluginsdk.DescriptionKind
+	if docsPath := documentationPathFromEnvironment(); docsPath != "" {
+		withDescriptionsFromDocumentation(docsPath, dataSources, resources)
 	}
 
 	p := &schema.Provider{
@@ -212,8 +245,16 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "This will disable the Terraform Partner ID which is used if a custom `partner_id` isn't specified.",
 			},
 
+			"default_tags": schemaDefaultTags(),
+
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"ignore_tags": schemaIgnoreTags(),
+
+			"rate_limit": schemaRateLimit(),
+
+			"retry": schemaRetry(),
+
 			// Advanced feature flags
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
@@ -222,6 +263,17 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "Should the AzureRM Provider skip registering all of the Resource Providers that it supports, if they're not already registered?",
 			},
 
+			"resource_providers_to_register": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				ConflictsWith: []string{"skip_provider_registration"},
+				Description:   "A list of Resource Providers which should be registered, if they're not already registered, instead of all of the Resource Providers that the AzureRM Provider supports.",
+			},
+
 			"storage_use_azuread": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -314,6 +366,8 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 			DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 			Features:                    expandFeatures(d.Get("features").([]interface{})),
+			RateLimit:                   expandRateLimit(d.Get("rate_limit").([]interface{})),
+			Retry:                       expandRetry(d.Get("retry").([]interface{})),
 			StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
 
 			// this field is intentionally not exposed in the provider block, since it's only used for
@@ -332,22 +386,60 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 		}
 
 		client.StopContext = stopCtx
+		client.DefaultTags = expandDefaultTags(d.Get("default_tags").([]interface{}))
 
-		if !skipProviderRegistration {
-			// List all the available providers and their registration state to avoid unnecessary
-			// requests. This also lets us check if the provider credentials are correct.
-			providerList, err := client.Resource.ProvidersClient.List(ctx, nil, "")
-			if err != nil {
-				return nil, diag.FromErr(fmt.Errorf("Unable to list provider registration status, it is possible that this is due to invalid "+
-					"credentials or the service principal does not have permission to use the Resource Manager API, Azure "+
-					"error: %s", err))
-			}
+		// the Tags which should be ignored are used when flattening Tags, which doesn't have access to the client
+		tags.SetIgnoreConfig(expandIgnoreTags(d.Get("ignore_tags").([]interface{})))
 
-			availableResourceProviders := providerList.Values()
+		if !skipProviderRegistration {
 			requiredResourceProviders := resourceproviders.Required()
 
-			if err := resourceproviders.EnsureRegistered(ctx, *client.Resource.ProvidersClient, availableResourceProviders, requiredResourceProviders); err != nil {
-				return nil, diag.FromErr(fmt.Errorf(resourceProviderRegistrationErrorFmt, err))
+			// when a custom list of Resource Providers is specified, only these are registered - and we check
+			// that each Resource's Resource Provider is either registered or within this list
+			customResourceProviders := false
+			if v := d.Get("resource_providers_to_register").(*schema.Set).List(); len(v) > 0 {
+				customResourceProviders = true
+				requiredResourceProviders = make(map[string]struct{}, len(v))
+				for _, item := range v {
+					requiredResourceProviders[item.(string)] = struct{}{}
+				}
+			}
+
+			// when the on-disk cache is enabled, and all of the required Resource Providers were registered
+			// recently, we can skip listing the Resource Providers and registering them
+			cacheFile := resourceproviders.CacheFileFromEnvironment()
+			environmentName := client.Account.Environment.Name
+			subscriptionId := client.Account.SubscriptionId
+			if cached := cacheFile.Get(environmentName, subscriptionId); cacheFile.IsFreshForRequiredProviders(cached.RegisteredProviders, requiredResourceProviders) {
+				log.Printf("[DEBUG] Skipping Resource Provider Registration since all required Resource Providers were recently registered")
+				if customResourceProviders {
+					client.RegisteredResourceProviders = registeredResourceProviders(nil, cached.RegisteredProviders.Values)
+				}
+			} else {
+				// List all the available providers and their registration state to avoid unnecessary
+				// requests. This also lets us check if the provider credentials are correct.
+				providerList, err := client.Resource.ProvidersClient.List(ctx, nil, "")
+				if err != nil {
+					return nil, diag.FromErr(fmt.Errorf("Unable to list provider registration status, it is possible that this is due to invalid "+
+						"credentials or the service principal does not have permission to use the Resource Manager API, Azure "+
+						"error: %s", err))
+				}
+
+				availableResourceProviders := providerList.Values()
+
+				if err := resourceproviders.EnsureRegistered(ctx, *client.Resource.ProvidersClient, availableResourceProviders, requiredResourceProviders); err != nil {
+					return nil, diag.FromErr(fmt.Errorf(resourceProviderRegistrationErrorFmt, err))
+				}
+
+				cachedValues := resourceproviders.RequiredAsList()
+				if customResourceProviders {
+					client.RegisteredResourceProviders = registeredResourceProviders(availableResourceProviders, sortedResourceProviders(requiredResourceProviders))
+					cachedValues = sortedResourceProviders(client.RegisteredResourceProviders)
+				}
+
+				cacheFile.Update(environmentName, subscriptionId, func(entry *resourceproviders.CacheEntry) {
+					entry.RegisteredProviders = resourceproviders.NewCachedValuesForRequiredProviders(cachedValues, requiredResourceProviders)
+				})
 			}
 		}
 
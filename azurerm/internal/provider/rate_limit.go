@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+func schemaRateLimit() *pluginsdk.Schema {
+	defaults := common.DefaultRateLimitOptions()
+
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configures a client-side rate limit for requests to the Azure APIs, for each Subscription.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"reads_per_hour": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      defaults.ReadsPerHour,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of Read requests which can be made per hour, for each Subscription.",
+				},
+
+				"writes_per_hour": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      defaults.WritesPerHour,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of Write requests which can be made per hour, for each Subscription.",
+				},
+
+				"deletes_per_hour": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      defaults.DeletesPerHour,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of Delete requests which can be made per hour, for each Subscription.",
+				},
+
+				"burst": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      defaults.Burst,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of requests of each type which can be made in quick succession.",
+				},
+			},
+		},
+	}
+}
+
+func expandRateLimit(input []interface{}) *common.RateLimitOptions {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	options := common.DefaultRateLimitOptions()
+	raw := input[0].(map[string]interface{})
+
+	if v, ok := raw["reads_per_hour"]; ok {
+		options.ReadsPerHour = v.(int)
+	}
+	if v, ok := raw["writes_per_hour"]; ok {
+		options.WritesPerHour = v.(int)
+	}
+	if v, ok := raw["deletes_per_hour"]; ok {
+		options.DeletesPerHour = v.(int)
+	}
+	if v, ok := raw["burst"]; ok {
+		options.Burst = v.(int)
+	}
+
+	return &options
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+)
+
+// withStructuredLogging wraps the Create/Read/Update/Delete functions of a Plugin SDK (untyped) Resource
+// so that the start, duration and outcome of each operation is logged via a StructuredLogger - in the
+// same way as for Typed Resources, where the Resource Type, Operation and Resource ID are attached to each
+// log message. Untyped Resources can log additional messages using `sdk.NewUntypedResourceLogger`.
+func withStructuredLogging(resourceType string, resource *schema.Resource) {
+	if resource.Create != nil {
+		resource.Create = withStructuredLoggingFor(resourceType, sdk.OperationCreate, resource.Create)
+	}
+	if resource.CreateContext != nil {
+		resource.CreateContext = withStructuredLoggingForContext(resourceType, sdk.OperationCreate, resource.CreateContext)
+	}
+	if resource.Read != nil {
+		resource.Read = withStructuredLoggingFor(resourceType, sdk.OperationRead, resource.Read)
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = withStructuredLoggingForContext(resourceType, sdk.OperationRead, resource.ReadContext)
+	}
+	if resource.Update != nil {
+		resource.Update = withStructuredLoggingFor(resourceType, sdk.OperationUpdate, resource.Update)
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = withStructuredLoggingForContext(resourceType, sdk.OperationUpdate, resource.UpdateContext)
+	}
+	if resource.Delete != nil {
+		resource.Delete = withStructuredLoggingFor(resourceType, sdk.OperationDelete, resource.Delete)
+	}
+	if resource.DeleteContext != nil {
+		resource.DeleteContext = withStructuredLoggingForContext(resourceType, sdk.OperationDelete, resource.DeleteContext)
+	}
+}
+
+func withStructuredLoggingFor(resourceType string, operation sdk.Operation, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		logger := sdk.NewUntypedResourceLogger(resourceType, operation, d)
+		start := time.Now()
+		logger.Debugf("starting %s..", operation)
+
+		err := f(d, meta)
+		logOperationResult(logger, operation, start, err)
+		return err
+	}
+}
+
+func withStructuredLoggingForContext(resourceType string, operation sdk.Operation, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		logger := sdk.NewUntypedResourceLogger(resourceType, operation, d)
+		start := time.Now()
+		logger.Debugf("starting %s..", operation)
+
+		diags := f(ctx, d, meta)
+		var err error
+		for _, v := range diags {
+			if v.Severity == diag.Error {
+				err = diagnosticError(v)
+				break
+			}
+		}
+		logOperationResult(logger, operation, start, err)
+		return diags
+	}
+}
+
+func logOperationResult(logger sdk.Logger, operation sdk.Operation, start time.Time, err error) {
+	if err != nil {
+		logger.Errorf("%s failed after %s: %+v", operation, time.Since(start).Round(time.Millisecond), err)
+		return
+	}
+
+	logger.Debugf("%s completed in %s", operation, time.Since(start).Round(time.Millisecond))
+}
+
+// diagnosticError exposes an Error Diagnostic as an error, so that it can be logged
+type diagnosticError diag.Diagnostic
+
+func (e diagnosticError) Error() string {
+	return e.Summary
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWithStructuredLogging(t *testing.T) {
+	var buf bytes.Buffer
+	original := log.Writer()
+	log.SetOutput(&buf)
+	defer log.SetOutput(original)
+
+	resource := &schema.Resource{
+		Create: func(d *schema.ResourceData, _ interface{}) error {
+			d.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example")
+			return nil
+		},
+		Read: func(_ *schema.ResourceData, _ interface{}) error {
+			return nil
+		},
+		Delete: func(_ *schema.ResourceData, _ interface{}) error {
+			return fmt.Errorf("deleting example")
+		},
+		Schema: map[string]*schema.Schema{},
+	}
+	withStructuredLogging("azurerm_example", resource)
+
+	d := resource.TestResourceData()
+	if err := resource.Create(d, nil); err != nil {
+		t.Fatalf("creating: %+v", err)
+	}
+	if err := resource.Delete(d, nil); err == nil {
+		t.Fatalf("expected an error deleting but didn't get one")
+	}
+
+	output := buf.String()
+	expected := []string{
+		"[DEBUG] starting create.. (correlation_request_id=",
+		"create completed in ",
+		"operation=create resource_id=/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example resource_type=azurerm_example)",
+		"[ERROR] delete failed after ",
+		": deleting example (correlation_request_id=",
+	}
+	for _, v := range expected {
+		if !strings.Contains(output, v) {
+			t.Fatalf("expected the log output to contain %q but got:\n%s", v, output)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+)
+
+// registeredResourceProviders returns the set of (lower-cased) Resource Provider Namespaces which are either
+// registered within the Subscription, or which have been registered by the Provider
+func registeredResourceProviders(available []resources.Provider, registered []string) map[string]struct{} {
+	out := make(map[string]struct{}, len(registered))
+	for _, v := range registered {
+		out[strings.ToLower(v)] = struct{}{}
+	}
+
+	for _, provider := range available {
+		if provider.Namespace == nil || provider.RegistrationState == nil {
+			continue
+		}
+
+		if strings.EqualFold(*provider.RegistrationState, "Registered") {
+			out[strings.ToLower(*provider.Namespace)] = struct{}{}
+		}
+	}
+
+	return out
+}
+
+func sortedResourceProviders(input map[string]struct{}) []string {
+	out := make([]string, 0, len(input))
+	for k := range input {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// resourceProviderRegisteredCustomizeDiff returns a CustomizeDiff function which checks that the Resource Provider
+// used by this Resource is registered prior to creating the Resource, before calling the existing CustomizeDiff function
+func resourceProviderRegisteredCustomizeDiff(resourceType, namespace string, existing schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	if namespace == "" {
+		return existing
+	}
+
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if err := checkResourceProviderIsRegistered(resourceType, namespace, diff, meta); err != nil {
+			return err
+		}
+
+		if existing != nil {
+			return existing(ctx, diff, meta)
+		}
+
+		return nil
+	}
+}
+
+func checkResourceProviderIsRegistered(resourceType, namespace string, diff *schema.ResourceDiff, meta interface{}) error {
+	// this is only checked when the Resource is being created, since existing Resources must be registered
+	if diff.Id() != "" {
+		return nil
+	}
+
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || client.RegisteredResourceProviders == nil {
+		return nil
+	}
+
+	if _, ok := client.RegisteredResourceProviders[strings.ToLower(namespace)]; ok {
+		return nil
+	}
+
+	log.Printf("[DEBUG] the Resource Provider %q used by %q is not registered", namespace, resourceType)
+	return fmt.Errorf(`the Resource Provider %q is required to provision %q, but isn't registered in this Subscription.
+
+Since the "resource_providers_to_register" field is specified in the Provider block, only the Resource Providers
+within this list are registered by the Provider. To provision this Resource, either add %q to the
+"resource_providers_to_register" field, or register this Resource Provider manually`, namespace, resourceType, namespace)
+}
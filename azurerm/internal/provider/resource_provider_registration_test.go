@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestRegisteredResourceProviders(t *testing.T) {
+	available := []resources.Provider{
+		{
+			Namespace:         utils.String("Microsoft.Compute"),
+			RegistrationState: utils.String("Registered"),
+		},
+		{
+			Namespace:         utils.String("Microsoft.Web"),
+			RegistrationState: utils.String("NotRegistered"),
+		},
+		{
+			Namespace: utils.String("Microsoft.Storage"),
+		},
+	}
+
+	actual := registeredResourceProviders(available, []string{"Microsoft.KeyVault"})
+	expected := map[string]struct{}{
+		"microsoft.compute":  {},
+		"microsoft.keyvault": {},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestResourceProviderNamespacesAreValid(t *testing.T) {
+	check := func(service interface{}, resourceTypes []string) {
+		v, ok := service.(sdk.ServiceRegistrationWithResourceProviders)
+		if !ok {
+			return
+		}
+
+		for _, resourceType := range resourceTypes {
+			namespace := v.ResourceProviderNamespace(resourceType)
+			if namespace == "" {
+				continue
+			}
+
+			if segments := strings.Split(namespace, "."); len(segments) != 2 || !strings.EqualFold(segments[0], "Microsoft") || segments[1] == "" {
+				t.Errorf("the Resource Provider %q used by %q isn't a valid Resource Provider Namespace", namespace, resourceType)
+			}
+		}
+	}
+
+	for _, service := range SupportedTypedServices() {
+		resourceTypes := make([]string, 0)
+		for _, r := range service.Resources() {
+			resourceTypes = append(resourceTypes, r.ResourceType())
+		}
+		check(service, resourceTypes)
+	}
+
+	for _, service := range SupportedUntypedServices() {
+		resourceTypes := make([]string, 0)
+		for k := range service.SupportedResources() {
+			resourceTypes = append(resourceTypes, k)
+		}
+		check(service, resourceTypes)
+	}
+}
+
+func TestServicesWithResourcesMapResourceProviders(t *testing.T) {
+	for _, service := range SupportedTypedServices() {
+		if _, ok := service.(sdk.ServiceRegistrationWithResourceProviders); !ok && len(service.Resources()) > 0 {
+			t.Errorf("the Service %q doesn't map its Resources to a Resource Provider Namespace", service.Name())
+		}
+	}
+
+	for _, service := range SupportedUntypedServices() {
+		if _, ok := service.(sdk.ServiceRegistrationWithResourceProviders); !ok && len(service.SupportedResources()) > 0 {
+			t.Errorf("the Service %q doesn't map its Resources to a Resource Provider Namespace", service.Name())
+		}
+	}
+}
+
+func TestResourceProviderNamespaceForResource(t *testing.T) {
+	expected := map[string]string{
+		"azurerm_app_service":                   "Microsoft.Web",
+		"azurerm_app_service_certificate_order": "Microsoft.CertificateRegistration",
+		"azurerm_policy_definition":             "Microsoft.Authorization",
+		"azurerm_policy_remediation":            "Microsoft.PolicyInsights",
+		"azurerm_management_lock":               "Microsoft.Authorization",
+		"azurerm_resource_group":                "Microsoft.Resources",
+	}
+
+	for _, service := range SupportedUntypedServices() {
+		v, ok := service.(sdk.ServiceRegistrationWithResourceProviders)
+		if !ok {
+			continue
+		}
+
+		for resourceType := range service.SupportedResources() {
+			namespace, exists := expected[resourceType]
+			if !exists {
+				continue
+			}
+
+			if actual := v.ResourceProviderNamespace(resourceType); actual != namespace {
+				t.Errorf("expected the Resource Provider for %q to be %q but got %q", resourceType, namespace, actual)
+			}
+			delete(expected, resourceType)
+		}
+	}
+
+	for resourceType := range expected {
+		t.Errorf("the Resource %q wasn't found", resourceType)
+	}
+}
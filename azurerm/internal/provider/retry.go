@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+func schemaRetry() *pluginsdk.Schema {
+	defaults := common.DefaultRetryOptions()
+
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configures how requests to the Azure APIs which fail with a transient error (for example as a result of throttling) are retried.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"max_attempts": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      defaults.MaxAttempts,
+					ValidateFunc: validation.IntBetween(1, 100),
+					Description:  "The maximum number of times a request is sent, including the initial request.",
+				},
+
+				"base_backoff_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      int(defaults.BaseBackoff / time.Second),
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The number of seconds to wait before the first retry, which is doubled for each subsequent retry.",
+				},
+
+				"max_backoff_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      int(defaults.MaxBackoff / time.Second),
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The maximum number of seconds to wait between retries.",
+				},
+
+				"honor_retry_after": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     defaults.HonorRetryAfter,
+					Description: "Should the `Retry-After` header returned by the Azure APIs be used as the delay before the next retry?",
+				},
+
+				"retryable_status_codes": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeInt,
+						ValidateFunc: validation.IntBetween(400, 599),
+					},
+					Description: "A list of HTTP Status Codes which should be retried. Defaults to `408`, `429`, `500`, `502`, `503` and `504`.",
+				},
+			},
+		},
+	}
+}
+
+func expandRetry(input []interface{}) *common.RetryOptions {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	options := common.DefaultRetryOptions()
+	raw := input[0].(map[string]interface{})
+
+	if v, ok := raw["max_attempts"]; ok {
+		options.MaxAttempts = v.(int)
+	}
+	if v, ok := raw["base_backoff_seconds"]; ok {
+		options.BaseBackoff = time.Duration(v.(int)) * time.Second
+	}
+	if v, ok := raw["max_backoff_seconds"]; ok {
+		options.MaxBackoff = time.Duration(v.(int)) * time.Second
+	}
+	if v, ok := raw["honor_retry_after"]; ok {
+		options.HonorRetryAfter = v.(bool)
+	}
+	if v, ok := raw["retryable_status_codes"].(*pluginsdk.Set); ok && v.Len() > 0 {
+		statusCodes := make([]int, 0)
+		for _, item := range v.List() {
+			statusCodes = append(statusCodes, item.(int))
+		}
+		options.RetryableStatusCodes = statusCodes
+	}
+
+	return &options
+}
@@ -0,0 +1,175 @@
+package recording
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Cassette is the list of (scrubbed) interactions with the Azure APIs made during a single test
+type Cassette struct {
+	// Interactions are the requests made, and the responses returned, in the order these were made
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single (scrubbed) request made to the Azure APIs and the response returned
+type Interaction struct {
+	Method          string            `json:"method"`
+	URL             string            `json:"url"`
+	RequestBody     string            `json:"requestBody,omitempty"`
+	StatusCode      int               `json:"statusCode"`
+	ResponseHeaders map[string]string `json:"responseHeaders,omitempty"`
+	ResponseBody    string            `json:"responseBody,omitempty"`
+
+	// used tracks whether this interaction has been replayed
+	used bool
+}
+
+// recordedHeaders are the response headers which are recorded, since these are used by the Azure SDK
+// (for example for polling Long Running Operations)
+var recordedHeaders = []string{
+	"Azure-AsyncOperation",
+	"Content-Type",
+	"ETag",
+	"Location",
+	"Operation-Location",
+}
+
+// secretPatterns match values which are sensitive, and which are always removed from the cassettes
+var secretPatterns = []*regexp.Regexp{
+	// JSON Web Tokens
+	regexp.MustCompile(`eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]*`),
+
+	// JSON properties containing keys, secrets, passwords, tokens and connection strings
+	regexp.MustCompile(`(?i)("[a-z0-9_]*(?:key|secret|password|token|connectionstring)"\s*:\s*")[^"]*(")`),
+
+	// Shared Access Signatures
+	regexp.MustCompile(`(?i)(sig=)[^&"\s]+()`),
+}
+
+const redacted = "{{redacted}}"
+
+// scrubber replaces the values which are unique to an environment or test run (e.g. the Subscription ID
+// or the random suffixes used in resource names) with placeholders - and vice versa
+type scrubber struct {
+	// values is a map of the placeholder name to the value, e.g. `subscription_id` to a UUID
+	values map[string]string
+}
+
+func placeholder(name string) string {
+	return fmt.Sprintf("{{%s}}", name)
+}
+
+// scrub replaces the values with their placeholders, and removes any secrets
+func (s scrubber) scrub(input string) string {
+	for _, pattern := range secretPatterns {
+		if pattern.NumSubexp() == 2 {
+			input = pattern.ReplaceAllString(input, "${1}"+redacted+"${2}")
+		} else {
+			input = pattern.ReplaceAllString(input, redacted)
+		}
+	}
+
+	// replace the longest values first, since these can contain the shorter values
+	for _, name := range s.namesByLength() {
+		value := s.values[name]
+		input = regexp.MustCompile(`(?i)`+regexp.QuoteMeta(value)).ReplaceAllString(input, placeholder(name))
+	}
+
+	return input
+}
+
+// restore replaces the placeholders with their values
+func (s scrubber) restore(input string) string {
+	for name, value := range s.values {
+		input = strings.ReplaceAll(input, placeholder(name), value)
+	}
+
+	return input
+}
+
+// contains returns whether the input contains any of the values which are unique to this test
+func (s scrubber) contains(input string, names []string) bool {
+	input = strings.ToLower(input)
+	for _, name := range names {
+		if value, ok := s.values[name]; ok && value != "" && strings.Contains(input, strings.ToLower(value)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s scrubber) namesByLength() []string {
+	names := make([]string, 0, len(s.values))
+	for name, value := range s.values {
+		if value == "" {
+			continue
+		}
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if len(s.values[names[i]]) != len(s.values[names[j]]) {
+			return len(s.values[names[i]]) > len(s.values[names[j]])
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+func newInteraction(s scrubber, req *http.Request, requestBody []byte, resp *http.Response, responseBody []byte) Interaction {
+	headers := make(map[string]string)
+	for _, header := range recordedHeaders {
+		if v := resp.Header.Get(header); v != "" {
+			headers[header] = s.scrub(v)
+		}
+	}
+
+	return Interaction{
+		Method:          req.Method,
+		URL:             s.scrub(req.URL.String()),
+		RequestBody:     s.scrub(string(requestBody)),
+		StatusCode:      resp.StatusCode,
+		ResponseHeaders: headers,
+		ResponseBody:    s.scrub(string(responseBody)),
+	}
+}
+
+func cassetteFileName(testName string) string {
+	return filepath.Join(cassettePath(), strings.ReplaceAll(testName, "/", "_")+".json")
+}
+
+func loadCassette(testName string) (*Cassette, error) {
+	data, err := ioutil.ReadFile(cassetteFileName(testName))
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette for %q: %+v", testName, err)
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("deserializing cassette for %q: %+v", testName, err)
+	}
+
+	return &cassette, nil
+}
+
+func (c *Cassette) save(testName string) error {
+	fileName := cassetteFileName(testName)
+	if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+		return fmt.Errorf("creating directory for cassette %q: %+v", fileName, err)
+	}
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing cassette %q: %+v", fileName, err)
+	}
+
+	return ioutil.WriteFile(fileName, data, 0600)
+}
@@ -0,0 +1,55 @@
+package recording
+
+import (
+	"os"
+	"strings"
+)
+
+const (
+	// modeEnvVar is the environment variable used to enable recording or replaying the requests
+	// made to the Azure APIs during the Acceptance Tests - either `record` or `replay`
+	modeEnvVar = "ARM_PROVIDER_RECORDING_MODE"
+
+	// pathEnvVar is the environment variable used to override the directory which the cassettes
+	// are stored within - which defaults to `testdata/recordings` within the package being tested
+	pathEnvVar = "ARM_PROVIDER_RECORDING_PATH"
+
+	defaultPath = "testdata/recordings"
+)
+
+// Mode is the Recording Mode for the requests made to the Azure APIs
+type Mode string
+
+const (
+	// ModeDisabled means that requests are sent to the Azure APIs as usual
+	ModeDisabled Mode = ""
+
+	// ModeRecord means that requests are sent to the Azure APIs and the (scrubbed) interactions
+	// are recorded to a cassette for each test
+	ModeRecord Mode = "record"
+
+	// ModeReplay means that no requests are sent to the Azure APIs - instead the responses are
+	// replayed from the cassette for each test
+	ModeReplay Mode = "replay"
+)
+
+// ModeFromEnvironment returns the Recording Mode configured using the `ARM_PROVIDER_RECORDING_MODE`
+// environment variable
+func ModeFromEnvironment() Mode {
+	switch strings.ToLower(os.Getenv(modeEnvVar)) {
+	case string(ModeRecord):
+		return ModeRecord
+	case string(ModeReplay):
+		return ModeReplay
+	}
+
+	return ModeDisabled
+}
+
+func cassettePath() string {
+	if v := os.Getenv(pathEnvVar); v != "" {
+		return v
+	}
+
+	return defaultPath
+}
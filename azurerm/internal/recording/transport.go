@@ -0,0 +1,307 @@
+package recording
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// session is the recording (or replay) of the interactions for a single test
+type session struct {
+	testName string
+	cassette *Cassette
+
+	// names are the names of the values which are unique to this test (e.g. the random suffixes)
+	names []string
+}
+
+var (
+	lock = &sync.Mutex{}
+
+	// sessions are the tests which are currently running, keyed by the test name
+	sessions = make(map[string]*session)
+
+	// values are the values which are replaced with placeholders in the cassettes, keyed by the
+	// placeholder name - those which are unique to a test are prefixed with the test name
+	values = make(map[string]string)
+)
+
+// SetEnvironmentValue registers a value which is the same for all tests (for example the Subscription ID)
+// which is replaced with the specified placeholder in the cassettes
+func SetEnvironmentValue(name, value string) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	values[name] = value
+}
+
+// Start starts recording (or replaying) the interactions for the specified test - where the specified values
+// (for example the random suffixes used in resource names) are replaced with placeholders in the cassette,
+// which allows the cassette to be replayed with different values.
+//
+// The returned function must be called when the test completes, which saves the cassette when recording.
+func Start(testName string, testValues map[string]string) (func() error, error) {
+	mode := ModeFromEnvironment()
+	if mode == ModeDisabled {
+		return func() error { return nil }, nil
+	}
+
+	s := &session{
+		testName: testName,
+		cassette: &Cassette{},
+	}
+
+	if mode == ModeReplay {
+		cassette, err := loadCassette(testName)
+		if err != nil {
+			return nil, err
+		}
+		s.cassette = cassette
+	}
+
+	lock.Lock()
+	for name, value := range testValues {
+		s.addValue(name, value)
+	}
+	sessions[testName] = s
+	lock.Unlock()
+
+	return func() error {
+		return stop(mode, testName)
+	}, nil
+}
+
+// AddValue registers an additional value which is unique to the specified test (for example a random suffix
+// of a specific length) - which is replaced with the specified placeholder in the cassette
+func AddValue(testName, name, value string) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if s, ok := sessions[testName]; ok {
+		s.addValue(name, value)
+	}
+}
+
+func (s *session) addValue(name, value string) {
+	if value == "" {
+		return
+	}
+
+	key := fmt.Sprintf("%s/%s", s.testName, name)
+
+	// values which are generated multiple times (e.g. random strings of the same length) are numbered
+	for i := 2; ; i++ {
+		existing, exists := values[key]
+		if !exists {
+			break
+		}
+		if existing == value {
+			return
+		}
+		key = fmt.Sprintf("%s/%s_%d", s.testName, name, i)
+	}
+
+	s.names = append(s.names, key)
+	values[key] = value
+}
+
+func stop(mode Mode, testName string) error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	s, ok := sessions[testName]
+	if !ok {
+		return nil
+	}
+
+	delete(sessions, testName)
+	for _, name := range s.names {
+		delete(values, name)
+	}
+
+	if mode != ModeRecord {
+		return nil
+	}
+
+	return s.cassette.save(testName)
+}
+
+// scrubberForSession returns the scrubber for the specified test - where the placeholders for the values
+// unique to this test don't include the test name, so that these are portable across test runs
+func scrubberForSession(s *session) scrubber {
+	output := scrubber{
+		values: make(map[string]string),
+	}
+
+	for name, value := range values {
+		if !strings.Contains(name, "/") {
+			output.values[name] = value
+		}
+	}
+	for _, name := range s.names {
+		output.values[strings.TrimPrefix(name, s.testName+"/")] = values[name]
+	}
+
+	return output
+}
+
+// sessionsForRequest returns the tests which the request belongs to - which are the tests whose unique values
+// are contained within the request, or when the request can't be attributed to a test, all of the running tests
+func sessionsForRequest(req *http.Request, body []byte) []*session {
+	input := req.URL.String() + string(body)
+
+	attributed := make([]*session, 0)
+	all := make([]*session, 0, len(sessions))
+	for _, s := range sessions {
+		all = append(all, s)
+		if (scrubber{values: values}).contains(input, s.names) {
+			attributed = append(attributed, s)
+		}
+	}
+
+	output := attributed
+	if len(output) == 0 {
+		output = all
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].testName < output[j].testName
+	})
+	return output
+}
+
+// WithRecording returns a SendDecorator which records, or replays, the interactions with the Azure APIs
+// based on the configured Recording Mode
+func WithRecording(mode Mode) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		switch mode {
+		case ModeRecord:
+			return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+				return record(s, r)
+			})
+
+		case ModeReplay:
+			return autorest.SenderFunc(replay)
+		}
+
+		return s
+	}
+}
+
+func readRequestBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading request body: %+v", err)
+	}
+	r.Body.Close()
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+func record(sender autorest.Sender, r *http.Request) (*http.Response, error) {
+	requestBody, err := readRequestBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := sender.Do(r)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	var responseBody []byte
+	if resp.Body != nil {
+		responseBody, err = ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return resp, fmt.Errorf("reading response body: %+v", err)
+		}
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	for _, s := range sessionsForRequest(r, requestBody) {
+		interaction := newInteraction(scrubberForSession(s), r, requestBody, resp, responseBody)
+		s.cassette.Interactions = append(s.cassette.Interactions, interaction)
+	}
+
+	return resp, nil
+}
+
+func replay(r *http.Request) (*http.Response, error) {
+	requestBody, err := readRequestBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	for _, s := range sessionsForRequest(r, requestBody) {
+		scrubber := scrubberForSession(s)
+		url := scrubber.scrub(r.URL.String())
+
+		interaction := s.cassette.next(r.Method, url)
+		if interaction == nil {
+			continue
+		}
+
+		log.Printf("[DEBUG] Replaying %s %s from the cassette for %q", r.Method, url, s.testName)
+		return interaction.response(scrubber, r), nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction was found for %s %s - the cassettes need to be re-recorded by running the tests with `%s=%s`", r.Method, r.URL, modeEnvVar, ModeRecord)
+}
+
+// next returns the next unused interaction matching the method and (scrubbed) URL - or, once all of the
+// matching interactions have been replayed, the last matching interaction (for example when polling)
+func (c *Cassette) next(method, url string) *Interaction {
+	var last *Interaction
+	for i := range c.Interactions {
+		interaction := &c.Interactions[i]
+		if !strings.EqualFold(interaction.Method, method) || !strings.EqualFold(interaction.URL, url) {
+			continue
+		}
+
+		if !interaction.used {
+			interaction.used = true
+			return interaction
+		}
+		last = interaction
+	}
+
+	return last
+}
+
+func (i Interaction) response(s scrubber, r *http.Request) *http.Response {
+	header := make(http.Header)
+	for k, v := range i.ResponseHeaders {
+		header.Set(k, s.restore(v))
+	}
+
+	body := s.restore(i.ResponseBody)
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.StatusCode, http.StatusText(i.StatusCode)),
+		StatusCode:    i.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       r,
+	}
+}
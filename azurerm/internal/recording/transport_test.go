@@ -0,0 +1,94 @@
+package recording
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"` + r.URL.Path + `","accessToken":"eyJhbGciOiJub25lIn0.eyJzdWIiOiJleGFtcGxlIn0.c2ln"}`))
+	}))
+	defer server.Close()
+
+	os.Setenv(pathEnvVar, t.TempDir())
+	defer os.Unsetenv(pathEnvVar)
+	defer os.Unsetenv(modeEnvVar)
+	SetEnvironmentValue("subscription_id", "11111111-2222-3333-4444-555555555555")
+
+	send := func(mode Mode, randomInteger string) string {
+		stop, err := Start(t.Name(), map[string]string{
+			"random_integer": randomInteger,
+		})
+		if err != nil {
+			t.Fatalf("starting: %+v", err)
+		}
+
+		sender := autorest.DecorateSender(http.DefaultClient, WithRecording(mode))
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/subscriptions/11111111-2222-3333-4444-555555555555/resourceGroups/acctestRG-"+randomInteger, nil)
+		resp, err := sender.Do(req)
+		if err != nil {
+			t.Fatalf("sending: %+v", err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+
+		if err := stop(); err != nil {
+			t.Fatalf("stopping: %+v", err)
+		}
+		return string(body)
+	}
+
+	os.Setenv(modeEnvVar, string(ModeRecord))
+	recorded := send(ModeRecord, "210101123456789012")
+	if !strings.Contains(recorded, "acctestRG-210101123456789012") {
+		t.Fatalf("Expected the recorded response to be returned but got %q", recorded)
+	}
+
+	data, err := ioutil.ReadFile(cassetteFileName(t.Name()))
+	if err != nil {
+		t.Fatalf("reading cassette: %+v", err)
+	}
+	for _, unexpected := range []string{"210101123456789012", "11111111-2222-3333-4444-555555555555", "eyJ"} {
+		if strings.Contains(string(data), unexpected) {
+			t.Fatalf("Expected %q to be scrubbed from the cassette: %s", unexpected, string(data))
+		}
+	}
+
+	// the server is no longer available, so the response must be replayed from the cassette
+	server.Close()
+	os.Setenv(modeEnvVar, string(ModeReplay))
+	replayed := send(ModeReplay, "210202987654321098")
+	if !strings.Contains(replayed, "acctestRG-210202987654321098") {
+		t.Fatalf("Expected the replayed response to contain the new random integer but got %q", replayed)
+	}
+}
+
+func TestReplayWithoutRecordedInteraction(t *testing.T) {
+	os.Setenv(pathEnvVar, t.TempDir())
+	defer os.Unsetenv(pathEnvVar)
+
+	if err := (&Cassette{}).save(t.Name()); err != nil {
+		t.Fatalf("saving cassette: %+v", err)
+	}
+
+	os.Setenv(modeEnvVar, string(ModeReplay))
+	defer os.Unsetenv(modeEnvVar)
+	stop, err := Start(t.Name(), nil)
+	if err != nil {
+		t.Fatalf("starting: %+v", err)
+	}
+	defer stop() // nolint errcheck
+
+	sender := autorest.DecorateSender(http.DefaultClient, WithRecording(ModeReplay))
+	req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/example", nil)
+	if _, err := sender.Do(req); err == nil {
+		t.Fatalf("Expected an error when no interaction was recorded but didn't get one")
+	}
+}
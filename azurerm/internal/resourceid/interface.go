@@ -1,5 +1,6 @@
 package resourceid
 
+// Formatter is implemented by Resource ID types, returning the Resource ID as a string
 type Formatter interface {
 	ID() string
 }
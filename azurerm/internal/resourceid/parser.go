@@ -0,0 +1,142 @@
+package resourceid
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Parser parses Resource IDs made up of an ordered list of Segments
+type Parser struct {
+	segments []Segment
+}
+
+// ParseResult is the result of parsing a Resource ID
+type ParseResult struct {
+	// Parsed is a map of the Segment Name to the value parsed from the Resource ID - for Static and
+	// Resource Provider Segments this is the value as specified in the Resource ID
+	Parsed map[string]string
+}
+
+// NewParser returns a Parser for the Segments of the specified Resource ID type
+func NewParser(id Segmenter) Parser {
+	return Parser{
+		segments: id.Segments(),
+	}
+}
+
+// Parse parses the specified Resource ID - when `insensitively` is true the Static and Resource Provider
+// Segments are matched case-insensitively, which should only be used to parse a Resource ID for rewriting
+func (p Parser) Parse(input string, insensitively bool) (*ParseResult, error) {
+	if input == "" {
+		return nil, fmt.Errorf("cannot parse an empty string")
+	}
+
+	scopes := 0
+	for _, segment := range p.segments {
+		if segment.Type == SegmentTypeScope {
+			scopes++
+		}
+	}
+	if scopes > 1 {
+		return nil, fmt.Errorf("a Resource ID can contain at most one Scope segment but got %d", scopes)
+	}
+
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Azure ID %q: %+v", input, err)
+	}
+
+	path := strings.TrimSuffix(strings.TrimPrefix(idURL.Path, "/"), "/")
+	components := make([]string, 0)
+	if path != "" {
+		components = strings.Split(path, "/")
+	}
+
+	// the Scope spans all of the components which aren't matched by the other segments
+	scopeLength := len(components) - (len(p.segments) - scopes)
+	if scopeLength < 0 {
+		scopeLength = 0
+	}
+
+	result := ParseResult{
+		Parsed: make(map[string]string),
+	}
+
+	current := 0
+	for _, segment := range p.segments {
+		if segment.Type == SegmentTypeScope {
+			scope := components[current : current+scopeLength]
+			for _, v := range scope {
+				if v == "" {
+					return nil, fmt.Errorf("parsing %q: the %s contained an empty segment", input, segment)
+				}
+			}
+			result.Parsed[segment.Name] = "/" + strings.Join(scope, "/")
+			current += scopeLength
+			continue
+		}
+
+		if current >= len(components) {
+			return nil, fmt.Errorf("parsing %q: the %s was missing", input, segment)
+		}
+		value := components[current]
+		current++
+
+		switch segment.Type {
+		case SegmentTypeResourceProvider, SegmentTypeStatic:
+			matches := value == segment.FixedValue
+			if insensitively || segment.CaseInsensitive {
+				matches = strings.EqualFold(value, segment.FixedValue)
+			}
+			if !matches {
+				return nil, fmt.Errorf("parsing %q: expected the %s but got %q", input, segment, value)
+			}
+
+		case SegmentTypeUserSpecified:
+			if value == "" {
+				return nil, fmt.Errorf("parsing %q: the value for the %s was empty", input, segment)
+			}
+
+		default:
+			return nil, fmt.Errorf("internal-error: unimplemented Segment Type %q", string(segment.Type))
+		}
+
+		result.Parsed[segment.Name] = value
+	}
+
+	if current < len(components) {
+		return nil, fmt.Errorf("parsing %q: the ID contained more segments than expected: %q", input, strings.Join(components[current:], "/"))
+	}
+
+	return &result, nil
+}
+
+// Format returns the Resource ID for the Segments of the specified Resource ID type, using the specified
+// values (keyed by the Segment Name) for the Scope and User Specified Segments
+func Format(id Segmenter, values map[string]string) (string, error) {
+	components := make([]string, 0)
+	for _, segment := range id.Segments() {
+		switch segment.Type {
+		case SegmentTypeResourceProvider, SegmentTypeStatic:
+			components = append(components, segment.FixedValue)
+
+		case SegmentTypeScope:
+			if scope := strings.Trim(values[segment.Name], "/"); scope != "" {
+				components = append(components, scope)
+			}
+
+		case SegmentTypeUserSpecified:
+			value := values[segment.Name]
+			if value == "" {
+				return "", fmt.Errorf("a value must be specified for the %s", segment)
+			}
+			components = append(components, value)
+
+		default:
+			return "", fmt.Errorf("internal-error: unimplemented Segment Type %q", string(segment.Type))
+		}
+	}
+
+	return "/" + strings.Join(components, "/"), nil
+}
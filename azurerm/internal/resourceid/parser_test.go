@@ -0,0 +1,264 @@
+package resourceid
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testSegmenter []Segment
+
+func (s testSegmenter) Segments() []Segment {
+	return s
+}
+
+var (
+	testResourceGroupId = testSegmenter{
+		StaticSegment("subscriptions"),
+		UserSpecifiedSegment("subscriptionId"),
+		StaticSegment("resourceGroups").Insensitively(),
+		UserSpecifiedSegment("resourceGroup"),
+	}
+
+	testServiceBusSubscriptionId = testSegmenter{
+		StaticSegment("subscriptions"),
+		UserSpecifiedSegment("subscriptionId"),
+		StaticSegment("resourceGroups"),
+		UserSpecifiedSegment("resourceGroup"),
+		StaticSegment("providers"),
+		ResourceProviderSegment("Microsoft.ServiceBus"),
+		StaticSegment("namespaces"),
+		UserSpecifiedSegment("namespaceName"),
+		StaticSegment("topics"),
+		UserSpecifiedSegment("topicName"),
+		StaticSegment("subscriptions"),
+		UserSpecifiedSegment("name"),
+	}
+
+	testRoleAssignmentId = testSegmenter{
+		ScopeSegment("scope"),
+		StaticSegment("providers"),
+		ResourceProviderSegment("Microsoft.Authorization"),
+		StaticSegment("roleAssignments"),
+		UserSpecifiedSegment("name"),
+	}
+)
+
+func TestParse(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Segmenter     Segmenter
+		Input         string
+		Insensitively bool
+		Error         bool
+		Expected      map[string]string
+	}{
+		{
+			Name:      "empty",
+			Segmenter: testResourceGroupId,
+			Input:     "",
+			Error:     true,
+		},
+		{
+			Name:      "resource group",
+			Segmenter: testResourceGroupId,
+			Input:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			Expected: map[string]string{
+				"subscriptions":  "subscriptions",
+				"subscriptionId": "12345678-1234-9876-4563-123456789012",
+				"resourceGroups": "resourceGroups",
+				"resourceGroup":  "group1",
+			},
+		},
+		{
+			Name:      "resource group with a trailing slash",
+			Segmenter: testResourceGroupId,
+			Input:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/",
+			Expected: map[string]string{
+				"subscriptions":  "subscriptions",
+				"subscriptionId": "12345678-1234-9876-4563-123456789012",
+				"resourceGroups": "resourceGroups",
+				"resourceGroup":  "group1",
+			},
+		},
+		{
+			Name:      "resource group with a case-insensitive segment",
+			Segmenter: testResourceGroupId,
+			Input:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1",
+			Expected: map[string]string{
+				"subscriptions":  "subscriptions",
+				"subscriptionId": "12345678-1234-9876-4563-123456789012",
+				"resourceGroups": "resourcegroups",
+				"resourceGroup":  "group1",
+			},
+		},
+		{
+			Name:      "resource group missing the value",
+			Segmenter: testResourceGroupId,
+			Input:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error:     true,
+		},
+		{
+			Name:      "resource group with additional segments",
+			Segmenter: testResourceGroupId,
+			Input:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Resources",
+			Error:     true,
+		},
+		{
+			Name:      "upper-cased",
+			Segmenter: testResourceGroupId,
+			Input:     "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1",
+			Error:     true,
+		},
+		{
+			Name:          "upper-cased insensitively",
+			Segmenter:     testResourceGroupId,
+			Input:         "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1",
+			Insensitively: true,
+			Expected: map[string]string{
+				"subscriptions":  "SUBSCRIPTIONS",
+				"subscriptionId": "12345678-1234-9876-4563-123456789012",
+				"resourceGroups": "RESOURCEGROUPS",
+				"resourceGroup":  "GROUP1",
+			},
+		},
+		{
+			Name:      "service bus subscription",
+			Segmenter: testServiceBusSubscriptionId,
+			Input:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/microsoft.servicebus/namespaces/namespace1/topics/topic1/subscriptions/subscription1",
+			Expected: map[string]string{
+				"subscriptions":        "subscriptions",
+				"subscriptionId":       "12345678-1234-9876-4563-123456789012",
+				"resourceGroups":       "resourceGroups",
+				"resourceGroup":        "group1",
+				"providers":            "providers",
+				"Microsoft.ServiceBus": "microsoft.servicebus",
+				"namespaces":           "namespaces",
+				"namespaceName":        "namespace1",
+				"topics":               "topics",
+				"topicName":            "topic1",
+				"name":                 "subscription1",
+			},
+		},
+		{
+			Name:      "role assignment at the tenant scope",
+			Segmenter: testRoleAssignmentId,
+			Input:     "/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			Expected: map[string]string{
+				"scope":                   "/",
+				"providers":               "providers",
+				"Microsoft.Authorization": "Microsoft.Authorization",
+				"roleAssignments":         "roleAssignments",
+				"name":                    "assignment1",
+			},
+		},
+		{
+			Name:      "role assignment at a management group scope",
+			Segmenter: testRoleAssignmentId,
+			Input:     "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			Expected: map[string]string{
+				"scope":                   "/providers/Microsoft.Management/managementGroups/group1",
+				"providers":               "providers",
+				"Microsoft.Authorization": "Microsoft.Authorization",
+				"roleAssignments":         "roleAssignments",
+				"name":                    "assignment1",
+			},
+		},
+		{
+			Name:      "role assignment at a resource scope",
+			Segmenter: testRoleAssignmentId,
+			Input:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			Expected: map[string]string{
+				"scope":                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1",
+				"providers":               "providers",
+				"Microsoft.Authorization": "Microsoft.Authorization",
+				"roleAssignments":         "roleAssignments",
+				"name":                    "assignment1",
+			},
+		},
+		{
+			Name:      "role assignment missing the name",
+			Segmenter: testRoleAssignmentId,
+			Input:     "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments",
+			Error:     true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := NewParser(v.Segmenter).Parse(v.Input, v.Insensitively)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(actual.Parsed, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual.Parsed)
+		}
+	}
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	testData := []struct {
+		Segmenter Segmenter
+		Input     string
+	}{
+		{
+			Segmenter: testResourceGroupId,
+			Input:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+		},
+		{
+			Segmenter: testServiceBusSubscriptionId,
+			Input:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1/subscriptions/subscription1",
+		},
+		{
+			Segmenter: testRoleAssignmentId,
+			Input:     "/providers/Microsoft.Authorization/roleAssignments/assignment1",
+		},
+		{
+			Segmenter: testRoleAssignmentId,
+			Input:     "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/assignment1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		parsed, err := NewParser(v.Segmenter).Parse(v.Input, false)
+		if err != nil {
+			t.Fatalf("parsing: %+v", err)
+		}
+
+		actual, err := Format(v.Segmenter, parsed.Parsed)
+		if err != nil {
+			t.Fatalf("formatting: %+v", err)
+		}
+		if actual != v.Input {
+			t.Fatalf("Expected %q but got %q", v.Input, actual)
+		}
+	}
+}
+
+func TestParseMultipleScopes(t *testing.T) {
+	segmenter := testSegmenter{
+		ScopeSegment("first"),
+		StaticSegment("example"),
+		ScopeSegment("second"),
+	}
+
+	if _, err := NewParser(segmenter).Parse("/a/example/b", false); err == nil {
+		t.Fatalf("Expected an error when multiple Scope segments are defined but didn't get one")
+	}
+}
+
+func TestFormatMissingValue(t *testing.T) {
+	if _, err := Format(testResourceGroupId, map[string]string{"subscriptionId": "12345678-1234-9876-4563-123456789012"}); err == nil {
+		t.Fatalf("Expected an error when a value is missing but didn't get one")
+	}
+}
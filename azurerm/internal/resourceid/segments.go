@@ -0,0 +1,95 @@
+package resourceid
+
+import "fmt"
+
+// SegmentType is the type of a Segment within a Resource ID
+type SegmentType string
+
+const (
+	// SegmentTypeResourceProvider is a Resource Provider Namespace, e.g. `Microsoft.Compute`
+	SegmentTypeResourceProvider SegmentType = "ResourceProvider"
+
+	// SegmentTypeScope is a Scope which can span any number of segments (including none), e.g. the
+	// Resource ID of the Resource which an Extension Resource (such as a Role Assignment) is assigned to
+	SegmentTypeScope SegmentType = "Scope"
+
+	// SegmentTypeStatic is a fixed value, e.g. `resourceGroups` or `virtualMachines`
+	SegmentTypeStatic SegmentType = "Static"
+
+	// SegmentTypeUserSpecified is a value specified by the user, e.g. the name of a Resource Group
+	SegmentTypeUserSpecified SegmentType = "UserSpecified"
+)
+
+// Segment is a single segment (or, for a Scope, several segments) within a Resource ID
+type Segment struct {
+	// Name is the name of this Segment, which the value is keyed by when parsed - for Static and
+	// Resource Provider Segments this is the fixed value
+	Name string
+
+	// Type is the type of this Segment
+	Type SegmentType
+
+	// FixedValue is the value of Static and Resource Provider Segments
+	FixedValue string
+
+	// CaseInsensitive specifies whether the FixedValue should be matched insensitively, even when the
+	// Resource ID isn't being parsed insensitively - for example as some APIs return `resourcegroups`
+	CaseInsensitive bool
+}
+
+// ResourceProviderSegment returns a Segment for the specified Resource Provider Namespace - which is matched
+// insensitively, since Azure Resource Manager treats Resource Provider Namespaces as case-insensitive
+func ResourceProviderSegment(namespace string) Segment {
+	return Segment{
+		Name:            namespace,
+		Type:            SegmentTypeResourceProvider,
+		FixedValue:      namespace,
+		CaseInsensitive: true,
+	}
+}
+
+// ScopeSegment returns a Segment for a Scope with the specified name
+func ScopeSegment(name string) Segment {
+	return Segment{
+		Name: name,
+		Type: SegmentTypeScope,
+	}
+}
+
+// StaticSegment returns a Segment with the specified fixed value
+func StaticSegment(value string) Segment {
+	return Segment{
+		Name:       value,
+		Type:       SegmentTypeStatic,
+		FixedValue: value,
+	}
+}
+
+// UserSpecifiedSegment returns a Segment for a user-specified value with the specified name
+func UserSpecifiedSegment(name string) Segment {
+	return Segment{
+		Name: name,
+		Type: SegmentTypeUserSpecified,
+	}
+}
+
+// Insensitively returns a copy of this Segment whose fixed value is matched case-insensitively
+func (s Segment) Insensitively() Segment {
+	s.CaseInsensitive = true
+	return s
+}
+
+func (s Segment) String() string {
+	switch s.Type {
+	case SegmentTypeResourceProvider, SegmentTypeStatic:
+		return fmt.Sprintf("%s segment %q", s.Type, s.FixedValue)
+	}
+
+	return fmt.Sprintf("%s segment %q", s.Type, s.Name)
+}
+
+// Segmenter is implemented by Resource ID types which are made up of an ordered list of Segments
+type Segmenter interface {
+	// Segments returns the ordered list of Segments which make up this Resource ID
+	Segments() []Segment
+}
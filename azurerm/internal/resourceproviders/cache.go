@@ -21,3 +21,14 @@ func CacheSupportedProviders(ctx context.Context, client *resources.ProvidersCli
 
 	cachedResourceProviders = providers
 }
+
+// CachedSupportedProviders returns the cached list of supported Resource Providers, which can be (validly) nil
+func CachedSupportedProviders() *[]string {
+	return cachedResourceProviders
+}
+
+// SetSupportedProviders caches the specified supported Resource Providers, for use in enhanced validation
+// this allows the supported Resource Providers to be loaded from elsewhere, for example from an on-disk cache
+func SetSupportedProviders(providers []string) {
+	cachedResourceProviders = &providers
+}
@@ -0,0 +1,245 @@
+package resourceproviders
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// cacheFilePathEnvVar is the environment variable used to specify the path to the on-disk cache
+	cacheFilePathEnvVar = "ARM_PROVIDER_REGISTRATION_CACHE_PATH"
+
+	// cacheFileTTLEnvVar is the environment variable used to specify how long (e.g. `12h`) the cached
+	// values should be used for before they're refreshed from the Azure APIs
+	cacheFileTTLEnvVar = "ARM_PROVIDER_REGISTRATION_CACHE_TTL"
+
+	defaultCacheFileTTL = 24 * time.Hour
+)
+
+// CacheFile is an optional on-disk cache of the Resource Providers which are registered, and the
+// Resource Providers and Locations which are supported, for each Subscription and Azure Environment.
+//
+// This allows repeated runs (e.g. in CI) to skip listing the Resource Providers and Locations, and
+// allows Enhanced Validation to continue to work should retrieving these from the Azure APIs fail.
+type CacheFile struct {
+	// Path is the path to the JSON file used for the cache
+	Path string
+
+	// TTL is how long the cached values are valid for
+	TTL time.Duration
+
+	lock *sync.Mutex
+}
+
+// CacheEntry is the cached information for a single Subscription within an Azure Environment
+type CacheEntry struct {
+	// RegisteredProviders is the list of Resource Providers which are registered
+	RegisteredProviders *CachedValues `json:"registeredProviders,omitempty"`
+
+	// SupportedLocations is the list of Locations supported by this Azure Environment
+	SupportedLocations *CachedValues `json:"supportedLocations,omitempty"`
+
+	// SupportedProviders is the list of Resource Providers supported by this Azure Environment
+	SupportedProviders *CachedValues `json:"supportedProviders,omitempty"`
+}
+
+// CachedValues is a list of values which were cached at a point in time
+type CachedValues struct {
+	// RequiredProviders is the list of Resource Providers required by the Provider when these values were cached
+	// this is used to invalidate the cache when a new Resource Provider is required
+	RequiredProviders []string `json:"requiredProviders"`
+
+	// UpdatedAt is the time at which these values were cached
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Values are the cached values
+	Values []string `json:"values"`
+}
+
+// NewCachedValues returns a CachedValues for the specified values, cached at the current time
+func NewCachedValues(values []string) *CachedValues {
+	return NewCachedValuesForRequiredProviders(values, Required())
+}
+
+// NewCachedValuesForRequiredProviders returns a CachedValues for the specified values, cached at the
+// current time, when a custom list of Resource Providers is required
+func NewCachedValuesForRequiredProviders(values []string, requiredProviders map[string]struct{}) *CachedValues {
+	return &CachedValues{
+		RequiredProviders: sortedKeys(requiredProviders),
+		UpdatedAt:         time.Now().UTC(),
+		Values:            values,
+	}
+}
+
+type cacheFileContents struct {
+	Entries map[string]CacheEntry `json:"entries"`
+}
+
+var cacheFileLock = &sync.Mutex{}
+
+// CacheFileFromEnvironment returns the CacheFile configured using the `ARM_PROVIDER_REGISTRATION_CACHE_PATH`
+// and `ARM_PROVIDER_REGISTRATION_CACHE_TTL` environment variables - or nil if the cache isn't enabled
+func CacheFileFromEnvironment() *CacheFile {
+	path := os.Getenv(cacheFilePathEnvVar)
+	if path == "" {
+		return nil
+	}
+
+	ttl := defaultCacheFileTTL
+	if v := os.Getenv(cacheFileTTLEnvVar); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil {
+			log.Printf("[WARN] parsing %q as a duration for %s: %+v - using the default of %s", v, cacheFileTTLEnvVar, err, defaultCacheFileTTL)
+		} else {
+			ttl = parsed
+		}
+	}
+
+	return &CacheFile{
+		Path: path,
+		TTL:  ttl,
+		lock: cacheFileLock,
+	}
+}
+
+// IsFresh returns whether the cached values are within the TTL and include all of the Resource Providers
+// which are currently required - it's safe to call this on a nil CacheFile, which always returns false
+func (f *CacheFile) IsFresh(values *CachedValues) bool {
+	return f.IsFreshForRequiredProviders(values, Required())
+}
+
+// IsFreshForRequiredProviders returns whether the cached values are within the TTL and include all of the
+// specified Resource Providers - it's safe to call this on a nil CacheFile, which always returns false
+func (f *CacheFile) IsFreshForRequiredProviders(values *CachedValues, requiredProviders map[string]struct{}) bool {
+	if f == nil || values == nil {
+		return false
+	}
+
+	if time.Since(values.UpdatedAt) > f.TTL {
+		return false
+	}
+
+	cachedRequiredProviders := make(map[string]struct{}, len(values.RequiredProviders))
+	for _, v := range values.RequiredProviders {
+		cachedRequiredProviders[v] = struct{}{}
+	}
+	for v := range requiredProviders {
+		if _, ok := cachedRequiredProviders[v]; !ok {
+			log.Printf("[DEBUG] the Resource Provider %q is now required - invalidating the cache", v)
+			return false
+		}
+	}
+
+	return true
+}
+
+// Get returns the cached information for the specified Azure Environment and Subscription
+// it's safe to call this on a nil CacheFile, which always returns an empty CacheEntry
+func (f *CacheFile) Get(environment, subscriptionId string) CacheEntry {
+	if f == nil {
+		return CacheEntry{}
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	contents, err := f.read()
+	if err != nil {
+		log.Printf("[WARN] reading the Resource Provider cache from %q: %+v", f.Path, err)
+		return CacheEntry{}
+	}
+
+	return contents.Entries[cacheKey(environment, subscriptionId)]
+}
+
+// Update updates the cached information for the specified Azure Environment and Subscription
+// it's safe to call this on a nil CacheFile, which is a no-op
+func (f *CacheFile) Update(environment, subscriptionId string, update func(entry *CacheEntry)) {
+	if f == nil {
+		return
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	contents, err := f.read()
+	if err != nil {
+		log.Printf("[WARN] reading the Resource Provider cache from %q - this will be overwritten: %+v", f.Path, err)
+		contents = &cacheFileContents{}
+	}
+	if contents.Entries == nil {
+		contents.Entries = make(map[string]CacheEntry)
+	}
+
+	key := cacheKey(environment, subscriptionId)
+	entry := contents.Entries[key]
+	update(&entry)
+	contents.Entries[key] = entry
+
+	if err := f.write(*contents); err != nil {
+		log.Printf("[WARN] writing the Resource Provider cache to %q: %+v", f.Path, err)
+	}
+}
+
+func (f *CacheFile) read() (*cacheFileContents, error) {
+	data, err := ioutil.ReadFile(f.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &cacheFileContents{}, nil
+		}
+
+		return nil, err
+	}
+
+	var contents cacheFileContents
+	if err := json.Unmarshal(data, &contents); err != nil {
+		return nil, fmt.Errorf("deserializing JSON: %+v", err)
+	}
+
+	return &contents, nil
+}
+
+func (f *CacheFile) write(contents cacheFileContents) error {
+	data, err := json.MarshalIndent(contents, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing JSON: %+v", err)
+	}
+
+	// write to a temporary file and then rename it, so that concurrent runs never see a partial file
+	tempFile, err := ioutil.TempFile(filepath.Dir(f.Path), filepath.Base(f.Path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("creating temporary file: %+v", err)
+	}
+	defer os.Remove(tempFile.Name())
+
+	if _, err := tempFile.Write(data); err != nil {
+		tempFile.Close()
+		return fmt.Errorf("writing temporary file: %+v", err)
+	}
+	if err := tempFile.Close(); err != nil {
+		return fmt.Errorf("closing temporary file: %+v", err)
+	}
+
+	return os.Rename(tempFile.Name(), f.Path)
+}
+
+func cacheKey(environment, subscriptionId string) string {
+	return fmt.Sprintf("%s/%s", strings.ToLower(environment), strings.ToLower(subscriptionId))
+}
+
+func sortedKeys(input map[string]struct{}) []string {
+	out := make([]string, 0, len(input))
+	for k := range input {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,121 @@
+package resourceproviders
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCacheFileRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	cacheFile := &CacheFile{
+		Path: filepath.Join(dir, "cache.json"),
+		TTL:  time.Hour,
+		lock: cacheFileLock,
+	}
+
+	entry := cacheFile.Get("public", "00000000-0000-0000-0000-000000000000")
+	if entry.RegisteredProviders != nil || entry.SupportedLocations != nil || entry.SupportedProviders != nil {
+		t.Fatalf("expected an empty entry but got %+v", entry)
+	}
+
+	cacheFile.Update("public", "00000000-0000-0000-0000-000000000000", func(entry *CacheEntry) {
+		entry.SupportedLocations = NewCachedValues([]string{"westeurope", "northeurope"})
+	})
+	cacheFile.Update("public", "00000000-0000-0000-0000-000000000000", func(entry *CacheEntry) {
+		entry.RegisteredProviders = NewCachedValues(RequiredAsList())
+	})
+	cacheFile.Update("china", "00000000-0000-0000-0000-000000000000", func(entry *CacheEntry) {
+		entry.SupportedLocations = NewCachedValues([]string{"chinaeast"})
+	})
+
+	entry = cacheFile.Get("PUBLIC", "00000000-0000-0000-0000-000000000000")
+	if entry.SupportedLocations == nil || !reflect.DeepEqual(entry.SupportedLocations.Values, []string{"westeurope", "northeurope"}) {
+		t.Fatalf("expected the Supported Locations to be cached but got %+v", entry.SupportedLocations)
+	}
+	if !cacheFile.IsFresh(entry.RegisteredProviders) {
+		t.Fatalf("expected the Registered Providers to be fresh")
+	}
+
+	entry = cacheFile.Get("china", "00000000-0000-0000-0000-000000000000")
+	if entry.SupportedLocations == nil || !reflect.DeepEqual(entry.SupportedLocations.Values, []string{"chinaeast"}) {
+		t.Fatalf("expected the Supported Locations to be cached but got %+v", entry.SupportedLocations)
+	}
+	if entry.RegisteredProviders != nil {
+		t.Fatalf("expected the Registered Providers not to be cached but got %+v", entry.RegisteredProviders)
+	}
+}
+
+func TestCacheFileIsFresh(t *testing.T) {
+	cacheFile := &CacheFile{
+		TTL: time.Hour,
+	}
+
+	if cacheFile.IsFresh(nil) {
+		t.Fatalf("expected nil values not to be fresh")
+	}
+
+	if !cacheFile.IsFresh(NewCachedValues([]string{})) {
+		t.Fatalf("expected new values to be fresh")
+	}
+
+	expired := NewCachedValues([]string{})
+	expired.UpdatedAt = time.Now().Add(-2 * time.Hour)
+	if cacheFile.IsFresh(expired) {
+		t.Fatalf("expected expired values not to be fresh")
+	}
+
+	missingRequiredProvider := NewCachedValues([]string{})
+	missingRequiredProvider.RequiredProviders = missingRequiredProvider.RequiredProviders[1:]
+	if cacheFile.IsFresh(missingRequiredProvider) {
+		t.Fatalf("expected values cached before a Resource Provider was required not to be fresh")
+	}
+
+	var disabled *CacheFile
+	if disabled.IsFresh(NewCachedValues([]string{})) {
+		t.Fatalf("expected a disabled cache never to be fresh")
+	}
+}
+
+func TestCacheFileInvalidContentsAreOverwritten(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cache.json")
+	if err := os.WriteFile(path, []byte("not-json"), 0600); err != nil {
+		t.Fatalf("writing file: %+v", err)
+	}
+	cacheFile := &CacheFile{
+		Path: path,
+		TTL:  time.Hour,
+		lock: cacheFileLock,
+	}
+
+	cacheFile.Update("public", "1234", func(entry *CacheEntry) {
+		entry.SupportedProviders = NewCachedValues([]string{"Microsoft.Compute"})
+	})
+
+	entry := cacheFile.Get("public", "1234")
+	if !cacheFile.IsFresh(entry.SupportedProviders) {
+		t.Fatalf("expected the Supported Providers to be cached")
+	}
+}
+
+func TestCacheFileIsFreshForRequiredProviders(t *testing.T) {
+	cacheFile := &CacheFile{
+		TTL: time.Hour,
+	}
+
+	requiredProviders := map[string]struct{}{
+		"Microsoft.Compute": {},
+	}
+	values := NewCachedValuesForRequiredProviders([]string{"microsoft.compute"}, requiredProviders)
+	if !cacheFile.IsFreshForRequiredProviders(values, requiredProviders) {
+		t.Fatalf("expected new values to be fresh")
+	}
+
+	requiredProviders["Microsoft.Web"] = struct{}{}
+	if cacheFile.IsFreshForRequiredProviders(values, requiredProviders) {
+		t.Fatalf("expected values cached before a Resource Provider was listed not to be fresh")
+	}
+}
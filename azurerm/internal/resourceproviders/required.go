@@ -75,3 +75,8 @@ func Required() map[string]struct{} {
 		"Microsoft.Web":                     {},
 	}
 }
+
+// RequiredAsList returns the Resource Providers used by the AzureRM Provider as a sorted list
+func RequiredAsList() []string {
+	return sortedKeys(Required())
+}
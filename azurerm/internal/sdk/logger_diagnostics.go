@@ -13,6 +13,22 @@ type DiagnosticsLogger struct {
 	diagnostics diag.Diagnostics
 }
 
+func (d *DiagnosticsLogger) Trace(message string) {
+	log.Printf("[TRACE] %s", message)
+}
+
+func (d *DiagnosticsLogger) Tracef(format string, args ...interface{}) {
+	log.Printf("[TRACE] "+format, args...)
+}
+
+func (d *DiagnosticsLogger) Debug(message string) {
+	log.Printf("[DEBUG] %s", message)
+}
+
+func (d *DiagnosticsLogger) Debugf(format string, args ...interface{}) {
+	log.Printf("[DEBUG] "+format, args...)
+}
+
 func (d *DiagnosticsLogger) Info(message string) {
 	log.Printf("[INFO] %s", message)
 }
@@ -38,3 +54,14 @@ func (d *DiagnosticsLogger) Warnf(format string, args ...interface{}) {
 		AttributePath: nil,
 	})
 }
+
+// NOTE: errors are returned from the ResourceFunc and surfaced as Diagnostics
+// by the wrapper - as such these are only logged, to avoid duplicate Diagnostics
+
+func (d *DiagnosticsLogger) Error(message string) {
+	log.Printf("[ERROR] %s", message)
+}
+
+func (d *DiagnosticsLogger) Errorf(format string, args ...interface{}) {
+	log.Printf("[ERROR] "+format, args...)
+}
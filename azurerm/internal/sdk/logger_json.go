@@ -0,0 +1,73 @@
+package sdk
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// jsonLogPathEnvVar is the environment variable used to specify the path which
+// the JSON Lines log output should be (appended) to
+const jsonLogPathEnvVar = "ARM_PROVIDER_JSON_LOG_PATH"
+
+var (
+	jsonLogSinkOnce sync.Once
+	jsonLogSink     *JSONLogSink
+)
+
+// defaultJSONLogSink returns the JSON Log Sink configured via the `ARM_PROVIDER_JSON_LOG_PATH`
+// environment variable - or nil when this isn't configured
+func defaultJSONLogSink() *JSONLogSink {
+	jsonLogSinkOnce.Do(func() {
+		path := os.Getenv(jsonLogPathEnvVar)
+		if path == "" {
+			return
+		}
+
+		file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			log.Printf("[WARN] opening JSON Log file %q: %+v - JSON Logging will be disabled", path, err)
+			return
+		}
+
+		jsonLogSink = NewJSONLogSink(file)
+	})
+
+	return jsonLogSink
+}
+
+// JSONLogSink writes each log message as a JSON object on a single line (JSON Lines)
+// so that the Provider's logs can be parsed per-resource
+type JSONLogSink struct {
+	encoder *json.Encoder
+	lock    *sync.Mutex
+}
+
+// NewJSONLogSink returns a JSONLogSink which writes to the specified Writer
+func NewJSONLogSink(writer io.Writer) *JSONLogSink {
+	return &JSONLogSink{
+		encoder: json.NewEncoder(writer),
+		lock:    &sync.Mutex{},
+	}
+}
+
+// Write writes the specified message and fields as a single JSON line
+func (s *JSONLogSink) Write(timestamp time.Time, level LogLevel, message string, fields LogFields) {
+	line := make(map[string]interface{}, len(fields)+3)
+	for k, v := range fields {
+		line[k] = v
+	}
+	line["@timestamp"] = timestamp.UTC().Format(time.RFC3339Nano)
+	line["@level"] = string(level)
+	line["@message"] = message
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if err := s.encoder.Encode(line); err != nil {
+		log.Printf("[WARN] writing JSON Log message: %+v", err)
+	}
+}
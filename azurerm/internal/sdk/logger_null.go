@@ -5,6 +5,24 @@ package sdk
 // to reduce console output
 type NullLogger struct{}
 
+// Trace prints out a message prefixed with `[TRACE]` verbatim
+func (NullLogger) Trace(_ string) {
+}
+
+// Tracef prints out a message prefixed with `[TRACE]` formatted
+// with the specified arguments
+func (NullLogger) Tracef(_ string, _ ...interface{}) {
+}
+
+// Debug prints out a message prefixed with `[DEBUG]` verbatim
+func (NullLogger) Debug(_ string) {
+}
+
+// Debugf prints out a message prefixed with `[DEBUG]` formatted
+// with the specified arguments
+func (NullLogger) Debugf(_ string, _ ...interface{}) {
+}
+
 // Info prints out a message prefixed with `[INFO]` verbatim
 func (NullLogger) Info(_ string) {
 }
@@ -22,3 +40,12 @@ func (NullLogger) Warn(_ string) {
 // with the specified arguments
 func (NullLogger) Warnf(_ string, _ ...interface{}) {
 }
+
+// Error prints out a message prefixed with `[ERROR]` verbatim
+func (NullLogger) Error(_ string) {
+}
+
+// Errorf prints out a message prefixed with `[ERROR]` formatted
+// with the specified arguments
+func (NullLogger) Errorf(_ string, _ ...interface{}) {
+}
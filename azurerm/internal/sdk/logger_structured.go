@@ -0,0 +1,199 @@
+package sdk
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+const (
+	LogFieldCorrelationRequestID = "correlation_request_id"
+	LogFieldOperation            = "operation"
+	LogFieldResourceID           = "resource_id"
+	LogFieldResourceType         = "resource_type"
+)
+
+// Operation is the Terraform operation being performed on a Resource or Data Source
+type Operation string
+
+const (
+	OperationCreate        Operation = "create"
+	OperationCustomizeDiff Operation = "customize_diff"
+	OperationDelete        Operation = "delete"
+	OperationImport        Operation = "import"
+	OperationRead          Operation = "read"
+	OperationUpdate        Operation = "update"
+)
+
+// LogFields are the structured fields which are attached to each log message
+type LogFields map[string]interface{}
+
+// resourceIdRetriever returns the ID of the current Resource, which is available
+// from both the ResourceData and ResourceDiff
+type resourceIdRetriever interface {
+	Id() string
+}
+
+var _ Logger = StructuredLogger{}
+
+// StructuredLogger provides a Logger implementation which attaches structured fields
+// (for example the Resource Type, Resource ID and Operation) to each log message
+//
+// Messages are written to the inner Logger with these fields appended - and when a
+// JSON Log Sink is configured (via the `ARM_PROVIDER_JSON_LOG_PATH` environment variable)
+// are also written as JSON Lines, to allow the Provider logs to be parsed per-resource
+type StructuredLogger struct {
+	fields LogFields
+	idFunc resourceIdRetriever
+	inner  Logger
+	sink   *JSONLogSink
+}
+
+// NewStructuredLogger returns a StructuredLogger which writes to the inner Logger,
+// attaching the Provider's Correlation Request ID to each log message
+func NewStructuredLogger(inner Logger) StructuredLogger {
+	return StructuredLogger{
+		fields: LogFields{
+			LogFieldCorrelationRequestID: common.CorrelationRequestID(),
+		},
+		inner: inner,
+		sink:  defaultJSONLogSink(),
+	}
+}
+
+// WithFields returns a copy of this Logger which attaches the specified fields to each log message
+func (l StructuredLogger) WithFields(fields LogFields) StructuredLogger {
+	combined := make(LogFields, len(l.fields)+len(fields))
+	for k, v := range l.fields {
+		combined[k] = v
+	}
+	for k, v := range fields {
+		combined[k] = v
+	}
+
+	l.fields = combined
+	return l
+}
+
+// withResource returns a copy of this Logger which attaches the Resource Type, Operation
+// and (the latest value of) the Resource ID to each log message
+func (l StructuredLogger) withResource(resourceType string, operation Operation, id resourceIdRetriever) StructuredLogger {
+	out := l.WithFields(LogFields{
+		LogFieldOperation:    string(operation),
+		LogFieldResourceType: resourceType,
+	})
+	out.idFunc = id
+	return out
+}
+
+// Fields returns the fields which are attached to each log message
+func (l StructuredLogger) Fields() LogFields {
+	out := make(LogFields, len(l.fields)+1)
+	for k, v := range l.fields {
+		out[k] = v
+	}
+
+	// the ID isn't available until the resource has been created - so this is looked up each time
+	if l.idFunc != nil {
+		if id := l.idFunc.Id(); id != "" {
+			out[LogFieldResourceID] = id
+		}
+	}
+
+	return out
+}
+
+func (l StructuredLogger) Trace(message string) {
+	l.log(LogLevelTrace, message)
+}
+
+func (l StructuredLogger) Tracef(format string, args ...interface{}) {
+	l.log(LogLevelTrace, fmt.Sprintf(format, args...))
+}
+
+func (l StructuredLogger) Debug(message string) {
+	l.log(LogLevelDebug, message)
+}
+
+func (l StructuredLogger) Debugf(format string, args ...interface{}) {
+	l.log(LogLevelDebug, fmt.Sprintf(format, args...))
+}
+
+func (l StructuredLogger) Info(message string) {
+	l.log(LogLevelInfo, message)
+}
+
+func (l StructuredLogger) Infof(format string, args ...interface{}) {
+	l.log(LogLevelInfo, fmt.Sprintf(format, args...))
+}
+
+func (l StructuredLogger) Warn(message string) {
+	l.log(LogLevelWarn, message)
+}
+
+func (l StructuredLogger) Warnf(format string, args ...interface{}) {
+	l.log(LogLevelWarn, fmt.Sprintf(format, args...))
+}
+
+func (l StructuredLogger) Error(message string) {
+	l.log(LogLevelError, message)
+}
+
+func (l StructuredLogger) Errorf(format string, args ...interface{}) {
+	l.log(LogLevelError, fmt.Sprintf(format, args...))
+}
+
+func (l StructuredLogger) log(level LogLevel, message string) {
+	fields := l.Fields()
+
+	if l.sink != nil {
+		l.sink.Write(time.Now(), level, message, fields)
+	}
+
+	if l.inner == nil {
+		return
+	}
+
+	// Warnings are surfaced to users (e.g. as Diagnostics) so are output verbatim
+	if level == LogLevelWarn {
+		l.inner.Warn(message)
+		return
+	}
+
+	formatted := fmt.Sprintf("%s (%s)", message, formatLogFields(fields))
+	switch level {
+	case LogLevelTrace:
+		l.inner.Trace(formatted)
+	case LogLevelDebug:
+		l.inner.Debug(formatted)
+	case LogLevelInfo:
+		l.inner.Info(formatted)
+	case LogLevelError:
+		l.inner.Error(formatted)
+	}
+}
+
+// formatLogFields returns the fields as `key=value` pairs, sorted by key for consistency
+func formatLogFields(fields LogFields) string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%v", k, fields[k]))
+	}
+
+	return strings.Join(pairs, " ")
+}
+
+// NewUntypedResourceLogger returns a Logger which can be used within Plugin SDK (untyped) Resources
+// to attach the Resource Type, Operation and (the latest value of) the Resource ID to each log message
+func NewUntypedResourceLogger(resourceType string, operation Operation, id resourceIdRetriever) StructuredLogger {
+	return NewStructuredLogger(ConsoleLogger{}).withResource(resourceType, operation, id)
+}
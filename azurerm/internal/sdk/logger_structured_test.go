@@ -0,0 +1,103 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testResourceIdRetriever struct {
+	id string
+}
+
+func (r *testResourceIdRetriever) Id() string {
+	return r.id
+}
+
+type testRecordingLogger struct {
+	NullLogger
+	messages []string
+}
+
+func (l *testRecordingLogger) Debug(message string) {
+	l.messages = append(l.messages, message)
+}
+
+func (l *testRecordingLogger) Warn(message string) {
+	l.messages = append(l.messages, message)
+}
+
+func TestStructuredLogger_TextOutput(t *testing.T) {
+	inner := &testRecordingLogger{}
+	id := &testResourceIdRetriever{}
+	logger := StructuredLogger{
+		fields: LogFields{
+			LogFieldCorrelationRequestID: "1234",
+		},
+		inner: inner,
+	}.withResource("azurerm_example", OperationCreate, id)
+
+	logger.Debugf("creating %s..", "example")
+
+	// the ID is looked up each time, since it's only available once the resource has been created
+	id.id = "/subscriptions/1234/resourceGroups/example"
+	logger.Debug("created")
+
+	logger.Warn("a warning")
+
+	expected := []string{
+		"creating example.. (correlation_request_id=1234 operation=create resource_type=azurerm_example)",
+		"created (correlation_request_id=1234 operation=create resource_id=/subscriptions/1234/resourceGroups/example resource_type=azurerm_example)",
+		"a warning",
+	}
+	if len(inner.messages) != len(expected) {
+		t.Fatalf("expected %d messages but got %d: %+v", len(expected), len(inner.messages), inner.messages)
+	}
+	for i, v := range expected {
+		if inner.messages[i] != v {
+			t.Fatalf("expected message %d to be %q but got %q", i, v, inner.messages[i])
+		}
+	}
+}
+
+func TestStructuredLogger_JSONOutput(t *testing.T) {
+	buf := &bytes.Buffer{}
+	id := &testResourceIdRetriever{
+		id: "/subscriptions/1234/resourceGroups/example",
+	}
+	logger := StructuredLogger{
+		sink: NewJSONLogSink(buf),
+	}.withResource("azurerm_example", OperationDelete, id)
+
+	logger.Errorf("deleting %s", "example")
+	logger.Trace("deleted")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines but got %d: %q", len(lines), buf.String())
+	}
+
+	var line map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &line); err != nil {
+		t.Fatalf("unmarshaling %q: %+v", lines[0], err)
+	}
+
+	expected := map[string]interface{}{
+		"@level":        "ERROR",
+		"@message":      "deleting example",
+		"operation":     "delete",
+		"resource_id":   "/subscriptions/1234/resourceGroups/example",
+		"resource_type": "azurerm_example",
+	}
+	for k, v := range expected {
+		if line[k] != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, line[k])
+		}
+	}
+
+	if _, err := time.Parse(time.RFC3339Nano, line["@timestamp"].(string)); err != nil {
+		t.Fatalf("parsing timestamp: %+v", err)
+	}
+}
@@ -63,9 +63,40 @@ type Resource interface {
 	IDValidationFunc() pluginsdk.SchemaValidateFunc
 }
 
-// TODO: ResourceWithCustomizeDiff
-// TODO: ResourceWithStateMigration
-// TODO: a generic state migration for updating ID's
+// ResourceWithCustomizeDiff is an optional interface
+//
+// Resources implementing this interface can validate or modify the plan
+// for this resource, for example to validate fields which depend on one another
+type ResourceWithCustomizeDiff interface {
+	Resource
+
+	// CustomizeDiff returns a ResourceFunc which is run during the Plan
+	// NOTE: the ResourceMetaData passed to this function contains the ResourceDiff
+	// rather than the ResourceData - and as such DecodeDiff should be used to
+	// decode the planned values into the model
+	CustomizeDiff() ResourceFunc
+}
+
+// ResourceWithStateMigration is an optional interface
+//
+// Resources implementing this interface will have their SchemaVersion and
+// StateUpgraders configured from the StateUpgradeData returned here.
+type ResourceWithStateMigration interface {
+	Resource
+
+	// StateUpgraders returns the StateUpgradeData for this Resource
+	StateUpgraders() StateUpgradeData
+}
+
+type StateUpgradeData struct {
+	// SchemaVersion is the current Schema Version for this Resource
+	SchemaVersion int
+
+	// Upgraders is a map of the Schema Version to the StateUpgrade which
+	// upgrades the state from that version to the next one
+	// NOTE: there must be an entry for each version from 0 to SchemaVersion-1
+	Upgraders map[int]pluginsdk.StateUpgrade
+}
 
 type ResourceWithCustomImporter interface {
 	Resource
@@ -88,6 +119,17 @@ type ResourceWithUpdate interface {
 	Update() ResourceFunc
 }
 
+// ResourceWithDescription is an optional interface
+//
+// Data Sources and Resources implementing this interface expose a description of
+// the Data Source/Resource within the Provider Schema, which is used by editor tooling.
+// Descriptions for each Argument/Attribute are defined using the `Description` field
+// within the Schema. All descriptions are Markdown (see `pluginsdk.DescriptionKind`).
+type ResourceWithDescription interface {
+	// Description returns a description of this Data Source/Resource
+	Description() string
+}
+
 // ResourceWithDeprecation is an optional interface
 //
 // Resources implementing this interface will be marked as Deprecated
@@ -127,13 +169,18 @@ type ResourceMetaData struct {
 	// for example, to determine if a field has changes
 	ResourceData *schema.ResourceData
 
+	// ResourceDiff is a reference to the ResourceDiff object from Terraform's Plugin SDK
+	// This is only populated during CustomizeDiff - and should be used to make changes
+	// to the plan, for example to mark a field as ForceNew
+	ResourceDiff *schema.ResourceDiff
+
 	// serializationDebugLogger is used for testing purposes
 	serializationDebugLogger Logger
 }
 
 // MarkAsGone marks this resource as removed in the Remote API, so this is no longer available
 func (rmd ResourceMetaData) MarkAsGone(idFormatter resourceid.Formatter) error {
-	rmd.Logger.Infof("[DEBUG] %s was not found - removing from state", idFormatter)
+	rmd.Logger.Debugf("%s was not found - removing from state", idFormatter)
 	rmd.ResourceData.SetId("")
 	return nil
 }
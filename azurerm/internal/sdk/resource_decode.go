@@ -11,6 +11,11 @@ import (
 // NOTE: this object must be passed by value - and must contain `tfschema`
 // struct tags for all fields
 //
+// Nested blocks can be decoded into a slice of structs (or pointers to structs)
+// or, for blocks with `MaxItems: 1`, into a single struct or pointer to a struct.
+// Pointers to scalar types (e.g. `*string` or `*int64`) are left as nil when
+// the value isn't set, allowing unset values to be told apart from zero values.
+//
 // Example Usage:
 //
 // type Person struct {
@@ -22,6 +27,22 @@ func (rmd ResourceMetaData) Decode(input interface{}) error {
 	return decodeReflectedType(input, rmd.ResourceData, rmd.serializationDebugLogger)
 }
 
+// DecodeDiff will decode the planned values from the Terraform ResourceDiff into the specified object
+// NOTE: this is only available during CustomizeDiff - and like Decode, the object must be passed
+// by value and must contain `tfschema` struct tags for all fields
+//
+// Example Usage:
+//
+// var person Person
+// if err := metadata.DecodeDiff(&person); err != nil { .. }
+func (rmd ResourceMetaData) DecodeDiff(input interface{}) error {
+	if rmd.ResourceDiff == nil {
+		return fmt.Errorf("DecodeDiff can only be called during CustomizeDiff")
+	}
+
+	return decodeReflectedType(input, rmd.ResourceDiff, rmd.serializationDebugLogger)
+}
+
 // stateRetriever is a convenience wrapper around the Plugin SDK to be able to test it more accurately
 type stateRetriever interface {
 	Get(key string) interface{}
@@ -29,15 +50,29 @@ type stateRetriever interface {
 	GetOkExists(key string) (interface{}, bool)
 }
 
-func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debugLogger Logger) error {
+func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debugLogger Logger) (errOut error) {
 	if reflect.TypeOf(input).Kind() != reflect.Ptr {
 		return fmt.Errorf("need a pointer")
 	}
 
 	objType := reflect.TypeOf(input).Elem()
+	if objType.Kind() != reflect.Struct {
+		return fmt.Errorf("need a pointer to a struct but got a pointer to %s", objType.Kind())
+	}
+
+	defer func() {
+		// any type mismatches should be caught below - however since a panic here would crash
+		// the provider, we surface any which slip through as an error rather than swallowing them
+		if r := recover(); r != nil {
+			debugLogger.Warnf("error decoding %q: %+v", objType.Name(), r)
+			errOut = fmt.Errorf("decoding %q: %+v", objType.Name(), r)
+		}
+	}()
+
+	objVal := reflect.ValueOf(input).Elem()
 	for i := 0; i < objType.NumField(); i++ {
 		field := objType.Field(i)
-		debugLogger.Infof("Field", field)
+		debugLogger.Infof("Field %q", field.Name)
 
 		if val, exists := field.Tag.Lookup("tfschema"); exists {
 			tfschemaValue, valExists := stateRetriever.GetOkExists(val)
@@ -45,11 +80,10 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 				continue
 			}
 
-			debugLogger.Infof("TFSchemaValue: ", tfschemaValue)
-			debugLogger.Infof("Input Type: ", reflect.ValueOf(input).Elem().Field(i).Type())
+			debugLogger.Infof("TFSchemaValue: %+v", tfschemaValue)
+			debugLogger.Infof("Input Type: %s", objVal.Field(i).Type())
 
-			fieldName := reflect.ValueOf(input).Elem().Field(i).String()
-			if err := setValue(input, tfschemaValue, i, fieldName, debugLogger); err != nil {
+			if err := setValue(objVal.Field(i), tfschemaValue, val, debugLogger); err != nil {
 				return err
 			}
 		}
@@ -57,149 +91,224 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 	return nil
 }
 
-func setValue(input, tfschemaValue interface{}, index int, fieldName string, debugLogger Logger) (errOut error) {
-	debugLogger.Infof("setting list value for %q..", fieldName)
-	defer func() {
-		if r := recover(); r != nil {
-			debugLogger.Warnf("error setting value for %q: %+v", fieldName, r)
-			out, ok := r.(error)
+// decodeNestedObject decodes the values for a nested block (e.g. an item within a List or Set)
+// into the struct objVal
+func decodeNestedObject(objVal reflect.Value, input map[string]interface{}, fieldName string, debugLogger Logger) error {
+	objType := objVal.Type()
+	for i := 0; i < objType.NumField(); i++ {
+		nestedField := objType.Field(i)
+		debugLogger.Infof("nestedField %q", nestedField.Name)
+
+		if val, exists := nestedField.Tag.Lookup("tfschema"); exists {
+			nestedTFSchemaValue, ok := input[val]
 			if !ok {
-				return
+				continue
 			}
 
-			errOut = out
+			nestedFieldName := fmt.Sprintf("%s.%s", fieldName, val)
+			if err := setValue(objVal.Field(i), nestedTFSchemaValue, nestedFieldName, debugLogger); err != nil {
+				return err
+			}
 		}
-	}()
+	}
 
-	if v, ok := tfschemaValue.(string); ok {
-		debugLogger.Infof("[String] Decode %+v", v)
-		debugLogger.Infof("Input %+v", reflect.ValueOf(input))
-		debugLogger.Infof("Input Elem %+v", reflect.ValueOf(input).Elem())
-		reflect.ValueOf(input).Elem().Field(index).SetString(v)
+	return nil
+}
+
+func setValue(field reflect.Value, tfschemaValue interface{}, fieldName string, debugLogger Logger) error {
+	debugLogger.Infof("setting value for %q..", fieldName)
+
+	if tfschemaValue == nil {
 		return nil
 	}
 
-	if v, ok := tfschemaValue.(int); ok {
-		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(int64(v))
-		return nil
+	if v, ok := tfschemaValue.(*schema.Set); ok {
+		tfschemaValue = v.List()
 	}
 
-	if v, ok := tfschemaValue.(int32); ok {
-		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(int64(v))
+	switch field.Kind() {
+	case reflect.Ptr:
+		// a nil pointer denotes that this field isn't set - as such we only
+		// allocate this once we know there's a value to decode into it
+		elemType := field.Type().Elem()
+		if elemType.Kind() == reflect.Struct {
+			items, ok := tfschemaValue.([]interface{})
+			if !ok {
+				return typeMismatchError(fieldName, "a list", tfschemaValue)
+			}
+			if len(items) == 0 || items[0] == nil {
+				return nil
+			}
+		}
+
+		elem := reflect.New(elemType)
+		if err := setValue(elem.Elem(), tfschemaValue, fieldName, debugLogger); err != nil {
+			return err
+		}
+		field.Set(elem)
 		return nil
-	}
 
-	if v, ok := tfschemaValue.(int64); ok {
-		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(v)
+	case reflect.Struct:
+		// a single struct is used for a block with `MaxItems: 1`
+		items, ok := tfschemaValue.([]interface{})
+		if !ok {
+			return typeMismatchError(fieldName, "a list", tfschemaValue)
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		if len(items) > 1 {
+			return fmt.Errorf("decoding %q: expected at most 1 item to decode into %s but got %d", fieldName, field.Type(), len(items))
+		}
+		if items[0] == nil {
+			return nil
+		}
+
+		return setNestedObjectValue(field, items[0], fieldName, debugLogger)
+
+	case reflect.String:
+		v, ok := tfschemaValue.(string)
+		if !ok {
+			return typeMismatchError(fieldName, "a string", tfschemaValue)
+		}
+		debugLogger.Infof("[String] Decode %+v", v)
+		field.SetString(v)
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch v := tfschemaValue.(type) {
+		case int:
+			field.SetInt(int64(v))
+		case int32:
+			field.SetInt(int64(v))
+		case int64:
+			field.SetInt(v)
+		default:
+			return typeMismatchError(fieldName, "an int", tfschemaValue)
+		}
+		debugLogger.Infof("[INT] Decode %+v", tfschemaValue)
 		return nil
-	}
 
-	if v, ok := tfschemaValue.(float64); ok {
+	case reflect.Float32, reflect.Float64:
+		v, ok := tfschemaValue.(float64)
+		if !ok {
+			return typeMismatchError(fieldName, "a float", tfschemaValue)
+		}
 		debugLogger.Infof("[Float] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetFloat(v)
+		field.SetFloat(v)
 		return nil
-	}
 
-	// Doesn't work for empty bools?
-	if v, ok := tfschemaValue.(bool); ok {
+	case reflect.Bool:
+		v, ok := tfschemaValue.(bool)
+		if !ok {
+			return typeMismatchError(fieldName, "a bool", tfschemaValue)
+		}
 		debugLogger.Infof("[BOOL] Decode %+v", v)
-
-		reflect.ValueOf(input).Elem().Field(index).SetBool(v)
+		field.SetBool(v)
 		return nil
-	}
 
-	if v, ok := tfschemaValue.(*schema.Set); ok {
-		return setListValue(input, index, fieldName, v.List(), debugLogger)
-	}
+	case reflect.Map:
+		mapConfig, ok := tfschemaValue.(map[string]interface{})
+		if !ok {
+			return typeMismatchError(fieldName, "a map", tfschemaValue)
+		}
+		if field.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("decoding %q: map keys must be a string but got %s", fieldName, field.Type().Key())
+		}
 
-	if mapConfig, ok := tfschemaValue.(map[string]interface{}); ok {
-		mapOutput := reflect.MakeMap(reflect.ValueOf(input).Elem().Field(index).Type())
+		mapOutput := reflect.MakeMapWithSize(field.Type(), len(mapConfig))
 		for key, val := range mapConfig {
-			mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
+			elem := reflect.New(field.Type().Elem()).Elem()
+			if err := setValue(elem, val, fmt.Sprintf("%s.%s", fieldName, key), debugLogger); err != nil {
+				return err
+			}
+			mapOutput.SetMapIndex(reflect.ValueOf(key).Convert(field.Type().Key()), elem)
 		}
 
-		reflect.ValueOf(input).Elem().Field(index).Set(mapOutput)
+		field.Set(mapOutput)
 		return nil
-	}
 
-	if v, ok := tfschemaValue.([]interface{}); ok {
-		return setListValue(input, index, fieldName, v, debugLogger)
+	case reflect.Interface:
+		// e.g. the values within a `map[string]interface{}` - which are set as-is
+		value := reflect.ValueOf(tfschemaValue)
+		if !value.Type().AssignableTo(field.Type()) {
+			return typeMismatchError(fieldName, field.Type().String(), tfschemaValue)
+		}
+		field.Set(value)
+		return nil
+
+	case reflect.Slice:
+		items, ok := tfschemaValue.([]interface{})
+		if !ok {
+			// typed slices (e.g. `[]string`) are converted so that each item can be type-checked
+			reflectedValue := reflect.ValueOf(tfschemaValue)
+			if reflectedValue.Kind() != reflect.Slice {
+				return typeMismatchError(fieldName, "a list", tfschemaValue)
+			}
+
+			items = make([]interface{}, reflectedValue.Len())
+			for i := 0; i < reflectedValue.Len(); i++ {
+				items[i] = reflectedValue.Index(i).Interface()
+			}
+		}
+
+		return setListValue(field, items, fieldName, debugLogger)
 	}
 
-	return nil
+	return fmt.Errorf("decoding %q: unsupported type %s", fieldName, field.Type())
 }
 
-func setListValue(input interface{}, index int, fieldName string, v []interface{}, debugLogger Logger) error {
-	switch fieldType := reflect.ValueOf(input).Elem().Field(index).Type(); fieldType {
-	case reflect.TypeOf([]string{}):
-		stringSlice := reflect.MakeSlice(reflect.TypeOf([]string{}), len(v), len(v))
-		for i, stringVal := range v {
-			stringSlice.Index(i).SetString(stringVal.(string))
-		}
-		reflect.ValueOf(input).Elem().Field(index).Set(stringSlice)
-
-	case reflect.TypeOf([]int{}):
-		iSlice := reflect.MakeSlice(reflect.TypeOf([]int{}), len(v), len(v))
-		for i, iVal := range v {
-			iSlice.Index(i).SetInt(int64(iVal.(int)))
-		}
-		reflect.ValueOf(input).Elem().Field(index).Set(iSlice)
-
-	case reflect.TypeOf([]float64{}):
-		fSlice := reflect.MakeSlice(reflect.TypeOf([]float64{}), len(v), len(v))
-		for i, fVal := range v {
-			fSlice.Index(i).SetFloat(fVal.(float64))
-		}
-		reflect.ValueOf(input).Elem().Field(index).Set(fSlice)
-
-	case reflect.TypeOf([]bool{}):
-		bSlice := reflect.MakeSlice(reflect.TypeOf([]bool{}), len(v), len(v))
-		for i, bVal := range v {
-			bSlice.Index(i).SetBool(bVal.(bool))
-		}
-		reflect.ValueOf(input).Elem().Field(index).Set(bSlice)
-
-	default:
-		valueToSet := reflect.MakeSlice(reflect.ValueOf(input).Elem().Field(index).Type(), 0, 0)
-		debugLogger.Infof("List Type", valueToSet.Type())
-
-		for _, mapVal := range v {
-			if test, ok := mapVal.(map[string]interface{}); ok && test != nil {
-				elem := reflect.New(fieldType.Elem())
-				debugLogger.Infof("element ", elem)
-				for j := 0; j < elem.Type().Elem().NumField(); j++ {
-					nestedField := elem.Type().Elem().Field(j)
-					debugLogger.Infof("nestedField ", nestedField)
-
-					if val, exists := nestedField.Tag.Lookup("tfschema"); exists {
-						nestedTFSchemaValue := test[val]
-						if err := setValue(elem.Interface(), nestedTFSchemaValue, j, fieldName, debugLogger); err != nil {
-							return err
-						}
-					}
-				}
-
-				if !elem.CanSet() {
-					elem = elem.Elem()
-				}
-
-				if valueToSet.Kind() == reflect.Ptr {
-					valueToSet.Elem().Set(reflect.Append(valueToSet.Elem(), elem))
-				} else {
-					valueToSet = reflect.Append(valueToSet, elem)
-				}
-
-				debugLogger.Infof("value to set type after changes", valueToSet.Type())
+func setListValue(field reflect.Value, items []interface{}, fieldName string, debugLogger Logger) error {
+	elemType := field.Type().Elem()
+	valueToSet := reflect.MakeSlice(field.Type(), 0, len(items))
+	debugLogger.Infof("List Type %s", valueToSet.Type())
+
+	for i, item := range items {
+		itemFieldName := fmt.Sprintf("%s.%d", fieldName, i)
+		elem := reflect.New(elemType).Elem()
+
+		switch {
+		case elemType.Kind() == reflect.Struct:
+			// nested blocks without any values set are returned as nil - which we skip
+			if item == nil {
+				continue
+			}
+			if err := setNestedObjectValue(elem, item, itemFieldName, debugLogger); err != nil {
+				return err
+			}
+
+		case elemType.Kind() == reflect.Ptr && elemType.Elem().Kind() == reflect.Struct:
+			if item == nil {
+				continue
+			}
+			nested := reflect.New(elemType.Elem())
+			if err := setNestedObjectValue(nested.Elem(), item, itemFieldName, debugLogger); err != nil {
+				return err
+			}
+			elem.Set(nested)
+
+		default:
+			if err := setValue(elem, item, itemFieldName, debugLogger); err != nil {
+				return err
 			}
 		}
 
-		valueToSet = reflect.Indirect(valueToSet)
-		fieldToSet := reflect.ValueOf(input).Elem().Field(index)
-		fieldToSet.Set(reflect.Indirect(valueToSet))
+		valueToSet = reflect.Append(valueToSet, elem)
 	}
 
+	field.Set(valueToSet)
 	return nil
 }
+
+func setNestedObjectValue(field reflect.Value, item interface{}, fieldName string, debugLogger Logger) error {
+	values, ok := item.(map[string]interface{})
+	if !ok {
+		return typeMismatchError(fieldName, "a nested object", item)
+	}
+
+	return decodeNestedObject(field, values, fieldName, debugLogger)
+}
+
+func typeMismatchError(fieldName string, expected string, actual interface{}) error {
+	return fmt.Errorf("decoding %q: expected %s but got %T", fieldName, expected, actual)
+}
@@ -1,8 +1,12 @@
 package sdk
 
 import (
+	"context"
 	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 type decodeTestData struct {
@@ -197,9 +201,12 @@ func TestDecode_TopLevelFieldsOptional(t *testing.T) {
 		},
 		Input: &SimpleType{},
 		Expected: &SimpleType{
-			MapOfBools:   map[string]bool{},
-			MapOfNumbers: map[string]int{},
-			MapOfStrings: map[string]string{},
+			ListOfFloats:  []float64{},
+			ListOfNumbers: []int{},
+			ListOfStrings: []string{},
+			MapOfBools:    map[string]bool{},
+			MapOfNumbers:  map[string]int{},
+			MapOfStrings:  map[string]string{},
 		},
 		ExpectError: false,
 	}.test(t)
@@ -387,9 +394,12 @@ func TestResourceDecode_NestedOneLevelDeepSingleOmittedValues(t *testing.T) {
 		Expected: &Type{
 			NestedObject: []Inner{
 				{
-					MapOfBools:   map[string]bool{},
-					MapOfNumbers: map[string]int{},
-					MapOfStrings: map[string]string{},
+					ListOfFloats:  []float64{},
+					ListOfNumbers: []int{},
+					ListOfStrings: []string{},
+					MapOfBools:    map[string]bool{},
+					MapOfNumbers:  map[string]int{},
+					MapOfStrings:  map[string]string{},
 				},
 			},
 		},
@@ -698,6 +708,261 @@ func TestResourceDecode_NestedThreeLevelsDeepMultipleItems(t *testing.T) {
 	}.test(t)
 }
 
+func TestResourceDecode_Pointers(t *testing.T) {
+	type Inner struct {
+		Value string `tfschema:"value"`
+	}
+	type Type struct {
+		String       *string `tfschema:"string"`
+		Number       *int64  `tfschema:"number"`
+		Enabled      *bool   `tfschema:"enabled"`
+		Unset        *string `tfschema:"unset"`
+		NestedObject *Inner  `tfschema:"inner"`
+		EmptyObject  *Inner  `tfschema:"empty"`
+	}
+	str := "hello"
+	num := int64(0)
+	enabled := false
+	decodeTestData{
+		State: map[string]interface{}{
+			"string":  "hello",
+			"number":  0,
+			"enabled": false,
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value": "world",
+				},
+			},
+			"empty": []interface{}{},
+		},
+		Input: &Type{},
+		Expected: &Type{
+			String:  &str,
+			Number:  &num,
+			Enabled: &enabled,
+			NestedObject: &Inner{
+				Value: "world",
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_NestedSingleObject(t *testing.T) {
+	type Second struct {
+		Value string `tfschema:"value"`
+	}
+	type First struct {
+		Name   string    `tfschema:"name"`
+		Second Second    `tfschema:"second"`
+		Others []*Second `tfschema:"others"`
+	}
+	type Type struct {
+		First First `tfschema:"first"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"first": []interface{}{
+				map[string]interface{}{
+					"name": "first",
+					"second": []interface{}{
+						map[string]interface{}{
+							"value": "second",
+						},
+					},
+					"others": []interface{}{
+						map[string]interface{}{
+							"value": "third",
+						},
+					},
+				},
+			},
+		},
+		Input: &Type{},
+		Expected: &Type{
+			First: First{
+				Name: "first",
+				Second: Second{
+					Value: "second",
+				},
+				Others: []*Second{
+					{
+						Value: "third",
+					},
+				},
+			},
+		},
+	}.test(t)
+
+	t.Log("Too Many Items")
+	decodeTestData{
+		State: map[string]interface{}{
+			"first": []interface{}{
+				map[string]interface{}{
+					"name": "first",
+				},
+				map[string]interface{}{
+					"name": "second",
+				},
+			},
+		},
+		Input:       &Type{},
+		ExpectError: true,
+	}.test(t)
+}
+
+func TestResourceDecode_MapOfInterfaces(t *testing.T) {
+	type Type struct {
+		Settings map[string]interface{} `tfschema:"settings"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"settings": map[string]interface{}{
+				"hello": "world",
+				"count": 2,
+			},
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Settings: map[string]interface{}{
+				"hello": "world",
+				"count": 2,
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_TypeMismatch(t *testing.T) {
+	type Inner struct {
+		Value int `tfschema:"value"`
+	}
+	type Type struct {
+		String string  `tfschema:"string"`
+		Nested []Inner `tfschema:"nested"`
+	}
+
+	t.Log("Top Level")
+	decodeTestData{
+		State: map[string]interface{}{
+			"string": 42,
+		},
+		Input:       &Type{},
+		ExpectError: true,
+	}.test(t)
+
+	t.Log("Nested")
+	decodeTestData{
+		State: map[string]interface{}{
+			"nested": []interface{}{
+				map[string]interface{}{
+					"value": "forty-two",
+				},
+			},
+		},
+		Input:       &Type{},
+		ExpectError: true,
+	}.test(t)
+}
+
+func TestDecodeDiff_OutsideOfCustomizeDiff(t *testing.T) {
+	type SimpleType struct {
+		String string `tfschema:"string"`
+	}
+	metadata := ResourceMetaData{
+		serializationDebugLogger: ConsoleLogger{},
+	}
+	var input SimpleType
+	if err := metadata.DecodeDiff(&input); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestDecodeDiff_FromResourceDiff(t *testing.T) {
+	type NestedType struct {
+		Value string `tfschema:"value"`
+	}
+	type SimpleType struct {
+		String  string       `tfschema:"string"`
+		Number  int          `tfschema:"number"`
+		Enabled bool         `tfschema:"enabled"`
+		List    []string     `tfschema:"list"`
+		Nested  []NestedType `tfschema:"nested"`
+	}
+
+	var decoded SimpleType
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"string": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"number": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"nested": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			metadata := ResourceMetaData{
+				ResourceDiff:             d,
+				serializationDebugLogger: ConsoleLogger{},
+			}
+			return metadata.DecodeDiff(&decoded)
+		},
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"string":  "hello",
+		"number":  42,
+		"enabled": true,
+		"list":    []interface{}{"first", "second"},
+		"nested": []interface{}{
+			map[string]interface{}{
+				"value": "inner",
+			},
+		},
+	})
+	if _, err := resource.Diff(context.TODO(), nil, config, nil); err != nil {
+		t.Fatalf("running Diff: %+v", err)
+	}
+
+	expected := SimpleType{
+		String:  "hello",
+		Number:  42,
+		Enabled: true,
+		List:    []string{"first", "second"},
+		Nested: []NestedType{
+			{
+				Value: "inner",
+			},
+		},
+	}
+	if !reflect.DeepEqual(decoded, expected) {
+		t.Fatalf("\nExpected: %+v\n\n Received %+v\n\n", expected, decoded)
+	}
+}
+
 func (testData decodeTestData) test(t *testing.T) {
 	debugLogger := ConsoleLogger{}
 	state := testData.stateWrapper()
@@ -8,6 +8,10 @@ import (
 // Encode will encode the specified object into the Terraform State
 // NOTE: this requires that the object passed in is a pointer and
 // all fields contain `tfschema` struct tags
+//
+// Nested blocks can be encoded from a slice of structs (or pointers to structs)
+// or, for blocks with `MaxItems: 1`, a single struct or pointer to a struct.
+// A nil pointer to a scalar type (e.g. `*string`) is encoded as an unset value.
 func (rmd ResourceMetaData) Encode(input interface{}) error {
 	if reflect.TypeOf(input).Kind() != reflect.Ptr {
 		return fmt.Errorf("need a pointer")
@@ -16,8 +20,7 @@ func (rmd ResourceMetaData) Encode(input interface{}) error {
 	objType := reflect.TypeOf(input).Elem()
 	objVal := reflect.ValueOf(input).Elem()
 
-	fieldName := reflect.ValueOf(input).Elem().String()
-	serialized, err := recurse(objType, objVal, fieldName, rmd.serializationDebugLogger)
+	serialized, err := recurse(objType, objVal, "", rmd.serializationDebugLogger)
 	if err != nil {
 		return err
 	}
@@ -33,109 +36,157 @@ func (rmd ResourceMetaData) Encode(input interface{}) error {
 
 func recurse(objType reflect.Type, objVal reflect.Value, fieldName string, debugLogger Logger) (output map[string]interface{}, errOut error) {
 	defer func() {
+		// any unsupported types should be caught below - however since a panic here would crash
+		// the provider, we surface any which slip through as an error rather than swallowing them
 		if r := recover(); r != nil {
 			debugLogger.Warnf("error setting value for %q: %+v", fieldName, r)
-			out, ok := r.(error)
-			if !ok {
-				return
-			}
-
-			errOut = out
+			errOut = fmt.Errorf("encoding %q: %+v", fieldName, r)
 		}
 	}()
 
+	if objType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("encoding %q: expected a struct but got %s", fieldName, objType.Kind())
+	}
+
 	output = make(map[string]interface{})
 	for i := 0; i < objType.NumField(); i++ {
 		field := objType.Field(i)
 		fieldVal := objVal.Field(i)
 		if tfschemaTag, exists := field.Tag.Lookup("tfschema"); exists {
-			switch field.Type.Kind() {
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				iv := fieldVal.Int()
-				debugLogger.Infof("Setting %q to %d", tfschemaTag, iv)
-				output[tfschemaTag] = iv
-
-			case reflect.Float32, reflect.Float64:
-				fv := fieldVal.Float()
-				debugLogger.Infof("Setting %q to %f", tfschemaTag, fv)
-				output[tfschemaTag] = fv
-
-			case reflect.String:
-				sv := fieldVal.String()
-				debugLogger.Infof("Setting %q to %q", tfschemaTag, sv)
-				output[tfschemaTag] = sv
-
-			case reflect.Bool:
-				bv := fieldVal.Bool()
-				debugLogger.Infof("Setting %q to %t", tfschemaTag, bv)
-				output[tfschemaTag] = bv
-
-			case reflect.Map:
-				iter := fieldVal.MapRange()
-				attr := make(map[string]interface{})
-				for iter.Next() {
-					attr[iter.Key().String()] = iter.Value().Interface()
-				}
-				output[tfschemaTag] = attr
-
-			case reflect.Slice:
-				sv := fieldVal.Slice(0, fieldVal.Len())
-				attr := make([]interface{}, sv.Len())
-				switch sv.Type() {
-				case reflect.TypeOf([]string{}):
-					debugLogger.Infof("Setting %q to []string", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]string, 0)
-					}
-
-				case reflect.TypeOf([]int{}):
-					debugLogger.Infof("Setting %q to []int", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]int, 0)
-					}
-
-				case reflect.TypeOf([]float64{}):
-					debugLogger.Infof("Setting %q to []float64", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]float64, 0)
-					}
-
-				case reflect.TypeOf([]bool{}):
-					debugLogger.Infof("Setting %q to []bool", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]bool, 0)
-					}
-
-				default:
-					for i := 0; i < sv.Len(); i++ {
-						debugLogger.Infof("[SLICE] Index %d is %q", i, sv.Index(i).Interface())
-						debugLogger.Infof("[SLICE] Type %+v", sv.Type())
-						nestedType := sv.Index(i).Type()
-						nestedValue := sv.Index(i)
-
-						fieldName := field.Name
-						serialized, err := recurse(nestedType, nestedValue, fieldName, debugLogger)
-						if err != nil {
-							return nil, fmt.Errorf("serializing nested object %q: %+v", sv.Type(), exists)
-						}
-						attr[i] = serialized
-					}
-					debugLogger.Infof("[SLICE] Setting %q to %+v", tfschemaTag, attr)
-					output[tfschemaTag] = attr
-				}
-			default:
-				return output, fmt.Errorf("unknown type %+v for key %q", field.Type.Kind(), tfschemaTag)
+			nestedFieldName := tfschemaTag
+			if fieldName != "" {
+				nestedFieldName = fmt.Sprintf("%s.%s", fieldName, tfschemaTag)
+			}
+
+			value, err := encodeValue(fieldVal, nestedFieldName, debugLogger)
+			if err != nil {
+				return output, err
 			}
+
+			output[tfschemaTag] = value
 		}
 	}
 
 	return output, nil
 }
+
+func encodeValue(fieldVal reflect.Value, fieldName string, debugLogger Logger) (interface{}, error) {
+	switch fieldVal.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		iv := fieldVal.Int()
+		debugLogger.Infof("Setting %q to %d", fieldName, iv)
+		return iv, nil
+
+	case reflect.Float32, reflect.Float64:
+		fv := fieldVal.Float()
+		debugLogger.Infof("Setting %q to %f", fieldName, fv)
+		return fv, nil
+
+	case reflect.String:
+		sv := fieldVal.String()
+		debugLogger.Infof("Setting %q to %q", fieldName, sv)
+		return sv, nil
+
+	case reflect.Bool:
+		bv := fieldVal.Bool()
+		debugLogger.Infof("Setting %q to %t", fieldName, bv)
+		return bv, nil
+
+	case reflect.Ptr:
+		elemKind := fieldVal.Type().Elem().Kind()
+		if fieldVal.IsNil() {
+			debugLogger.Infof("Setting %q to nil", fieldName)
+			if elemKind == reflect.Struct {
+				return make([]interface{}, 0), nil
+			}
+
+			// a nil pointer denotes this value isn't set, rather than being the zero value
+			return nil, nil
+		}
+
+		return encodeValue(fieldVal.Elem(), fieldName, debugLogger)
+
+	case reflect.Struct:
+		// a single struct is used for a block with `MaxItems: 1`
+		serialized, err := recurse(fieldVal.Type(), fieldVal, fieldName, debugLogger)
+		if err != nil {
+			return nil, fmt.Errorf("serializing nested object %q: %+v", fieldName, err)
+		}
+		return []interface{}{serialized}, nil
+
+	case reflect.Map:
+		if fieldVal.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("encoding %q: map keys must be a string but got %s", fieldName, fieldVal.Type().Key())
+		}
+		iter := fieldVal.MapRange()
+		attr := make(map[string]interface{})
+		for iter.Next() {
+			attr[iter.Key().String()] = iter.Value().Interface()
+		}
+		return attr, nil
+
+	case reflect.Slice:
+		sv := fieldVal.Slice(0, fieldVal.Len())
+		switch sv.Type() {
+		case reflect.TypeOf([]string{}):
+			debugLogger.Infof("Setting %q to []string", fieldName)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]string, 0), nil
+
+		case reflect.TypeOf([]int{}):
+			debugLogger.Infof("Setting %q to []int", fieldName)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]int, 0), nil
+
+		case reflect.TypeOf([]float64{}):
+			debugLogger.Infof("Setting %q to []float64", fieldName)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]float64, 0), nil
+
+		case reflect.TypeOf([]bool{}):
+			debugLogger.Infof("Setting %q to []bool", fieldName)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]bool, 0), nil
+		}
+
+		attr := make([]interface{}, 0, sv.Len())
+		for i := 0; i < sv.Len(); i++ {
+			debugLogger.Infof("[SLICE] Index %d is %+v", i, sv.Index(i).Interface())
+			debugLogger.Infof("[SLICE] Type %+v", sv.Type())
+			nestedValue := reflect.Indirect(sv.Index(i))
+			itemFieldName := fmt.Sprintf("%s.%d", fieldName, i)
+
+			// nil items within a list of pointers are omitted
+			if !nestedValue.IsValid() {
+				continue
+			}
+
+			if nestedValue.Kind() == reflect.Struct {
+				serialized, err := recurse(nestedValue.Type(), nestedValue, itemFieldName, debugLogger)
+				if err != nil {
+					return nil, fmt.Errorf("serializing nested object %q: %+v", sv.Type(), err)
+				}
+				attr = append(attr, serialized)
+				continue
+			}
+
+			value, err := encodeValue(nestedValue, itemFieldName, debugLogger)
+			if err != nil {
+				return nil, err
+			}
+			attr = append(attr, value)
+		}
+		debugLogger.Infof("[SLICE] Setting %q to %+v", fieldName, attr)
+		return attr, nil
+	}
+
+	return nil, fmt.Errorf("unknown type %+v for key %q", fieldVal.Kind(), fieldName)
+}
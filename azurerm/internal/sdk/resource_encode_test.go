@@ -647,6 +647,100 @@ func TestResourceEncode_NestedThreeLevelsDeepMultipleItems(t *testing.T) {
 	}.test(t)
 }
 
+func TestResourceEncode_Pointers(t *testing.T) {
+	type Inner struct {
+		Value string `tfschema:"value"`
+	}
+	type Type struct {
+		String       *string `tfschema:"string"`
+		Number       *int64  `tfschema:"number"`
+		Unset        *string `tfschema:"unset"`
+		NestedObject *Inner  `tfschema:"inner"`
+		EmptyObject  *Inner  `tfschema:"empty"`
+	}
+	str := "hello"
+	num := int64(0)
+	encodeTestData{
+		Input: &Type{
+			String: &str,
+			Number: &num,
+			NestedObject: &Inner{
+				Value: "world",
+			},
+		},
+		Expected: map[string]interface{}{
+			"string": "hello",
+			"number": int64(0),
+			"unset":  nil,
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value": "world",
+				},
+			},
+			"empty": []interface{}{},
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_NestedSingleObject(t *testing.T) {
+	type Second struct {
+		Value string `tfschema:"value"`
+	}
+	type First struct {
+		Name   string    `tfschema:"name"`
+		Second Second    `tfschema:"second"`
+		Others []*Second `tfschema:"others"`
+	}
+	type Type struct {
+		First First `tfschema:"first"`
+	}
+	encodeTestData{
+		Input: &Type{
+			First: First{
+				Name: "first",
+				Second: Second{
+					Value: "second",
+				},
+				Others: []*Second{
+					{
+						Value: "third",
+					},
+					nil,
+				},
+			},
+		},
+		Expected: map[string]interface{}{
+			"first": []interface{}{
+				map[string]interface{}{
+					"name": "first",
+					"second": []interface{}{
+						map[string]interface{}{
+							"value": "second",
+						},
+					},
+					"others": []interface{}{
+						map[string]interface{}{
+							"value": "third",
+						},
+					},
+				},
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_UnsupportedType(t *testing.T) {
+	type Type struct {
+		Channel chan string `tfschema:"channel"`
+	}
+	encodeTestData{
+		Input: &Type{
+			Channel: make(chan string),
+		},
+		ExpectError: true,
+	}.test(t)
+}
+
 func (testData encodeTestData) test(t *testing.T) {
 	objType := reflect.TypeOf(testData.Input).Elem()
 	objVal := reflect.ValueOf(testData.Input).Elem()
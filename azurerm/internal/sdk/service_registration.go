@@ -37,3 +37,15 @@ type UntypedServiceRegistration interface {
 	// SupportedResources returns the supported Resources supported by this Service
 	SupportedResources() map[string]*pluginsdk.Resource
 }
+
+// ServiceRegistrationWithResourceProviders is an optional interface which can be implemented by
+// either a Typed or Untyped Service Registration to map each Resource within this Service to the
+// Resource Provider Namespace (e.g. `Microsoft.Compute`) which it's provisioned within.
+//
+// This is used to check that the Resource Provider is registered (or will be registered) when the
+// `resource_providers_to_register` field is specified in the Provider block.
+type ServiceRegistrationWithResourceProviders interface {
+	// ResourceProviderNamespace returns the Resource Provider Namespace used by the specified
+	// Resource Type - or an empty string if this isn't known
+	ResourceProviderNamespace(resourceType string) string
+}
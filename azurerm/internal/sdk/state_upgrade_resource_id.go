@@ -0,0 +1,66 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+// ResourceIDParser parses the specified Resource ID into a Formatter, which is
+// then used to output the Resource ID in it's canonical form
+type ResourceIDParser func(input string) (resourceid.Formatter, error)
+
+var _ pluginsdk.StateUpgrade = ResourceIDStateUpgrade{}
+
+// ResourceIDStateUpgrade is a generic StateUpgrade which rewrites the `id` field
+// in the State by parsing it with the specified parser and then re-formatting it
+//
+// This is intended for fixing the casing of Resource ID's, where the parser is
+// able to parse the existing (e.g. incorrectly cased) ID and the Formatter then
+// outputs the canonical (correctly cased) Resource ID.
+type ResourceIDStateUpgrade struct {
+	schema map[string]*pluginsdk.Schema
+	parser ResourceIDParser
+}
+
+// NewResourceIDStateUpgrade returns a StateUpgrade which rewrites the `id` field using the parser
+//
+// schema is a point-in-time reference to the Schema of the Resource at the version being upgraded from
+// parser is used to parse the existing Resource ID - and must support parsing the existing values
+func NewResourceIDStateUpgrade(schema map[string]*pluginsdk.Schema, parser ResourceIDParser) ResourceIDStateUpgrade {
+	return ResourceIDStateUpgrade{
+		schema: schema,
+		parser: parser,
+	}
+}
+
+func (u ResourceIDStateUpgrade) Schema() map[string]*pluginsdk.Schema {
+	return u.schema
+}
+
+func (u ResourceIDStateUpgrade) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		oldIdRaw, ok := rawState["id"]
+		if !ok || oldIdRaw == nil {
+			return rawState, fmt.Errorf("`id` was not found in the State")
+		}
+
+		oldId, ok := oldIdRaw.(string)
+		if !ok {
+			return rawState, fmt.Errorf("expected `id` to be a string but got %+v", oldIdRaw)
+		}
+
+		id, err := u.parser(oldId)
+		if err != nil {
+			return rawState, fmt.Errorf("parsing %q: %+v", oldId, err)
+		}
+
+		newId := id.ID()
+		log.Printf("[DEBUG] Updating ID from %q to %q", oldId, newId)
+		rawState["id"] = newId
+		return rawState, nil
+	}
+}
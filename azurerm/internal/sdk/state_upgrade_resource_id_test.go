@@ -0,0 +1,94 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+type testResourceGroupId struct {
+	SubscriptionId string
+	Name           string
+}
+
+func (id testResourceGroupId) ID() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", id.SubscriptionId, id.Name)
+}
+
+func testResourceGroupIdInsensitively(input string) (resourceid.Formatter, error) {
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(segments) != 4 || !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[2], "resourceGroups") {
+		return nil, fmt.Errorf("%q is not a Resource Group ID", input)
+	}
+
+	return testResourceGroupId{
+		SubscriptionId: segments[1],
+		Name:           segments[3],
+	}, nil
+}
+
+func TestResourceIDStateUpgrade(t *testing.T) {
+	testData := []struct {
+		input    map[string]interface{}
+		expected string
+		error    bool
+	}{
+		{
+			// missing
+			input: map[string]interface{}{},
+			error: true,
+		},
+		{
+			// not a string
+			input: map[string]interface{}{
+				"id": 123,
+			},
+			error: true,
+		},
+		{
+			// unparseable
+			input: map[string]interface{}{
+				"id": "/subscriptions/1234",
+			},
+			error: true,
+		},
+		{
+			// already correct
+			input: map[string]interface{}{
+				"id": "/subscriptions/1234/resourceGroups/group1",
+			},
+			expected: "/subscriptions/1234/resourceGroups/group1",
+		},
+		{
+			// incorrect casing
+			input: map[string]interface{}{
+				"id": "/Subscriptions/1234/resourcegroups/group1",
+			},
+			expected: "/subscriptions/1234/resourceGroups/group1",
+		},
+	}
+
+	upgrade := NewResourceIDStateUpgrade(nil, testResourceGroupIdInsensitively)
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.input)
+
+		actual, err := upgrade.UpgradeFunc()(context.TODO(), v.input, nil)
+		if err != nil {
+			if v.error {
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if actual["id"] != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual["id"])
+		}
+	}
+}
@@ -44,7 +44,7 @@ func (dw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 	resource := schema.Resource{
 		Schema: *resourceSchema,
 		ReadContext: dw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, dw.logger)
+			metaData := runArgs(d, meta, dw.dataSourceLogger(d))
 			return dw.dataSource.Read().Func(ctx, metaData)
 		}),
 		Timeouts: &schema.ResourceTimeout{
@@ -52,9 +52,18 @@ func (dw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 		},
 	}
 
+	if v, ok := dw.dataSource.(ResourceWithDescription); ok {
+		resource.Description = v.Description()
+	}
+
 	return &resource, nil
 }
 
+// dataSourceLogger returns a Logger which attaches the Data Source Type and ID to each log message
+func (dw *DataSourceWrapper) dataSourceLogger(id resourceIdRetriever) Logger {
+	return NewStructuredLogger(dw.logger).withResource(dw.dataSource.ResourceType(), OperationRead, id)
+}
+
 func (dw *DataSourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) schema.ReadContextFunc {
 	return diagnosticsWrapper(in, dw.logger)
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 )
 
@@ -47,7 +48,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		Schema: *resourceSchema,
 
 		CreateContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData := runArgs(d, meta, rw.resourceLogger(OperationCreate, d))
 			err := rw.resource.Create().Func(ctx, metaData)
 			if err != nil {
 				return err
@@ -60,11 +61,11 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 
 		// looks like these could be reused, easiest if they're not
 		ReadContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData := runArgs(d, meta, rw.resourceLogger(OperationRead, d))
 			return rw.resource.Read().Func(ctx, metaData)
 		}),
 		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData := runArgs(d, meta, rw.resourceLogger(OperationDelete, d))
 			return rw.resource.Delete().Func(ctx, metaData)
 		}),
 
@@ -92,7 +93,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			return nil
 		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
 			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
-				metaData := runArgs(d, meta, rw.logger)
+				metaData := runArgs(d, meta, rw.resourceLogger(OperationImport, d))
 
 				err := v.CustomImporter()(ctx, metaData)
 				if err != nil {
@@ -110,7 +111,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
 		resource.UpdateContext = rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData := runArgs(d, meta, rw.resourceLogger(OperationUpdate, d))
 
 			err := v.Update().Func(ctx, metaData)
 			if err != nil {
@@ -124,6 +125,10 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		resource.Timeouts.Update = d(v.Update().Timeout)
 	}
 
+	if v, ok := rw.resource.(ResourceWithDescription); ok {
+		resource.Description = v.Description()
+	}
+
 	if v, ok := rw.resource.(ResourceWithDeprecation); ok {
 		message := v.DeprecationMessage()
 		if message == "" {
@@ -133,12 +138,50 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		resource.DeprecationMessage = message
 	}
 
-	// TODO: CustomizeDiff
-	// TODO: State Migrations
+	if v, ok := rw.resource.(ResourceWithStateMigration); ok {
+		upgrades := v.StateUpgraders()
+		if upgrades.SchemaVersion != len(upgrades.Upgraders) {
+			return nil, fmt.Errorf("Resource %q has a SchemaVersion of %d but %d State Upgraders - these must match", rw.resource.ResourceType(), upgrades.SchemaVersion, len(upgrades.Upgraders))
+		}
+		for version := 0; version < upgrades.SchemaVersion; version++ {
+			if _, ok := upgrades.Upgraders[version]; !ok {
+				return nil, fmt.Errorf("Resource %q is missing a State Upgrader for Schema Version %d - there must be a State Upgrader for each Schema Version from 0 to %d", rw.resource.ResourceType(), version, upgrades.SchemaVersion-1)
+			}
+		}
+
+		resource.SchemaVersion = upgrades.SchemaVersion
+		resource.StateUpgraders = pluginsdk.StateUpgrades(upgrades.Upgraders)
+	}
+
+	if v, ok := rw.resource.(ResourceWithCustomizeDiff); ok {
+		resource.CustomizeDiff = pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+			client := meta.(*clients.Client)
+			metaData := ResourceMetaData{
+				Client:                   client,
+				Logger:                   rw.resourceLogger(OperationCustomizeDiff, d),
+				ResourceDiff:             d,
+				serializationDebugLogger: NullLogger{},
+			}
+
+			customizeDiff := v.CustomizeDiff()
+			if customizeDiff.Timeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, customizeDiff.Timeout)
+				defer cancel()
+			}
+
+			return customizeDiff.Func(ctx, metaData)
+		})
+	}
 
 	return &resource, nil
 }
 
+// resourceLogger returns a Logger which attaches the Resource Type, Operation and Resource ID to each log message
+func (rw *ResourceWrapper) resourceLogger(operation Operation, id resourceIdRetriever) Logger {
+	return NewStructuredLogger(rw.logger).withResource(rw.resource.ResourceType(), operation, id)
+}
+
 func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return diagnosticsWrapper(in, rw.logger)
 }
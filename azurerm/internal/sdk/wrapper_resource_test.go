@@ -0,0 +1,233 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+type testWrapperModel struct {
+	Name  string `tfschema:"name"`
+	Count int    `tfschema:"count"`
+}
+
+type testWrapperResource struct{}
+
+func (testWrapperResource) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"count": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+	}
+}
+
+func (testWrapperResource) Attributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{}
+}
+
+func (testWrapperResource) ModelObject() interface{} {
+	return &testWrapperModel{}
+}
+
+func (testWrapperResource) ResourceType() string {
+	return "azurerm_wrapper_test"
+}
+
+func (testWrapperResource) Create() ResourceFunc {
+	return testWrapperResourceFunc()
+}
+
+func (testWrapperResource) Read() ResourceFunc {
+	return testWrapperResourceFunc()
+}
+
+func (testWrapperResource) Delete() ResourceFunc {
+	return testWrapperResourceFunc()
+}
+
+func (testWrapperResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return nil
+}
+
+func testWrapperResourceFunc() ResourceFunc {
+	return ResourceFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+type testWrapperResourceWithStateMigration struct {
+	testWrapperResource
+
+	upgrades StateUpgradeData
+}
+
+func (r testWrapperResourceWithStateMigration) StateUpgraders() StateUpgradeData {
+	return r.upgrades
+}
+
+type testWrapperStateUpgrade struct{}
+
+func (testWrapperStateUpgrade) Schema() map[string]*pluginsdk.Schema {
+	return testWrapperResource{}.Arguments()
+}
+
+func (testWrapperStateUpgrade) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		return rawState, nil
+	}
+}
+
+type testWrapperResourceWithCustomizeDiff struct {
+	testWrapperResource
+
+	customizeDiff ResourceRunFunc
+}
+
+func (r testWrapperResourceWithCustomizeDiff) CustomizeDiff() ResourceFunc {
+	return ResourceFunc{
+		Func:    r.customizeDiff,
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func TestResourceWrapper_StateMigration(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Upgrades    StateUpgradeData
+		ExpectError bool
+	}{
+		{
+			Name: "None",
+			Upgrades: StateUpgradeData{
+				SchemaVersion: 0,
+				Upgraders:     map[int]pluginsdk.StateUpgrade{},
+			},
+		},
+		{
+			Name: "Contiguous",
+			Upgrades: StateUpgradeData{
+				SchemaVersion: 2,
+				Upgraders: map[int]pluginsdk.StateUpgrade{
+					0: testWrapperStateUpgrade{},
+					1: testWrapperStateUpgrade{},
+				},
+			},
+		},
+		{
+			Name: "Mismatched Count",
+			Upgrades: StateUpgradeData{
+				SchemaVersion: 2,
+				Upgraders: map[int]pluginsdk.StateUpgrade{
+					0: testWrapperStateUpgrade{},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Non-Contiguous",
+			Upgrades: StateUpgradeData{
+				SchemaVersion: 2,
+				Upgraders: map[int]pluginsdk.StateUpgrade{
+					1: testWrapperStateUpgrade{},
+					2: testWrapperStateUpgrade{},
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		wrapper := NewResourceWrapper(testWrapperResourceWithStateMigration{
+			upgrades: v.Upgrades,
+		})
+		resource, err := wrapper.Resource()
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if resource.SchemaVersion != v.Upgrades.SchemaVersion {
+			t.Fatalf("expected the SchemaVersion to be %d but got %d", v.Upgrades.SchemaVersion, resource.SchemaVersion)
+		}
+		if len(resource.StateUpgraders) != v.Upgrades.SchemaVersion {
+			t.Fatalf("expected %d State Upgraders but got %d", v.Upgrades.SchemaVersion, len(resource.StateUpgraders))
+		}
+	}
+}
+
+func TestResourceWrapper_CustomizeDiff(t *testing.T) {
+	var decoded testWrapperModel
+	wrapper := NewResourceWrapper(testWrapperResourceWithCustomizeDiff{
+		customizeDiff: func(ctx context.Context, metadata ResourceMetaData) error {
+			if metadata.ResourceData != nil {
+				return fmt.Errorf("expected the ResourceData to be nil during CustomizeDiff")
+			}
+
+			return metadata.DecodeDiff(&decoded)
+		},
+	})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+	if resource.CustomizeDiff == nil {
+		t.Fatalf("expected CustomizeDiff to be configured but it wasn't")
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":  "example",
+		"count": 3,
+	})
+	if _, err := resource.Diff(context.TODO(), nil, config, &clients.Client{}); err != nil {
+		t.Fatalf("running Diff: %+v", err)
+	}
+
+	expected := testWrapperModel{
+		Name:  "example",
+		Count: 3,
+	}
+	if decoded != expected {
+		t.Fatalf("expected %+v but got %+v", expected, decoded)
+	}
+}
+
+func TestResourceWrapper_CustomizeDiffError(t *testing.T) {
+	wrapper := NewResourceWrapper(testWrapperResourceWithCustomizeDiff{
+		customizeDiff: func(ctx context.Context, metadata ResourceMetaData) error {
+			return fmt.Errorf("validation failed")
+		},
+	})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "example",
+	})
+	if _, err := resource.Diff(context.TODO(), nil, config, &clients.Client{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
@@ -16,41 +16,58 @@ func ValidateModelObject(input interface{}) error {
 	// TODO: could we also validate that each `tfschema` tag exists in the schema?
 
 	objType := reflect.TypeOf(input).Elem()
-	objVal := reflect.ValueOf(input).Elem()
-	return validateModelObjectRecursively("", objType, objVal)
-}
-
-func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal reflect.Value) (errOut error) {
-	defer func() {
-		if r := recover(); r != nil {
-			out, ok := r.(error)
-			if !ok {
-				return
-			}
 
-			errOut = out
+	// the model object may be wrapped in an interface, e.g. when passed as `&modelObject`
+	if objType.Kind() == reflect.Interface {
+		objVal := reflect.ValueOf(input).Elem()
+		if objVal.IsNil() {
+			// resources which don't use Encode/Decode may not have a model object
+			return nil
 		}
-	}()
+		objType = objVal.Elem().Type()
+	}
+	if objType.Kind() == reflect.Ptr {
+		objType = objType.Elem()
+	}
+	if objType.Kind() != reflect.Struct {
+		return fmt.Errorf("need a pointer to a struct but got %s", objType.Kind())
+	}
+
+	return validateModelObjectRecursively("", objType)
+}
 
+func validateModelObjectRecursively(prefix string, objType reflect.Type) error {
 	for i := 0; i < objType.NumField(); i++ {
 		field := objType.Field(i)
-		fieldVal := objVal.Field(i)
-
-		if field.Type.Kind() == reflect.Slice {
-			sv := fieldVal.Slice(0, fieldVal.Len())
-			innerType := sv.Type().Elem()
-			innerVal := reflect.Indirect(reflect.New(innerType))
-			fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
-			if err := validateModelObjectRecursively(fieldName, innerType, innerVal); err != nil {
+		fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
+
+		// nested blocks can be either a slice of structs/pointers to structs, or
+		// for blocks with `MaxItems: 1` a struct/pointer to a struct
+		if innerType := nestedObjectType(field.Type); innerType != nil {
+			if err := validateModelObjectRecursively(fieldName, innerType); err != nil {
 				return err
 			}
 		}
 
 		if _, exists := field.Tag.Lookup("tfschema"); !exists {
-			fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
 			return fmt.Errorf("field %q is missing an `tfschema` label", fieldName)
 		}
 	}
 
 	return nil
 }
+
+// nestedObjectType returns the type of the nested object for this field, if this field is a nested object
+func nestedObjectType(fieldType reflect.Type) reflect.Type {
+	if fieldType.Kind() == reflect.Slice {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType.Kind() == reflect.Struct {
+		return fieldType
+	}
+
+	return nil
+}
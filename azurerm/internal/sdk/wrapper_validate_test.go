@@ -56,3 +56,28 @@ func TestValidateNestedObjectInvalid(t *testing.T) {
 		t.Fatalf("expected an error but didn't get one")
 	}
 }
+
+func TestValidateNestedSingleObjectInvalid(t *testing.T) {
+	type Pet struct {
+		Name string `tfschema:"name"`
+		Age  int
+	}
+	type Person struct {
+		Name string `tfschema:"name"`
+		Pet  *Pet   `tfschema:"pet"`
+	}
+	if err := ValidateModelObject(&Person{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestValidateModelObjectWithinInterface(t *testing.T) {
+	type Person struct {
+		Name string `tfschema:"name"`
+		Age  int
+	}
+	var model interface{} = &Person{}
+	if err := ValidateModelObject(&model); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
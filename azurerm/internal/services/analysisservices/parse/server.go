@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ServerId struct {
@@ -37,32 +37,31 @@ func (id ServerId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a Server ID
+func (id ServerId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.AnalysisServices"),
+		resourceid.StaticSegment("servers"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // ServerID parses a Server ID into an ServerId struct
 func ServerID(input string) (*ServerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ServerId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ServerId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.Name, err = id.PopSegment("servers"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
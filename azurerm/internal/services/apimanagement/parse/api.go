@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiId struct {
@@ -40,35 +40,34 @@ func (id ApiId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a Api ID
+func (id ApiId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.ApiManagement"),
+		resourceid.StaticSegment("service"),
+		resourceid.UserSpecifiedSegment("ServiceName"),
+		resourceid.StaticSegment("apis"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // ApiID parses a Api ID into an ApiId struct
 func ApiID(input string) (*ApiId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ApiId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ApiId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("apis"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		ServiceName:    id.Parsed["ServiceName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiDiagnosticId struct {
@@ -43,38 +43,37 @@ func (id ApiDiagnosticId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.DiagnosticName)
 }
 
+// Segments returns the ordered list of Segments which make up a ApiDiagnostic ID
+func (id ApiDiagnosticId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.ApiManagement"),
+		resourceid.StaticSegment("service"),
+		resourceid.UserSpecifiedSegment("ServiceName"),
+		resourceid.StaticSegment("apis"),
+		resourceid.UserSpecifiedSegment("ApiName"),
+		resourceid.StaticSegment("diagnostics"),
+		resourceid.UserSpecifiedSegment("DiagnosticName"),
+	}
+}
+
 // ApiDiagnosticID parses a ApiDiagnostic ID into an ApiDiagnosticId struct
 func ApiDiagnosticID(input string) (*ApiDiagnosticId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ApiDiagnosticId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ApiDiagnosticId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.ApiName, err = id.PopSegment("apis"); err != nil {
-		return nil, err
-	}
-	if resourceId.DiagnosticName, err = id.PopSegment("diagnostics"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		ServiceName:    id.Parsed["ServiceName"],
+		ApiName:        id.Parsed["ApiName"],
+		DiagnosticName: id.Parsed["DiagnosticName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiManagementId struct {
@@ -37,32 +37,31 @@ func (id ApiManagementId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName)
 }
 
+// Segments returns the ordered list of Segments which make up a ApiManagement ID
+func (id ApiManagementId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.ApiManagement"),
+		resourceid.StaticSegment("service"),
+		resourceid.UserSpecifiedSegment("ServiceName"),
+	}
+}
+
 // ApiManagementID parses a ApiManagement ID into an ApiManagementId struct
 func ApiManagementID(input string) (*ApiManagementId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ApiManagementId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ApiManagementId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		ServiceName:    id.Parsed["ServiceName"],
 	}
 
 	return &resourceId, nil
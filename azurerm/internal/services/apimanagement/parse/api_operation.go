@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiOperationId struct {
@@ -43,38 +43,37 @@ func (id ApiOperationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.OperationName)
 }
 
+// Segments returns the ordered list of Segments which make up a ApiOperation ID
+func (id ApiOperationId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.ApiManagement"),
+		resourceid.StaticSegment("service"),
+		resourceid.UserSpecifiedSegment("ServiceName"),
+		resourceid.StaticSegment("apis"),
+		resourceid.UserSpecifiedSegment("ApiName"),
+		resourceid.StaticSegment("operations"),
+		resourceid.UserSpecifiedSegment("OperationName"),
+	}
+}
+
 // ApiOperationID parses a ApiOperation ID into an ApiOperationId struct
 func ApiOperationID(input string) (*ApiOperationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ApiOperationId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ApiOperationId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.ApiName, err = id.PopSegment("apis"); err != nil {
-		return nil, err
-	}
-	if resourceId.OperationName, err = id.PopSegment("operations"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		ServiceName:    id.Parsed["ServiceName"],
+		ApiName:        id.Parsed["ApiName"],
+		OperationName:  id.Parsed["OperationName"],
 	}
 
 	return &resourceId, nil
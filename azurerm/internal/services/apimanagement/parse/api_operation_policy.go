@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiOperationPolicyId struct {
@@ -46,41 +46,40 @@ func (id ApiOperationPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.OperationName, id.PolicyName)
 }
 
+// Segments returns the ordered list of Segments which make up a ApiOperationPolicy ID
+func (id ApiOperationPolicyId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.ApiManagement"),
+		resourceid.StaticSegment("service"),
+		resourceid.UserSpecifiedSegment("ServiceName"),
+		resourceid.StaticSegment("apis"),
+		resourceid.UserSpecifiedSegment("ApiName"),
+		resourceid.StaticSegment("operations"),
+		resourceid.UserSpecifiedSegment("OperationName"),
+		resourceid.StaticSegment("policies"),
+		resourceid.UserSpecifiedSegment("PolicyName"),
+	}
+}
+
 // ApiOperationPolicyID parses a ApiOperationPolicy ID into an ApiOperationPolicyId struct
 func ApiOperationPolicyID(input string) (*ApiOperationPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ApiOperationPolicyId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ApiOperationPolicyId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.ApiName, err = id.PopSegment("apis"); err != nil {
-		return nil, err
-	}
-	if resourceId.OperationName, err = id.PopSegment("operations"); err != nil {
-		return nil, err
-	}
-	if resourceId.PolicyName, err = id.PopSegment("policies"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		ServiceName:    id.Parsed["ServiceName"],
+		ApiName:        id.Parsed["ApiName"],
+		OperationName:  id.Parsed["OperationName"],
+		PolicyName:     id.Parsed["PolicyName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type GroupUserId struct {
@@ -43,38 +43,37 @@ func (id GroupUserId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.GroupName, id.UserName)
 }
 
+// Segments returns the ordered list of Segments which make up a GroupUser ID
+func (id GroupUserId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.ApiManagement"),
+		resourceid.StaticSegment("service"),
+		resourceid.UserSpecifiedSegment("ServiceName"),
+		resourceid.StaticSegment("groups"),
+		resourceid.UserSpecifiedSegment("GroupName"),
+		resourceid.StaticSegment("users"),
+		resourceid.UserSpecifiedSegment("UserName"),
+	}
+}
+
 // GroupUserID parses a GroupUser ID into an GroupUserId struct
 func GroupUserID(input string) (*GroupUserId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(GroupUserId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := GroupUserId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.GroupName, err = id.PopSegment("groups"); err != nil {
-		return nil, err
-	}
-	if resourceId.UserName, err = id.PopSegment("users"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		ServiceName:    id.Parsed["ServiceName"],
+		GroupName:      id.Parsed["GroupName"],
+		UserName:       id.Parsed["UserName"],
 	}
 
 	return &resourceId, nil
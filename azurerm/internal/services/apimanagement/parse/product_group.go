@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProductGroupId struct {
@@ -43,38 +43,37 @@ func (id ProductGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ProductName, id.GroupName)
 }
 
+// Segments returns the ordered list of Segments which make up a ProductGroup ID
+func (id ProductGroupId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.ApiManagement"),
+		resourceid.StaticSegment("service"),
+		resourceid.UserSpecifiedSegment("ServiceName"),
+		resourceid.StaticSegment("products"),
+		resourceid.UserSpecifiedSegment("ProductName"),
+		resourceid.StaticSegment("groups"),
+		resourceid.UserSpecifiedSegment("GroupName"),
+	}
+}
+
 // ProductGroupID parses a ProductGroup ID into an ProductGroupId struct
 func ProductGroupID(input string) (*ProductGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ProductGroupId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ProductGroupId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.ProductName, err = id.PopSegment("products"); err != nil {
-		return nil, err
-	}
-	if resourceId.GroupName, err = id.PopSegment("groups"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		ServiceName:    id.Parsed["ServiceName"],
+		ProductName:    id.Parsed["ProductName"],
+		GroupName:      id.Parsed["GroupName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProductPolicyId struct {
@@ -43,38 +43,37 @@ func (id ProductPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ProductName, id.PolicyName)
 }
 
+// Segments returns the ordered list of Segments which make up a ProductPolicy ID
+func (id ProductPolicyId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.ApiManagement"),
+		resourceid.StaticSegment("service"),
+		resourceid.UserSpecifiedSegment("ServiceName"),
+		resourceid.StaticSegment("products"),
+		resourceid.UserSpecifiedSegment("ProductName"),
+		resourceid.StaticSegment("policies"),
+		resourceid.UserSpecifiedSegment("PolicyName"),
+	}
+}
+
 // ProductPolicyID parses a ProductPolicy ID into an ProductPolicyId struct
 func ProductPolicyID(input string) (*ProductPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ProductPolicyId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ProductPolicyId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.ProductName, err = id.PopSegment("products"); err != nil {
-		return nil, err
-	}
-	if resourceId.PolicyName, err = id.PopSegment("policies"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		ServiceName:    id.Parsed["ServiceName"],
+		ProductName:    id.Parsed["ProductName"],
+		PolicyName:     id.Parsed["PolicyName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SmartDetectionRuleId struct {
@@ -40,35 +40,34 @@ func (id SmartDetectionRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ComponentName, id.SmartDetectionRuleName)
 }
 
+// Segments returns the ordered list of Segments which make up a SmartDetectionRule ID
+func (id SmartDetectionRuleId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("microsoft.insights"),
+		resourceid.StaticSegment("components"),
+		resourceid.UserSpecifiedSegment("ComponentName"),
+		resourceid.StaticSegment("SmartDetectionRule"),
+		resourceid.UserSpecifiedSegment("SmartDetectionRuleName"),
+	}
+}
+
 // SmartDetectionRuleID parses a SmartDetectionRule ID into an SmartDetectionRuleId struct
 func SmartDetectionRuleID(input string) (*SmartDetectionRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(SmartDetectionRuleId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SmartDetectionRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ComponentName, err = id.PopSegment("components"); err != nil {
-		return nil, err
-	}
-	if resourceId.SmartDetectionRuleName, err = id.PopSegment("SmartDetectionRule"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         id.Parsed["SubscriptionId"],
+		ResourceGroup:          id.Parsed["ResourceGroup"],
+		ComponentName:          id.Parsed["ComponentName"],
+		SmartDetectionRuleName: id.Parsed["SmartDetectionRuleName"],
 	}
 
 	return &resourceId, nil
@@ -18,6 +18,11 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+// ResourceProviderNamespace returns the Resource Provider Namespace used by the specified Resource Type
+func (r Registration) ResourceProviderNamespace(_ string) string {
+	return "Microsoft.Insights"
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
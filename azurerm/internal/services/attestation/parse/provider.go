@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProviderId struct {
@@ -37,32 +37,31 @@ func (id ProviderId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AttestationProviderName)
 }
 
+// Segments returns the ordered list of Segments which make up a Provider ID
+func (id ProviderId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Attestation"),
+		resourceid.StaticSegment("attestationProviders"),
+		resourceid.UserSpecifiedSegment("AttestationProviderName"),
+	}
+}
+
 // ProviderID parses a Provider ID into an ProviderId struct
 func ProviderID(input string) (*ProviderId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ProviderId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ProviderId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.AttestationProviderName, err = id.PopSegment("attestationProviders"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:          id.Parsed["SubscriptionId"],
+		ResourceGroup:           id.Parsed["ResourceGroup"],
+		AttestationProviderName: id.Parsed["AttestationProviderName"],
 	}
 
 	return &resourceId, nil
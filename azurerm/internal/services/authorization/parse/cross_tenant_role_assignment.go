@@ -0,0 +1,64 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CrossTenantRoleAssignmentId is a Role Assignment ID which can additionally contain the ID of the Tenant which
+// the Role Assignment exists within, for Role Assignments made across Tenants - in the format `{id}|{tenantId}`
+type CrossTenantRoleAssignmentId struct {
+	RoleAssignmentId
+	TenantId string
+}
+
+func NewCrossTenantRoleAssignmentID(id RoleAssignmentId, tenantId string) CrossTenantRoleAssignmentId {
+	return CrossTenantRoleAssignmentId{
+		RoleAssignmentId: id,
+		TenantId:         tenantId,
+	}
+}
+
+// AzureResourceID returns the Azure Resource ID of this Role Assignment, without the Tenant ID
+func (id CrossTenantRoleAssignmentId) AzureResourceID() string {
+	return id.RoleAssignmentId.ID()
+}
+
+func (id CrossTenantRoleAssignmentId) ID() string {
+	return ConstructRoleAssignmentId(id.AzureResourceID(), id.TenantId)
+}
+
+// in general case, the id format does not change
+// for cross tenant scenario, add the tenantId info
+func ConstructRoleAssignmentId(azureResourceId, tenantId string) string {
+	if tenantId == "" {
+		return azureResourceId
+	}
+	return fmt.Sprintf("%s|%s", azureResourceId, tenantId)
+}
+
+// CrossTenantRoleAssignmentID parses a Role Assignment ID, which can optionally contain a Tenant ID, into a
+// CrossTenantRoleAssignmentId struct
+func CrossTenantRoleAssignmentID(input string) (*CrossTenantRoleAssignmentId, error) {
+	tenantId := ""
+	parts := strings.Split(input, "|")
+	switch len(parts) {
+	case 1:
+	case 2:
+		if parts[1] == "" {
+			return nil, fmt.Errorf("parsing %q: the Tenant ID was empty", input)
+		}
+		input = parts[0]
+		tenantId = parts[1]
+	default:
+		return nil, fmt.Errorf("parsing %q: expected the format `{roleAssignmentId}|{tenantId}`", input)
+	}
+
+	id, err := RoleAssignmentID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := NewCrossTenantRoleAssignmentID(*id, tenantId)
+	return &result, nil
+}
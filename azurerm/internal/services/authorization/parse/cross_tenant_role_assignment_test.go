@@ -0,0 +1,126 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = CrossTenantRoleAssignmentId{}
+
+func TestCrossTenantRoleAssignmentIDFormatter(t *testing.T) {
+	testData := []struct {
+		Scope    string
+		Name     string
+		TenantId string
+		Expected string
+	}{
+		{
+			Scope:    "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Name:     "23456781-2349-8764-5631-234567890121",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+		},
+		{
+			Scope:    "/providers/Microsoft.Management/managementGroups/managementGroup1",
+			Name:     "23456781-2349-8764-5631-234567890121",
+			TenantId: "34567812-3456-7653-6742-345678901234",
+			Expected: "/providers/Microsoft.Management/managementGroups/managementGroup1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121|34567812-3456-7653-6742-345678901234",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Expected)
+
+		actual := NewCrossTenantRoleAssignmentID(NewRoleAssignmentID(v.Scope, v.Name), v.TenantId).ID()
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestCrossTenantRoleAssignmentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *CrossTenantRoleAssignmentId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// missing tenant id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121|",
+			Error: true,
+		},
+		{
+			// too many segments
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121|tenant1|tenant2",
+			Error: true,
+		},
+		{
+			// invalid role assignment id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/|34567812-3456-7653-6742-345678901234",
+			Error: true,
+		},
+		{
+			// subscription
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+			Expected: &CrossTenantRoleAssignmentId{
+				RoleAssignmentId: RoleAssignmentId{
+					Scope: "/subscriptions/12345678-1234-9876-4563-123456789012",
+					Name:  "23456781-2349-8764-5631-234567890121",
+				},
+			},
+		},
+		{
+			// management group with a tenant
+			Input: "/providers/Microsoft.Management/managementGroups/managementGroup1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121|34567812-3456-7653-6742-345678901234",
+			Expected: &CrossTenantRoleAssignmentId{
+				RoleAssignmentId: RoleAssignmentId{
+					Scope: "/providers/Microsoft.Management/managementGroups/managementGroup1",
+					Name:  "23456781-2349-8764-5631-234567890121",
+				},
+				TenantId: "34567812-3456-7653-6742-345678901234",
+			},
+		},
+		{
+			// nested resource with a tenant
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AppPlatform/Spring/spring1/apps/app1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121|34567812-3456-7653-6742-345678901234",
+			Expected: &CrossTenantRoleAssignmentId{
+				RoleAssignmentId: RoleAssignmentId{
+					Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AppPlatform/Spring/spring1/apps/app1",
+					Name:  "23456781-2349-8764-5631-234567890121",
+				},
+				TenantId: "34567812-3456-7653-6742-345678901234",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := CrossTenantRoleAssignmentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+		if actual.TenantId != v.Expected.TenantId {
+			t.Fatalf("Expected %q but got %q for TenantId", v.Expected.TenantId, actual.TenantId)
+		}
+	}
+}
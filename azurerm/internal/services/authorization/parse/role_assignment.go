@@ -1,129 +1,62 @@
 package parse
 
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
 import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RoleAssignmentId struct {
-	SubscriptionID   string
-	ResourceGroup    string
-	ManagementGroup  string
-	ResourceScope    string
-	ResourceProvider string
-	Name             string
-	TenantId         string
+	Scope string
+	Name  string
 }
 
-func NewRoleAssignmentID(subscriptionId, resourceGroup, resourceProvider, resourceScope, managementGroup, name, tenantId string) (*RoleAssignmentId, error) {
-	if subscriptionId == "" && resourceGroup == "" && managementGroup == "" {
-		return nil, fmt.Errorf("one of subscriptionId, resourceGroup, or managementGroup must be provided")
-	}
-
-	if managementGroup != "" {
-		if subscriptionId != "" || resourceGroup != "" {
-			return nil, fmt.Errorf("cannot provide subscriptionId or resourceGroup when managementGroup is provided")
-		}
-	}
-
-	if resourceGroup != "" {
-		if subscriptionId == "" {
-			return nil, fmt.Errorf("subscriptionId must not be empty when resourceGroup is provided")
-		}
+func NewRoleAssignmentID(scope, name string) RoleAssignmentId {
+	return RoleAssignmentId{
+		Scope: scope,
+		Name:  name,
 	}
-
-	return &RoleAssignmentId{
-		SubscriptionID:   subscriptionId,
-		ResourceGroup:    resourceGroup,
-		ResourceProvider: resourceProvider,
-		ResourceScope:    resourceScope,
-		ManagementGroup:  managementGroup,
-		Name:             name,
-		TenantId:         tenantId,
-	}, nil
 }
 
-// in general case, the id format does not change
-// for cross tenant scenario, add the tenantId info
-func (id RoleAssignmentId) AzureResourceID() string {
-	if id.ResourceScope != "" {
-		fmtString := "/subscriptions/%s/resourceGroups/%s/providers/%s/%s/providers/Microsoft.Authorization/roleAssignments/%s"
-		return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ResourceProvider, id.ResourceScope, id.Name)
-	}
-
-	if id.ManagementGroup != "" {
-		fmtString := "/providers/Microsoft.Management/managementGroups/%s/providers/Microsoft.Authorization/roleAssignments/%s"
-		return fmt.Sprintf(fmtString, id.ManagementGroup, id.Name)
+func (id RoleAssignmentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Scope %q", id.Scope),
 	}
-
-	if id.ResourceGroup != "" {
-		fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Authorization/roleAssignments/%s"
-		return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
-	}
-
-	fmtString := "/subscriptions/%s/providers/Microsoft.Authorization/roleAssignments/%s"
-	return fmt.Sprintf(fmtString, id.SubscriptionID, id.Name)
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Role Assignment", segmentsStr)
 }
 
 func (id RoleAssignmentId) ID() string {
-	return ConstructRoleAssignmentId(id.AzureResourceID(), id.TenantId)
+	fmtString := "%s/providers/Microsoft.Authorization/roleAssignments/%s"
+	return fmt.Sprintf(fmtString, strings.TrimSuffix(id.Scope, "/"), id.Name)
 }
 
-func ConstructRoleAssignmentId(azureResourceId, tenantId string) string {
-	if tenantId == "" {
-		return azureResourceId
+// Segments returns the ordered list of Segments which make up a RoleAssignment ID
+func (id RoleAssignmentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.ScopeSegment("Scope"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Authorization"),
+		resourceid.StaticSegment("roleAssignments").Insensitively(),
+		resourceid.UserSpecifiedSegment("Name"),
 	}
-	return fmt.Sprintf("%s|%s", azureResourceId, tenantId)
 }
 
+// RoleAssignmentID parses a RoleAssignment ID into an RoleAssignmentId struct
 func RoleAssignmentID(input string) (*RoleAssignmentId, error) {
-	if len(input) == 0 {
-		return nil, fmt.Errorf("Role Assignment ID is empty string")
+	id, err := resourceid.NewParser(RoleAssignmentId{}).Parse(input, false)
+	if err != nil {
+		return nil, err
 	}
 
-	roleAssignmentId := RoleAssignmentId{}
-
-	parts := strings.Split(input, "|")
-	if len(parts) == 2 {
-		roleAssignmentId.TenantId = parts[1]
-		input = parts[0]
-	}
-
-	switch {
-	case strings.HasPrefix(input, "/subscriptions/"):
-		id, err := azure.ParseAzureResourceID(input)
-		if err != nil {
-			return nil, fmt.Errorf("could not parse %q as Azure resource ID", input)
-		}
-		roleAssignmentId.SubscriptionID = id.SubscriptionID
-		roleAssignmentId.ResourceGroup = id.ResourceGroup
-		if id.Provider != "Microsoft.Authorization" && id.Provider != "" {
-			roleAssignmentId.ResourceProvider = id.Provider
-			// logic to save resource scope
-			result := strings.Split(input, "/providers/")
-			if len(result) == 3 {
-				roleAssignmentId.ResourceScope = strings.TrimPrefix(result[1], fmt.Sprintf("%s/", id.Provider))
-			}
-		}
-
-		if roleAssignmentId.Name, err = id.PopSegment("roleAssignments"); err != nil {
-			return nil, err
-		}
-	case strings.HasPrefix(input, "/providers/Microsoft.Management/"):
-		idParts := strings.Split(input, "/providers/Microsoft.Authorization/roleAssignments/")
-		if len(idParts) != 2 {
-			return nil, fmt.Errorf("could not parse Role Assignment ID %q for Management Group", input)
-		}
-		if idParts[1] == "" {
-			return nil, fmt.Errorf("ID was missing a value for the roleAssignments element")
-		}
-		roleAssignmentId.Name = idParts[1]
-		roleAssignmentId.ManagementGroup = strings.TrimPrefix(idParts[0], "/providers/Microsoft.Management/managementGroups/")
-	default:
-		return nil, fmt.Errorf("could not parse Role Assignment ID %q", input)
+	resourceId := RoleAssignmentId{
+		Scope: id.Parsed["Scope"],
+		Name:  id.Parsed["Name"],
 	}
 
-	return &roleAssignmentId, nil
+	return &resourceId, nil
 }
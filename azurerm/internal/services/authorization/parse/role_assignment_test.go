@@ -1,5 +1,7 @@
 package parse
 
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
 import (
 	"testing"
 
@@ -9,99 +11,10 @@ import (
 var _ resourceid.Formatter = RoleAssignmentId{}
 
 func TestRoleAssignmentIDFormatter(t *testing.T) {
-	testData := []struct {
-		SubscriptionId   string
-		ResourceGroup    string
-		ResourceProvider string
-		ResourceScope    string
-		ManagementGroup  string
-		Name             string
-		TenantId         string
-		Expected         string
-	}{
-		{
-			SubscriptionId:  "",
-			ResourceGroup:   "",
-			ResourceScope:   "",
-			ManagementGroup: "",
-			Name:            "23456781-2349-8764-5631-234567890121",
-			TenantId:        "",
-		},
-		{
-			SubscriptionId:  "12345678-1234-9876-4563-123456789012",
-			ResourceGroup:   "group1",
-			ResourceScope:   "",
-			ManagementGroup: "managementGroup1",
-			Name:            "23456781-2349-8764-5631-234567890121",
-			TenantId:        "",
-		},
-		{
-			SubscriptionId:  "12345678-1234-9876-4563-123456789012",
-			ResourceGroup:   "",
-			ResourceScope:   "",
-			ManagementGroup: "managementGroup1",
-			Name:            "23456781-2349-8764-5631-234567890121",
-			TenantId:        "",
-		},
-		{
-			SubscriptionId:  "12345678-1234-9876-4563-123456789012",
-			ResourceGroup:   "",
-			ResourceScope:   "",
-			ManagementGroup: "",
-			Name:            "23456781-2349-8764-5631-234567890121",
-			TenantId:        "",
-			Expected:        "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
-		},
-		{
-			SubscriptionId:  "12345678-1234-9876-4563-123456789012",
-			ResourceGroup:   "group1",
-			ResourceScope:   "",
-			ManagementGroup: "",
-			Name:            "23456781-2349-8764-5631-234567890121",
-			TenantId:        "",
-			Expected:        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
-		},
-		{
-			SubscriptionId:  "",
-			ResourceGroup:   "",
-			ResourceScope:   "",
-			ManagementGroup: "12345678-1234-9876-4563-123456789012",
-			Name:            "23456781-2349-8764-5631-234567890121",
-			TenantId:        "",
-			Expected:        "/providers/Microsoft.Management/managementGroups/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
-		},
-		{
-			SubscriptionId:  "",
-			ResourceGroup:   "",
-			ResourceScope:   "",
-			ManagementGroup: "12345678-1234-9876-4563-123456789012",
-			Name:            "23456781-2349-8764-5631-234567890121",
-			TenantId:        "34567812-3456-7653-6742-345678901234",
-			Expected:        "/providers/Microsoft.Management/managementGroups/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121|34567812-3456-7653-6742-345678901234",
-		},
-		{
-			SubscriptionId:   "12345678-1234-9876-4563-123456789012",
-			ResourceGroup:    "group1",
-			ResourceProvider: "Microsoft.Storage",
-			ResourceScope:    "storageAccounts/nameStorageAccount",
-			ManagementGroup:  "",
-			Name:             "23456781-2349-8764-5631-234567890121",
-			TenantId:         "34567812-3456-7653-6742-345678901234",
-			Expected:         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/nameStorageAccount/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121|34567812-3456-7653-6742-345678901234",
-		},
-	}
-	for _, v := range testData {
-		t.Logf("testing %+v", v)
-		actual, err := NewRoleAssignmentID(v.SubscriptionId, v.ResourceGroup, v.ResourceProvider, v.ResourceScope, v.ManagementGroup, v.Name, v.TenantId)
-		if err != nil {
-			if v.Expected == "" {
-				continue
-			}
-		}
-		actualId := actual.ID()
-		if actualId != v.Expected {
-			t.Fatalf("expected %q, got %q", v.Expected, actualId)
-		}
+	actual := NewRoleAssignmentID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1", "23456781-2349-8764-5631-234567890121").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
 	}
 }
 
@@ -111,6 +24,7 @@ func TestRoleAssignmentID(t *testing.T) {
 		Error    bool
 		Expected *RoleAssignmentId
 	}{
+
 		{
 			// empty
 			Input: "",
@@ -118,108 +32,76 @@ func TestRoleAssignmentID(t *testing.T) {
 		},
 
 		{
-			// missing SubscriptionId
-			Input: "/",
-			Error: true,
-		},
-
-		{
-			// just subscription
-			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
-			Error: true,
-		},
-
-		{
-			// missing ResourceGroup value
-			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
-			Error: true,
-		},
-
-		{
-			// missing Management Group value
-			Input: "/providers/Microsoft.Management/managementGroups/",
-			Error: true,
-		},
-
-		{
-			// missing Role Assignment value at Subscription Scope
-			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/",
+			// missing Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/",
 			Error: true,
 		},
 
 		{
-			// missing Role Assignment value at Management Group scope
-			Input: "/providers/Microsoft.Management/managementGroups/managementGroup1/providers/Microsoft.Authorization/roleAssignments/",
+			// missing value for Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/roleAssignments/",
 			Error: true,
 		},
 
 		{
-			// valid at subscription scope
-			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
 			Expected: &RoleAssignmentId{
-				SubscriptionID:  "12345678-1234-9876-4563-123456789012",
-				ResourceGroup:   "",
-				ResourceScope:   "",
-				ManagementGroup: "",
-				Name:            "23456781-2349-8764-5631-234567890121",
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+				Name:  "23456781-2349-8764-5631-234567890121",
 			},
 		},
 
 		{
-			// valid at resource group scope
-			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+			// valid at the Scope "/"
+			Input: "/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
 			Expected: &RoleAssignmentId{
-				SubscriptionID:  "12345678-1234-9876-4563-123456789012",
-				ResourceGroup:   "group1",
-				ManagementGroup: "",
-				Name:            "23456781-2349-8764-5631-234567890121",
+				Scope: "/",
+				Name:  "23456781-2349-8764-5631-234567890121",
 			},
 		},
 
 		{
-			// valid at management group scope
+			// valid at the Scope "/providers/Microsoft.Management/managementGroups/managementGroup1"
 			Input: "/providers/Microsoft.Management/managementGroups/managementGroup1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
 			Expected: &RoleAssignmentId{
-				SubscriptionID:  "",
-				ResourceGroup:   "",
-				ManagementGroup: "managementGroup1",
-				Name:            "23456781-2349-8764-5631-234567890121",
+				Scope: "/providers/Microsoft.Management/managementGroups/managementGroup1",
+				Name:  "23456781-2349-8764-5631-234567890121",
 			},
 		},
+
 		{
-			Input: "/providers/Microsoft.Management/managementGroups/managementGroup1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121|34567812-3456-7653-6742-345678901234",
+			// valid at the Scope "/subscriptions/12345678-1234-9876-4563-123456789012"
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
 			Expected: &RoleAssignmentId{
-				SubscriptionID:  "",
-				ResourceGroup:   "",
-				ManagementGroup: "managementGroup1",
-				Name:            "23456781-2349-8764-5631-234567890121",
-				TenantId:        "34567812-3456-7653-6742-345678901234",
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012",
+				Name:  "23456781-2349-8764-5631-234567890121",
 			},
 		},
+
 		{
-			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/nameStorageAccount/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121|34567812-3456-7653-6742-345678901234",
+			// valid at the Scope "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1"
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
 			Expected: &RoleAssignmentId{
-				SubscriptionID:   "12345678-1234-9876-4563-123456789012",
-				ResourceGroup:    "group1",
-				ResourceProvider: "Microsoft.Storage",
-				ResourceScope:    "storageAccounts/nameStorageAccount",
-				ManagementGroup:  "",
-				Name:             "23456781-2349-8764-5631-234567890121",
-				TenantId:         "34567812-3456-7653-6742-345678901234",
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1",
+				Name:  "23456781-2349-8764-5631-234567890121",
 			},
 		},
+
 		{
-			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AppPlatform/Spring/spring1/apps/app1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121|34567812-3456-7653-6742-345678901234",
+			// case-insensitive segments
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/roleassignments/23456781-2349-8764-5631-234567890121",
 			Expected: &RoleAssignmentId{
-				SubscriptionID:   "12345678-1234-9876-4563-123456789012",
-				ResourceGroup:    "group1",
-				ResourceProvider: "Microsoft.AppPlatform",
-				ResourceScope:    "Spring/spring1/apps/app1",
-				ManagementGroup:  "",
-				Name:             "23456781-2349-8764-5631-234567890121",
-				TenantId:         "34567812-3456-7653-6742-345678901234",
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+				Name:  "23456781-2349-8764-5631-234567890121",
 			},
 		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.AUTHORIZATION/ROLEASSIGNMENTS/23456781-2349-8764-5631-234567890121",
+			Error: true,
+		},
 	}
 
 	for _, v := range testData {
@@ -231,35 +113,17 @@ func TestRoleAssignmentID(t *testing.T) {
 				continue
 			}
 
-			t.Fatalf("expected a value but got an error: %+v", err)
+			t.Fatalf("Expect a value but got an error: %s", err)
 		}
-
 		if v.Error {
 			t.Fatal("Expect an error but didn't get one")
 		}
 
-		if actual.Name != v.Expected.Name {
-			t.Fatalf("Expected %q but got %q for Role Assignment Name", v.Expected.Name, actual.Name)
-		}
-
-		if actual.SubscriptionID != v.Expected.SubscriptionID {
-			t.Fatalf("Expected %q but got %q for Role Assignment Subscription ID", v.Expected.SubscriptionID, actual.SubscriptionID)
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
 		}
-
-		if actual.ResourceGroup != v.Expected.ResourceGroup {
-			t.Fatalf("Expected %q but got %q for Role Assignment Resource Group", v.Expected.ResourceGroup, actual.ResourceGroup)
-		}
-
-		if actual.ResourceProvider != v.Expected.ResourceProvider {
-			t.Fatalf("Expected %q but got %q for Role Assignment Resource Provider", v.Expected.ResourceProvider, actual.ResourceProvider)
-		}
-
-		if actual.ResourceScope != v.Expected.ResourceScope {
-			t.Fatalf("Expected %q but got %q for Role Assignment Resource Scope", v.Expected.ResourceScope, actual.ResourceScope)
-		}
-
-		if actual.ManagementGroup != v.Expected.ManagementGroup {
-			t.Fatalf("Expected %q but got %q for Role Assignment Management Group", v.Expected.ManagementGroup, actual.ManagementGroup)
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
 		}
 	}
 }
@@ -1,40 +1,62 @@
 package parse
 
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
 import (
 	"fmt"
 	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
-type RoleDefinitionID struct {
-	ResourceID string
-	Scope      string
-	RoleID     string
+type RoleDefinitionId struct {
+	Scope string
+	Name  string
+}
+
+func NewRoleDefinitionID(scope, name string) RoleDefinitionId {
+	return RoleDefinitionId{
+		Scope: scope,
+		Name:  name,
+	}
 }
 
-// RoleDefinitionId is a pseudo ID for storing Scope parameter as this it not retrievable from API
-// It is formed of the Azure Resource ID for the Role and the Scope it is created against
-func RoleDefinitionId(input string) (*RoleDefinitionID, error) {
-	parts := strings.Split(input, "|")
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("could not parse Role Definition ID, invalid format %q", input)
+func (id RoleDefinitionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Scope %q", id.Scope),
 	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Role Definition", segmentsStr)
+}
 
-	idParts := strings.Split(parts[0], "roleDefinitions/")
+func (id RoleDefinitionId) ID() string {
+	fmtString := "%s/providers/Microsoft.Authorization/roleDefinitions/%s"
+	return fmt.Sprintf(fmtString, strings.TrimSuffix(id.Scope, "/"), id.Name)
+}
 
-	if !strings.HasPrefix(parts[1], "/subscriptions/") && !strings.HasPrefix(parts[1], "/providers/Microsoft.Management/managementGroups/") {
-		return nil, fmt.Errorf("failed to parse scope from Role Definition ID %q", input)
+// Segments returns the ordered list of Segments which make up a RoleDefinition ID
+func (id RoleDefinitionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.ScopeSegment("Scope"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Authorization"),
+		resourceid.StaticSegment("roleDefinitions").Insensitively(),
+		resourceid.UserSpecifiedSegment("Name"),
 	}
+}
 
-	roleDefinitionID := RoleDefinitionID{
-		ResourceID: parts[0],
-		Scope:      parts[1],
+// RoleDefinitionID parses a RoleDefinition ID into an RoleDefinitionId struct
+func RoleDefinitionID(input string) (*RoleDefinitionId, error) {
+	id, err := resourceid.NewParser(RoleDefinitionId{}).Parse(input, false)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(idParts) < 1 {
-		return nil, fmt.Errorf("failed to parse Role Definition ID from resource ID %q", input)
-	} else {
-		roleDefinitionID.RoleID = idParts[1]
+	resourceId := RoleDefinitionId{
+		Scope: id.Parsed["Scope"],
+		Name:  id.Parsed["Name"],
 	}
 
-	return &roleDefinitionID, nil
+	return &resourceId, nil
 }
@@ -0,0 +1,37 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+type RoleDefinitionResourceId struct {
+	ResourceID string
+	Scope      string
+	RoleID     string
+}
+
+// RoleDefinitionResourceID parses the pseudo ID used by the Role Definition resource, which stores the Scope
+// parameter since this isn't retrievable from the API - it's formed of the Azure Resource ID for the Role and
+// the Scope it's created against, in the format `{roleDefinitionId}|{scope}`
+func RoleDefinitionResourceID(input string) (*RoleDefinitionResourceId, error) {
+	parts := strings.Split(input, "|")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("could not parse Role Definition ID, invalid format %q", input)
+	}
+
+	if !strings.HasPrefix(parts[1], "/subscriptions/") && !strings.HasPrefix(parts[1], "/providers/Microsoft.Management/managementGroups/") {
+		return nil, fmt.Errorf("failed to parse scope from Role Definition ID %q", input)
+	}
+
+	id, err := RoleDefinitionID(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Role Definition ID from resource ID %q: %+v", input, err)
+	}
+
+	return &RoleDefinitionResourceId{
+		ResourceID: parts[0],
+		Scope:      parts[1],
+		RoleID:     id.Name,
+	}, nil
+}
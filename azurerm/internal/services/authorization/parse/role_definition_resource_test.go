@@ -0,0 +1,72 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestRoleDefinitionResourceID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *RoleDefinitionResourceId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// missing scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/23456781-2349-8764-5631-234567890121",
+			Error: true,
+		},
+		{
+			// invalid scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/23456781-2349-8764-5631-234567890121|/tenants/tenant1",
+			Error: true,
+		},
+		{
+			// missing role definition name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/|/subscriptions/12345678-1234-9876-4563-123456789012",
+			Error: true,
+		},
+		{
+			// subscription
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/23456781-2349-8764-5631-234567890121|/subscriptions/12345678-1234-9876-4563-123456789012",
+			Expected: &RoleDefinitionResourceId{
+				ResourceID: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/23456781-2349-8764-5631-234567890121",
+				Scope:      "/subscriptions/12345678-1234-9876-4563-123456789012",
+				RoleID:     "23456781-2349-8764-5631-234567890121",
+			},
+		},
+		{
+			// management group
+			Input: "/providers/Microsoft.Authorization/roleDefinitions/23456781-2349-8764-5631-234567890121|/providers/Microsoft.Management/managementGroups/managementGroup1",
+			Expected: &RoleDefinitionResourceId{
+				ResourceID: "/providers/Microsoft.Authorization/roleDefinitions/23456781-2349-8764-5631-234567890121",
+				Scope:      "/providers/Microsoft.Management/managementGroups/managementGroup1",
+				RoleID:     "23456781-2349-8764-5631-234567890121",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := RoleDefinitionResourceID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
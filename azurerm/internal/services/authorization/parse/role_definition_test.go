@@ -0,0 +1,120 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = RoleDefinitionId{}
+
+func TestRoleDefinitionIDFormatter(t *testing.T) {
+	actual := NewRoleDefinitionID("/subscriptions/12345678-1234-9876-4563-123456789012", "12345678-1234-9876-4563-123456789012").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/12345678-1234-9876-4563-123456789012"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestRoleDefinitionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *RoleDefinitionId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/12345678-1234-9876-4563-123456789012",
+			Expected: &RoleDefinitionId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012",
+				Name:  "12345678-1234-9876-4563-123456789012",
+			},
+		},
+
+		{
+			// valid at the Scope "/"
+			Input: "/providers/Microsoft.Authorization/roleDefinitions/12345678-1234-9876-4563-123456789012",
+			Expected: &RoleDefinitionId{
+				Scope: "/",
+				Name:  "12345678-1234-9876-4563-123456789012",
+			},
+		},
+
+		{
+			// valid at the Scope "/providers/Microsoft.Management/managementGroups/managementGroup1"
+			Input: "/providers/Microsoft.Management/managementGroups/managementGroup1/providers/Microsoft.Authorization/roleDefinitions/12345678-1234-9876-4563-123456789012",
+			Expected: &RoleDefinitionId{
+				Scope: "/providers/Microsoft.Management/managementGroups/managementGroup1",
+				Name:  "12345678-1234-9876-4563-123456789012",
+			},
+		},
+
+		{
+			// valid at the Scope "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1"
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/roleDefinitions/12345678-1234-9876-4563-123456789012",
+			Expected: &RoleDefinitionId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+				Name:  "12345678-1234-9876-4563-123456789012",
+			},
+		},
+
+		{
+			// case-insensitive segments
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roledefinitions/12345678-1234-9876-4563-123456789012",
+			Expected: &RoleDefinitionId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012",
+				Name:  "12345678-1234-9876-4563-123456789012",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.AUTHORIZATION/ROLEDEFINITIONS/12345678-1234-9876-4563-123456789012",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := RoleDefinitionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
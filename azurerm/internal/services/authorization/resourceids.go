@@ -0,0 +1,4 @@
+package authorization
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=RoleAssignment -id=/{scope}/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121 -scopes=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1,/subscriptions/12345678-1234-9876-4563-123456789012,/providers/Microsoft.Management/managementGroups/managementGroup1,/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1,/ -insensitive=roleAssignments
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=RoleDefinition -id=/{scope}/providers/Microsoft.Authorization/roleDefinitions/12345678-1234-9876-4563-123456789012 -scopes=/subscriptions/12345678-1234-9876-4563-123456789012,/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1,/providers/Microsoft.Management/managementGroups/managementGroup1,/ -insensitive=roleDefinitions
@@ -244,7 +244,7 @@ func resourceArmRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{})
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.RoleAssignmentID(d.Id())
+	id, err := parse.CrossTenantRoleAssignmentID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -292,12 +292,12 @@ func resourceArmRoleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parseRoleAssignmentId(d.Id())
+	id, err := parse.CrossTenantRoleAssignmentID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.scope, id.name, id.tenantId)
+	resp, err := client.Delete(ctx, strings.TrimPrefix(id.Scope, "/"), id.Name, id.TenantId)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp.Response) {
 			return err
@@ -351,34 +351,6 @@ func retryRoleAssignmentsClient(d *pluginsdk.ResourceData, scope string, name st
 	}
 }
 
-type roleAssignmentId struct {
-	scope    string
-	name     string
-	tenantId string
-}
-
-func parseRoleAssignmentId(input string) (*roleAssignmentId, error) {
-	tenantId := ""
-	segments := strings.Split(input, "|")
-	if len(segments) == 2 {
-		tenantId = segments[1]
-		input = segments[0]
-	}
-
-	segments = strings.Split(input, "/providers/Microsoft.Authorization/roleAssignments/")
-	if len(segments) != 2 {
-		return nil, fmt.Errorf("Expected Role Assignment ID to be in the format `{scope}/providers/Microsoft.Authorization/roleAssignments/{name}` but got %q", input)
-	}
-
-	// /{scope}/providers/Microsoft.Authorization/roleAssignments/{roleAssignmentName}
-	id := roleAssignmentId{
-		scope:    strings.TrimPrefix(segments[0], "/"),
-		name:     segments[1],
-		tenantId: tenantId,
-	}
-	return &id, nil
-}
-
 func roleAssignmentCreateStateRefreshFunc(ctx context.Context, client *authorization.RoleAssignmentsClient, roleID string, tenantId string) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		resp, err := client.GetByID(ctx, roleID, tenantId)
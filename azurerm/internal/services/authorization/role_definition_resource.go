@@ -26,7 +26,7 @@ func resourceArmRoleDefinition() *pluginsdk.Resource {
 		Delete: resourceArmRoleDefinitionDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.RoleDefinitionId(id)
+			_, err := parse.RoleDefinitionResourceID(id)
 			return err
 		}),
 
@@ -177,7 +177,7 @@ func resourceArmRoleDefinitionCreate(d *pluginsdk.ResourceData, meta interface{}
 
 	// (@jackofallops) - Updates are subject to eventual consistency, and could be read as stale data
 	if !d.IsNewResource() {
-		id, err := parse.RoleDefinitionId(d.Id())
+		id, err := parse.RoleDefinitionResourceID(d.Id())
 		if err != nil {
 			return err
 		}
@@ -217,7 +217,7 @@ func resourceArmRoleDefinitionUpdate(d *pluginsdk.ResourceData, meta interface{}
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	roleDefinitionId, err := parse.RoleDefinitionId(d.Id())
+	roleDefinitionId, err := parse.RoleDefinitionResourceID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -278,7 +278,7 @@ func resourceArmRoleDefinitionRead(d *pluginsdk.ResourceData, meta interface{})
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	roleDefinitionId, err := parse.RoleDefinitionId(d.Id())
+	roleDefinitionId, err := parse.RoleDefinitionResourceID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -321,7 +321,7 @@ func resourceArmRoleDefinitionDelete(d *pluginsdk.ResourceData, meta interface{}
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, _ := parse.RoleDefinitionId(d.Id())
+	id, _ := parse.RoleDefinitionResourceID(d.Id())
 
 	resp, err := client.Delete(ctx, id.Scope, id.RoleID)
 	if err != nil {
@@ -351,7 +351,7 @@ func resourceArmRoleDefinitionDelete(d *pluginsdk.ResourceData, meta interface{}
 	return nil
 }
 
-func roleDefinitionEventualConsistencyUpdate(ctx context.Context, client azuresdkhacks.RoleDefinitionsWorkaroundClient, id parse.RoleDefinitionID, updateRequestDate string) pluginsdk.StateRefreshFunc {
+func roleDefinitionEventualConsistencyUpdate(ctx context.Context, client azuresdkhacks.RoleDefinitionsWorkaroundClient, id parse.RoleDefinitionResourceId, updateRequestDate string) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		resp, err := client.Get(ctx, id.Scope, id.RoleID)
 		if err != nil {
@@ -0,0 +1,70 @@
+package validate
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import "testing"
+
+func TestRoleDefinitionID(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+
+		{
+			// missing Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/",
+			Valid: false,
+		},
+
+		{
+			// missing value for Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/",
+			Valid: false,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/12345678-1234-9876-4563-123456789012",
+			Valid: true,
+		},
+
+		{
+			// valid at the Scope "/"
+			Input: "/providers/Microsoft.Authorization/roleDefinitions/12345678-1234-9876-4563-123456789012",
+			Valid: true,
+		},
+
+		{
+			// valid at the Scope "/providers/Microsoft.Management/managementGroups/managementGroup1"
+			Input: "/providers/Microsoft.Management/managementGroups/managementGroup1/providers/Microsoft.Authorization/roleDefinitions/12345678-1234-9876-4563-123456789012",
+			Valid: true,
+		},
+
+		{
+			// valid at the Scope "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1"
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/roleDefinitions/12345678-1234-9876-4563-123456789012",
+			Valid: true,
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.AUTHORIZATION/ROLEDEFINITIONS/12345678-1234-9876-4563-123456789012",
+			Valid: false,
+		},
+	}
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := RoleDefinitionID(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ConnectionId struct {
@@ -40,35 +40,34 @@ func (id ConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AutomationAccountName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a Connection ID
+func (id ConnectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Automation"),
+		resourceid.StaticSegment("automationAccounts"),
+		resourceid.UserSpecifiedSegment("AutomationAccountName"),
+		resourceid.StaticSegment("connections"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // ConnectionID parses a Connection ID into an ConnectionId struct
 func ConnectionID(input string) (*ConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ConnectionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ConnectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.AutomationAccountName, err = id.PopSegment("automationAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("connections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:        id.Parsed["SubscriptionId"],
+		ResourceGroup:         id.Parsed["ResourceGroup"],
+		AutomationAccountName: id.Parsed["AutomationAccountName"],
+		Name:                  id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
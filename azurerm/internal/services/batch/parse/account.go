@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AccountId struct {
@@ -37,32 +37,31 @@ func (id AccountId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BatchAccountName)
 }
 
+// Segments returns the ordered list of Segments which make up a Account ID
+func (id AccountId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Batch"),
+		resourceid.StaticSegment("batchAccounts"),
+		resourceid.UserSpecifiedSegment("BatchAccountName"),
+	}
+}
+
 // AccountID parses a Account ID into an AccountId struct
 func AccountID(input string) (*AccountId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(AccountId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := AccountId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.BatchAccountName, err = id.PopSegment("batchAccounts"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   id.Parsed["SubscriptionId"],
+		ResourceGroup:    id.Parsed["ResourceGroup"],
+		BatchAccountName: id.Parsed["BatchAccountName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CertificateId struct {
@@ -40,35 +40,34 @@ func (id CertificateId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BatchAccountName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a Certificate ID
+func (id CertificateId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Batch"),
+		resourceid.StaticSegment("batchAccounts"),
+		resourceid.UserSpecifiedSegment("BatchAccountName"),
+		resourceid.StaticSegment("certificates"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // CertificateID parses a Certificate ID into an CertificateId struct
 func CertificateID(input string) (*CertificateId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(CertificateId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := CertificateId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.BatchAccountName, err = id.PopSegment("batchAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("certificates"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   id.Parsed["SubscriptionId"],
+		ResourceGroup:    id.Parsed["ResourceGroup"],
+		BatchAccountName: id.Parsed["BatchAccountName"],
+		Name:             id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
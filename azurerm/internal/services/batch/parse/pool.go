@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PoolId struct {
@@ -40,35 +40,34 @@ func (id PoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BatchAccountName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a Pool ID
+func (id PoolId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Batch"),
+		resourceid.StaticSegment("batchAccounts"),
+		resourceid.UserSpecifiedSegment("BatchAccountName"),
+		resourceid.StaticSegment("pools"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // PoolID parses a Pool ID into an PoolId struct
 func PoolID(input string) (*PoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(PoolId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := PoolId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.BatchAccountName, err = id.PopSegment("batchAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("pools"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   id.Parsed["SubscriptionId"],
+		ResourceGroup:    id.Parsed["ResourceGroup"],
+		BatchAccountName: id.Parsed["BatchAccountName"],
+		Name:             id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
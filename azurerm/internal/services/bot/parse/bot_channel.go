@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BotChannelId struct {
@@ -40,35 +40,34 @@ func (id BotChannelId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BotServiceName, id.ChannelName)
 }
 
+// Segments returns the ordered list of Segments which make up a BotChannel ID
+func (id BotChannelId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.BotService"),
+		resourceid.StaticSegment("botServices"),
+		resourceid.UserSpecifiedSegment("BotServiceName"),
+		resourceid.StaticSegment("channels"),
+		resourceid.UserSpecifiedSegment("ChannelName"),
+	}
+}
+
 // BotChannelID parses a BotChannel ID into an BotChannelId struct
 func BotChannelID(input string) (*BotChannelId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(BotChannelId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := BotChannelId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.BotServiceName, err = id.PopSegment("botServices"); err != nil {
-		return nil, err
-	}
-	if resourceId.ChannelName, err = id.PopSegment("channels"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		BotServiceName: id.Parsed["BotServiceName"],
+		ChannelName:    id.Parsed["ChannelName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BotConnectionId struct {
@@ -40,35 +40,34 @@ func (id BotConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BotServiceName, id.ConnectionName)
 }
 
+// Segments returns the ordered list of Segments which make up a BotConnection ID
+func (id BotConnectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.BotService"),
+		resourceid.StaticSegment("botServices"),
+		resourceid.UserSpecifiedSegment("BotServiceName"),
+		resourceid.StaticSegment("connections"),
+		resourceid.UserSpecifiedSegment("ConnectionName"),
+	}
+}
+
 // BotConnectionID parses a BotConnection ID into an BotConnectionId struct
 func BotConnectionID(input string) (*BotConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(BotConnectionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := BotConnectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.BotServiceName, err = id.PopSegment("botServices"); err != nil {
-		return nil, err
-	}
-	if resourceId.ConnectionName, err = id.PopSegment("connections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		BotServiceName: id.Parsed["BotServiceName"],
+		ConnectionName: id.Parsed["ConnectionName"],
 	}
 
 	return &resourceId, nil
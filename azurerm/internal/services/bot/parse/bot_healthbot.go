@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BotHealthbotId struct {
@@ -37,32 +37,31 @@ func (id BotHealthbotId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.HealthBotName)
 }
 
+// Segments returns the ordered list of Segments which make up a BotHealthbot ID
+func (id BotHealthbotId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.HealthBot"),
+		resourceid.StaticSegment("healthBots"),
+		resourceid.UserSpecifiedSegment("HealthBotName"),
+	}
+}
+
 // BotHealthbotID parses a BotHealthbot ID into an BotHealthbotId struct
 func BotHealthbotID(input string) (*BotHealthbotId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(BotHealthbotId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := BotHealthbotId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.HealthBotName, err = id.PopSegment("healthBots"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		HealthBotName:  id.Parsed["HealthBotName"],
 	}
 
 	return &resourceId, nil
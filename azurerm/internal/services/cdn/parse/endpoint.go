@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EndpointId struct {
@@ -40,35 +40,34 @@ func (id EndpointId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a Endpoint ID
+func (id EndpointId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Cdn"),
+		resourceid.StaticSegment("profiles"),
+		resourceid.UserSpecifiedSegment("ProfileName"),
+		resourceid.StaticSegment("endpoints"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // EndpointID parses a Endpoint ID into an EndpointId struct
 func EndpointID(input string) (*EndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(EndpointId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := EndpointId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ProfileName, err = id.PopSegment("profiles"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("endpoints"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		ProfileName:    id.Parsed["ProfileName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
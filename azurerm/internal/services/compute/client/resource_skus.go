@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+)
+
+// resourceSkusCache is a map of the Subscription ID and Location (see resourceSkusCacheKey) to the
+// Resource SKUs available within it, since these are retrieved both during Plan (to validate the
+// Availability Zones) and by the `azurerm_resource_skus` Data Source - and are unlikely to change
+// whilst the Provider is running.
+var resourceSkusCache = map[string][]compute.ResourceSku{}
+var resourceSkusLock = &sync.Mutex{}
+
+// resourceSkusLocationLocks ensures that the Resource SKUs within a Subscription and Location are only
+// retrieved once at a time, without blocking the retrieval of the Resource SKUs in other Locations
+var resourceSkusLocationLocks = map[string]*sync.Mutex{}
+var resourceSkusLocationLocksLock = &sync.Mutex{}
+
+// ResourceSkusForLocation returns the Resource SKUs available within the specified Location, which are
+// retrieved once per Location from the Resource SKUs API and then cached for the lifetime of the Provider.
+func (c *Client) ResourceSkusForLocation(ctx context.Context, location string) (*[]compute.ResourceSku, error) {
+	cacheKey := resourceSkusCacheKey(c.ResourceSkusClient.SubscriptionID, location)
+
+	// the Resource SKUs API is slow, so we hold the lock for this Location whilst retrieving these to avoid duplicate requests
+	locationLock := lockForResourceSkus(cacheKey)
+	locationLock.Lock()
+	defer locationLock.Unlock()
+
+	resourceSkusLock.Lock()
+	existing, ok := resourceSkusCache[cacheKey]
+	resourceSkusLock.Unlock()
+	if ok {
+		return &existing, nil
+	}
+
+	filter := fmt.Sprintf("location eq '%s'", location)
+	iterator, err := c.ResourceSkusClient.ListComplete(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("listing Resource SKUs in %q: %+v", location, err)
+	}
+
+	skus := make([]compute.ResourceSku, 0)
+	for iterator.NotDone() {
+		skus = append(skus, iterator.Value())
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Resource SKUs in %q: %+v", location, err)
+		}
+	}
+
+	resourceSkusLock.Lock()
+	resourceSkusCache[cacheKey] = skus
+	resourceSkusLock.Unlock()
+
+	return &skus, nil
+}
+
+func lockForResourceSkus(cacheKey string) *sync.Mutex {
+	resourceSkusLocationLocksLock.Lock()
+	defer resourceSkusLocationLocksLock.Unlock()
+
+	if resourceSkusLocationLocks[cacheKey] == nil {
+		resourceSkusLocationLocks[cacheKey] = &sync.Mutex{}
+	}
+
+	return resourceSkusLocationLocks[cacheKey]
+}
+
+func resourceSkusCacheKey(subscriptionId string, location string) string {
+	return strings.ToLower(fmt.Sprintf("%s/%s", subscriptionId, location))
+}
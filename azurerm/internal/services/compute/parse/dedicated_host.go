@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DedicatedHostId struct {
@@ -40,35 +40,34 @@ func (id DedicatedHostId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.HostGroupName, id.HostName)
 }
 
+// Segments returns the ordered list of Segments which make up a DedicatedHost ID
+func (id DedicatedHostId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Compute"),
+		resourceid.StaticSegment("hostGroups"),
+		resourceid.UserSpecifiedSegment("HostGroupName"),
+		resourceid.StaticSegment("hosts"),
+		resourceid.UserSpecifiedSegment("HostName"),
+	}
+}
+
 // DedicatedHostID parses a DedicatedHost ID into an DedicatedHostId struct
 func DedicatedHostID(input string) (*DedicatedHostId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(DedicatedHostId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DedicatedHostId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.HostGroupName, err = id.PopSegment("hostGroups"); err != nil {
-		return nil, err
-	}
-	if resourceId.HostName, err = id.PopSegment("hosts"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		HostGroupName:  id.Parsed["HostGroupName"],
+		HostName:       id.Parsed["HostName"],
 	}
 
 	return &resourceId, nil
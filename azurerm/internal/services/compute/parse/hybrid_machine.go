@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type HybridMachineId struct {
@@ -37,32 +37,31 @@ func (id HybridMachineId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.MachineName)
 }
 
+// Segments returns the ordered list of Segments which make up a HybridMachine ID
+func (id HybridMachineId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.HybridCompute"),
+		resourceid.StaticSegment("machines"),
+		resourceid.UserSpecifiedSegment("MachineName"),
+	}
+}
+
 // HybridMachineID parses a HybridMachine ID into an HybridMachineId struct
 func HybridMachineID(input string) (*HybridMachineId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(HybridMachineId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := HybridMachineId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.MachineName, err = id.PopSegment("machines"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		MachineName:    id.Parsed["MachineName"],
 	}
 
 	return &resourceId, nil
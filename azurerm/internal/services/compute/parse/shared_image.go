@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SharedImageId struct {
@@ -40,35 +40,34 @@ func (id SharedImageId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.ImageName)
 }
 
+// Segments returns the ordered list of Segments which make up a SharedImage ID
+func (id SharedImageId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Compute"),
+		resourceid.StaticSegment("galleries"),
+		resourceid.UserSpecifiedSegment("GalleryName"),
+		resourceid.StaticSegment("images"),
+		resourceid.UserSpecifiedSegment("ImageName"),
+	}
+}
+
 // SharedImageID parses a SharedImage ID into an SharedImageId struct
 func SharedImageID(input string) (*SharedImageId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(SharedImageId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SharedImageId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.GalleryName, err = id.PopSegment("galleries"); err != nil {
-		return nil, err
-	}
-	if resourceId.ImageName, err = id.PopSegment("images"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		GalleryName:    id.Parsed["GalleryName"],
+		ImageName:      id.Parsed["ImageName"],
 	}
 
 	return &resourceId, nil
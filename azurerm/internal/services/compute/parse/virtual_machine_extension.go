@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualMachineExtensionId struct {
@@ -40,35 +40,34 @@ func (id VirtualMachineExtensionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName, id.ExtensionName)
 }
 
+// Segments returns the ordered list of Segments which make up a VirtualMachineExtension ID
+func (id VirtualMachineExtensionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Compute"),
+		resourceid.StaticSegment("virtualMachines"),
+		resourceid.UserSpecifiedSegment("VirtualMachineName"),
+		resourceid.StaticSegment("extensions"),
+		resourceid.UserSpecifiedSegment("ExtensionName"),
+	}
+}
+
 // VirtualMachineExtensionID parses a VirtualMachineExtension ID into an VirtualMachineExtensionId struct
 func VirtualMachineExtensionID(input string) (*VirtualMachineExtensionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(VirtualMachineExtensionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VirtualMachineExtensionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VirtualMachineName, err = id.PopSegment("virtualMachines"); err != nil {
-		return nil, err
-	}
-	if resourceId.ExtensionName, err = id.PopSegment("extensions"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     id.Parsed["SubscriptionId"],
+		ResourceGroup:      id.Parsed["ResourceGroup"],
+		VirtualMachineName: id.Parsed["VirtualMachineName"],
+		ExtensionName:      id.Parsed["ExtensionName"],
 	}
 
 	return &resourceId, nil
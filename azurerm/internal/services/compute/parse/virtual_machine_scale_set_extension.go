@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualMachineScaleSetExtensionId struct {
@@ -40,35 +40,34 @@ func (id VirtualMachineScaleSetExtensionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, id.ExtensionName)
 }
 
+// Segments returns the ordered list of Segments which make up a VirtualMachineScaleSetExtension ID
+func (id VirtualMachineScaleSetExtensionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Compute"),
+		resourceid.StaticSegment("virtualMachineScaleSets"),
+		resourceid.UserSpecifiedSegment("VirtualMachineScaleSetName"),
+		resourceid.StaticSegment("extensions"),
+		resourceid.UserSpecifiedSegment("ExtensionName"),
+	}
+}
+
 // VirtualMachineScaleSetExtensionID parses a VirtualMachineScaleSetExtension ID into an VirtualMachineScaleSetExtensionId struct
 func VirtualMachineScaleSetExtensionID(input string) (*VirtualMachineScaleSetExtensionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(VirtualMachineScaleSetExtensionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VirtualMachineScaleSetExtensionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VirtualMachineScaleSetName, err = id.PopSegment("virtualMachineScaleSets"); err != nil {
-		return nil, err
-	}
-	if resourceId.ExtensionName, err = id.PopSegment("extensions"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:             id.Parsed["SubscriptionId"],
+		ResourceGroup:              id.Parsed["ResourceGroup"],
+		VirtualMachineScaleSetName: id.Parsed["VirtualMachineScaleSetName"],
+		ExtensionName:              id.Parsed["ExtensionName"],
 	}
 
 	return &resourceId, nil
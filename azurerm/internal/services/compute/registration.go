@@ -18,6 +18,15 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+// ResourceProviderNamespace returns the Resource Provider Namespace used by the specified Resource Type
+func (r Registration) ResourceProviderNamespace(resourceType string) string {
+	if resourceType == "azurerm_marketplace_agreement" {
+		return "Microsoft.MarketplaceOrdering"
+	}
+
+	return "Microsoft.Compute"
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
@@ -31,6 +40,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_disk_access":               dataSourceDiskAccess(),
 		"azurerm_platform_image":            dataSourcePlatformImage(),
 		"azurerm_proximity_placement_group": dataSourceProximityPlacementGroup(),
+		"azurerm_resource_skus":             dataSourceResourceSkus(),
 		"azurerm_shared_image_gallery":      dataSourceSharedImageGallery(),
 		"azurerm_shared_image_version":      dataSourceSharedImageVersion(),
 		"azurerm_shared_image_versions":     dataSourceSharedImageVersions(),
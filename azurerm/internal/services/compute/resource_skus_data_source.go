@@ -0,0 +1,248 @@
+package compute
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceResourceSkus() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceResourceSkusRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"location": location.SchemaWithoutForceNew(),
+
+			"resource_type": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_skus": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"resource_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"tier": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"size": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"family": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"capabilities": {
+							Type:     pluginsdk.TypeMap,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"zones": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"restricted": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"restrictions": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"type": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"reason_code": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"zones": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceResourceSkusRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loc := location.Normalize(d.Get("location").(string))
+	resourceType := d.Get("resource_type").(string)
+	name := d.Get("name").(string)
+
+	// the Resource SKUs API only supports filtering by Location, so the remaining filters are applied here
+	skus, err := client.ResourceSkusForLocation(ctx, loc)
+	if err != nil {
+		return fmt.Errorf("retrieving Resource SKUs (Location %q): %+v", loc, err)
+	}
+
+	results := make([]interface{}, 0)
+	for _, sku := range *skus {
+		if sku.ResourceType == nil || sku.Name == nil {
+			continue
+		}
+		if resourceType != "" && !strings.EqualFold(*sku.ResourceType, resourceType) {
+			continue
+		}
+		if name != "" && !strings.EqualFold(*sku.Name, name) {
+			continue
+		}
+
+		results = append(results, flattenResourceSku(sku, loc))
+	}
+
+	// the ID includes the filters, so that multiple instances of this Data Source are distinguishable
+	id := fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Compute/locations/%s/skus", subscriptionId, loc)
+	if resourceType != "" {
+		id = fmt.Sprintf("%s/resourceTypes/%s", id, resourceType)
+	}
+	if name != "" {
+		id = fmt.Sprintf("%s/names/%s", id, name)
+	}
+	d.SetId(id)
+
+	d.Set("location", loc)
+
+	if err := d.Set("resource_skus", results); err != nil {
+		return fmt.Errorf("setting `resource_skus`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenResourceSku(input compute.ResourceSku, loc string) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	output["name"] = *input.Name
+	output["resource_type"] = *input.ResourceType
+
+	tier := ""
+	if input.Tier != nil {
+		tier = *input.Tier
+	}
+	output["tier"] = tier
+
+	size := ""
+	if input.Size != nil {
+		size = *input.Size
+	}
+	output["size"] = size
+
+	family := ""
+	if input.Family != nil {
+		family = *input.Family
+	}
+	output["family"] = family
+
+	capabilities := make(map[string]interface{})
+	if input.Capabilities != nil {
+		for _, capability := range *input.Capabilities {
+			if capability.Name == nil || capability.Value == nil {
+				continue
+			}
+
+			capabilities[*capability.Name] = *capability.Value
+		}
+	}
+	output["capabilities"] = capabilities
+
+	zones := make([]string, 0)
+	if input.LocationInfo != nil {
+		for _, info := range *input.LocationInfo {
+			if info.Location == nil || location.Normalize(*info.Location) != loc || info.Zones == nil {
+				continue
+			}
+
+			zones = append(zones, *info.Zones...)
+		}
+	}
+	sort.Strings(zones)
+	output["zones"] = zones
+
+	restricted := false
+	restrictions := make([]interface{}, 0)
+	if input.Restrictions != nil {
+		for _, restriction := range *input.Restrictions {
+			restrictedZones := make([]string, 0)
+			if restriction.RestrictionInfo != nil && restriction.RestrictionInfo.Zones != nil {
+				restrictedZones = append(restrictedZones, *restriction.RestrictionInfo.Zones...)
+			}
+			sort.Strings(restrictedZones)
+
+			// a Location restriction means this SKU can't be used in this Location for this Subscription
+			if restriction.Type == compute.Location {
+				restricted = true
+			}
+
+			restrictions = append(restrictions, map[string]interface{}{
+				"type":        string(restriction.Type),
+				"reason_code": string(restriction.ReasonCode),
+				"zones":       restrictedZones,
+			})
+		}
+	}
+	output["restricted"] = restricted
+	output["restrictions"] = restrictions
+
+	return output
+}
@@ -0,0 +1,88 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+)
+
+type ResourceSkusDataSource struct {
+}
+
+func TestAccDataSourceResourceSkus_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_skus", "test")
+	r := ResourceSkusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resource_skus.#").Exists(),
+				check.That(data.ResourceName).Key("resource_skus.0.resource_type").HasValue("virtualMachines"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceResourceSkus_name(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_skus", "test")
+	r := ResourceSkusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.name(data, "Standard_F2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").HasValue(fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Compute/locations/%s/skus/resourceTypes/virtualMachines/names/Standard_F2", data.Client().SubscriptionID, location.Normalize(data.Locations.Primary))),
+				check.That(data.ResourceName).Key("resource_skus.#").HasValue("1"),
+				check.That(data.ResourceName).Key("resource_skus.0.name").HasValue("Standard_F2"),
+				check.That(data.ResourceName).Key("resource_skus.0.family").HasValue("standardFFamily"),
+				check.That(data.ResourceName).Key("resource_skus.0.capabilities.vCPUs").HasValue("2"),
+				check.That(data.ResourceName).Key("resource_skus.0.restricted").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceResourceSkus_noMatches(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_skus", "test")
+	r := ResourceSkusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.name(data, "Standard_DoesNotExist"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resource_skus.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (ResourceSkusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_resource_skus" "test" {
+  location      = "%s"
+  resource_type = "virtualMachines"
+}
+`, data.Locations.Primary)
+}
+
+func (ResourceSkusDataSource) name(data acceptance.TestData, name string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_resource_skus" "test" {
+  location      = "%s"
+  resource_type = "virtualMachines"
+  name          = "%s"
+}
+`, data.Locations.Primary, name)
+}
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ConsumptionBudgetResourceGroupId struct {
@@ -37,32 +37,31 @@ func (id ConsumptionBudgetResourceGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BudgetName)
 }
 
+// Segments returns the ordered list of Segments which make up a ConsumptionBudgetResourceGroup ID
+func (id ConsumptionBudgetResourceGroupId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Consumption"),
+		resourceid.StaticSegment("budgets"),
+		resourceid.UserSpecifiedSegment("BudgetName"),
+	}
+}
+
 // ConsumptionBudgetResourceGroupID parses a ConsumptionBudgetResourceGroup ID into an ConsumptionBudgetResourceGroupId struct
 func ConsumptionBudgetResourceGroupID(input string) (*ConsumptionBudgetResourceGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ConsumptionBudgetResourceGroupId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ConsumptionBudgetResourceGroupId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.BudgetName, err = id.PopSegment("budgets"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		BudgetName:     id.Parsed["BudgetName"],
 	}
 
 	return &resourceId, nil
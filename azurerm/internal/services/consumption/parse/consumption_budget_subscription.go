@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ConsumptionBudgetSubscriptionId struct {
@@ -34,27 +34,28 @@ func (id ConsumptionBudgetSubscriptionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.BudgetName)
 }
 
+// Segments returns the ordered list of Segments which make up a ConsumptionBudgetSubscription ID
+func (id ConsumptionBudgetSubscriptionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Consumption"),
+		resourceid.StaticSegment("budgets"),
+		resourceid.UserSpecifiedSegment("BudgetName"),
+	}
+}
+
 // ConsumptionBudgetSubscriptionID parses a ConsumptionBudgetSubscription ID into an ConsumptionBudgetSubscriptionId struct
 func ConsumptionBudgetSubscriptionID(input string) (*ConsumptionBudgetSubscriptionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ConsumptionBudgetSubscriptionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ConsumptionBudgetSubscriptionId{
-		SubscriptionId: id.SubscriptionID,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.BudgetName, err = id.PopSegment("budgets"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		BudgetName:     id.Parsed["BudgetName"],
 	}
 
 	return &resourceId, nil
@@ -1,6 +1,7 @@
 package containers
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
 	computeValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers/parse"
 	containerValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers/validate"
@@ -32,6 +34,8 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(location.ValidateAvailabilityZonesDiffWithLocationFunc("kubernetes_cluster_id", kubernetesClusterNodePoolLocation, "availability_zones", "virtualMachines", "vm_size")),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -256,6 +260,32 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 	}
 }
 
+// kubernetesClusterNodePoolLocation returns the Location of the Kubernetes Cluster which the Node Pool is
+// within, since the Node Pool doesn't define a Location - which is used to validate the Availability Zones
+func kubernetesClusterNodePoolLocation(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) (*string, error) {
+	if !d.NewValueKnown("kubernetes_cluster_id") {
+		return nil, nil
+	}
+
+	id, err := parse.ClusterID(d.Get("kubernetes_cluster_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	client := meta.(*clients.Client).Containers.KubernetesClustersClient
+	cluster, err := client.Get(ctx, id.ResourceGroup, id.ManagedClusterName)
+	if err != nil {
+		// the Kubernetes Cluster is checked when the Node Pool is created
+		if utils.ResponseWasNotFound(cluster.Response) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return cluster.Location, nil
+}
+
 func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	containersClient := meta.(*clients.Client).Containers
 	clustersClient := containersClient.KubernetesClustersClient
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ContainerRegistryScopeMapId struct {
@@ -40,35 +40,34 @@ func (id ContainerRegistryScopeMapId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.ScopeMapName)
 }
 
+// Segments returns the ordered list of Segments which make up a ContainerRegistryScopeMap ID
+func (id ContainerRegistryScopeMapId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.ContainerRegistry"),
+		resourceid.StaticSegment("registries"),
+		resourceid.UserSpecifiedSegment("RegistryName"),
+		resourceid.StaticSegment("scopeMaps"),
+		resourceid.UserSpecifiedSegment("ScopeMapName"),
+	}
+}
+
 // ContainerRegistryScopeMapID parses a ContainerRegistryScopeMap ID into an ContainerRegistryScopeMapId struct
 func ContainerRegistryScopeMapID(input string) (*ContainerRegistryScopeMapId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ContainerRegistryScopeMapId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ContainerRegistryScopeMapId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.RegistryName, err = id.PopSegment("registries"); err != nil {
-		return nil, err
-	}
-	if resourceId.ScopeMapName, err = id.PopSegment("scopeMaps"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		RegistryName:   id.Parsed["RegistryName"],
+		ScopeMapName:   id.Parsed["ScopeMapName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ContainerRegistryTokenId struct {
@@ -40,35 +40,34 @@ func (id ContainerRegistryTokenId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.TokenName)
 }
 
+// Segments returns the ordered list of Segments which make up a ContainerRegistryToken ID
+func (id ContainerRegistryTokenId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.ContainerRegistry"),
+		resourceid.StaticSegment("registries"),
+		resourceid.UserSpecifiedSegment("RegistryName"),
+		resourceid.StaticSegment("tokens"),
+		resourceid.UserSpecifiedSegment("TokenName"),
+	}
+}
+
 // ContainerRegistryTokenID parses a ContainerRegistryToken ID into an ContainerRegistryTokenId struct
 func ContainerRegistryTokenID(input string) (*ContainerRegistryTokenId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ContainerRegistryTokenId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ContainerRegistryTokenId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.RegistryName, err = id.PopSegment("registries"); err != nil {
-		return nil, err
-	}
-	if resourceId.TokenName, err = id.PopSegment("tokens"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		RegistryName:   id.Parsed["RegistryName"],
+		TokenName:      id.Parsed["TokenName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NodePoolId struct {
@@ -40,35 +40,34 @@ func (id NodePoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName)
 }
 
+// Segments returns the ordered list of Segments which make up a NodePool ID
+func (id NodePoolId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.ContainerService"),
+		resourceid.StaticSegment("managedClusters"),
+		resourceid.UserSpecifiedSegment("ManagedClusterName"),
+		resourceid.StaticSegment("agentPools"),
+		resourceid.UserSpecifiedSegment("AgentPoolName"),
+	}
+}
+
 // NodePoolID parses a NodePool ID into an NodePoolId struct
 func NodePoolID(input string) (*NodePoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(NodePoolId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := NodePoolId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ManagedClusterName, err = id.PopSegment("managedClusters"); err != nil {
-		return nil, err
-	}
-	if resourceId.AgentPoolName, err = id.PopSegment("agentPools"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     id.Parsed["SubscriptionId"],
+		ResourceGroup:      id.Parsed["ResourceGroup"],
+		ManagedClusterName: id.Parsed["ManagedClusterName"],
+		AgentPoolName:      id.Parsed["AgentPoolName"],
 	}
 
 	return &resourceId, nil
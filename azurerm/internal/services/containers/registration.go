@@ -1,6 +1,8 @@
 package containers
 
 import (
+	"strings"
+
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 )
 
@@ -18,6 +20,20 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+// ResourceProviderNamespace returns the Resource Provider Namespace used by the specified Resource Type
+func (r Registration) ResourceProviderNamespace(resourceType string) string {
+	switch {
+	case strings.HasPrefix(resourceType, "azurerm_container_group"):
+		return "Microsoft.ContainerInstance"
+	case strings.HasPrefix(resourceType, "azurerm_container_registry"):
+		return "Microsoft.ContainerRegistry"
+	case strings.HasPrefix(resourceType, "azurerm_kubernetes_"):
+		return "Microsoft.ContainerService"
+	}
+
+	return ""
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
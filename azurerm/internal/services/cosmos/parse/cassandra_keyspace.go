@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CassandraKeyspaceId struct {
@@ -40,35 +40,34 @@ func (id CassandraKeyspaceId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a CassandraKeyspace ID
+func (id CassandraKeyspaceId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DocumentDB"),
+		resourceid.StaticSegment("databaseAccounts"),
+		resourceid.UserSpecifiedSegment("DatabaseAccountName"),
+		resourceid.StaticSegment("cassandraKeyspaces"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // CassandraKeyspaceID parses a CassandraKeyspace ID into an CassandraKeyspaceId struct
 func CassandraKeyspaceID(input string) (*CassandraKeyspaceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(CassandraKeyspaceId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := CassandraKeyspaceId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("cassandraKeyspaces"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      id.Parsed["SubscriptionId"],
+		ResourceGroup:       id.Parsed["ResourceGroup"],
+		DatabaseAccountName: id.Parsed["DatabaseAccountName"],
+		Name:                id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
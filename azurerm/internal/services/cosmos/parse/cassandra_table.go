@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CassandraTableId struct {
@@ -43,38 +43,37 @@ func (id CassandraTableId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.CassandraKeyspaceName, id.TableName)
 }
 
+// Segments returns the ordered list of Segments which make up a CassandraTable ID
+func (id CassandraTableId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DocumentDB"),
+		resourceid.StaticSegment("databaseAccounts"),
+		resourceid.UserSpecifiedSegment("DatabaseAccountName"),
+		resourceid.StaticSegment("cassandraKeyspaces"),
+		resourceid.UserSpecifiedSegment("CassandraKeyspaceName"),
+		resourceid.StaticSegment("tables"),
+		resourceid.UserSpecifiedSegment("TableName"),
+	}
+}
+
 // CassandraTableID parses a CassandraTable ID into an CassandraTableId struct
 func CassandraTableID(input string) (*CassandraTableId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(CassandraTableId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := CassandraTableId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.CassandraKeyspaceName, err = id.PopSegment("cassandraKeyspaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.TableName, err = id.PopSegment("tables"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:        id.Parsed["SubscriptionId"],
+		ResourceGroup:         id.Parsed["ResourceGroup"],
+		DatabaseAccountName:   id.Parsed["DatabaseAccountName"],
+		CassandraKeyspaceName: id.Parsed["CassandraKeyspaceName"],
+		TableName:             id.Parsed["TableName"],
 	}
 
 	return &resourceId, nil
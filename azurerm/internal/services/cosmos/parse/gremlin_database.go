@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type GremlinDatabaseId struct {
@@ -40,35 +40,34 @@ func (id GremlinDatabaseId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a GremlinDatabase ID
+func (id GremlinDatabaseId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DocumentDB"),
+		resourceid.StaticSegment("databaseAccounts"),
+		resourceid.UserSpecifiedSegment("DatabaseAccountName"),
+		resourceid.StaticSegment("gremlinDatabases"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // GremlinDatabaseID parses a GremlinDatabase ID into an GremlinDatabaseId struct
 func GremlinDatabaseID(input string) (*GremlinDatabaseId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(GremlinDatabaseId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := GremlinDatabaseId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("gremlinDatabases"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      id.Parsed["SubscriptionId"],
+		ResourceGroup:       id.Parsed["ResourceGroup"],
+		DatabaseAccountName: id.Parsed["DatabaseAccountName"],
+		Name:                id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type GremlinGraphId struct {
@@ -43,38 +43,37 @@ func (id GremlinGraphId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.GremlinDatabaseName, id.GraphName)
 }
 
+// Segments returns the ordered list of Segments which make up a GremlinGraph ID
+func (id GremlinGraphId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DocumentDB"),
+		resourceid.StaticSegment("databaseAccounts"),
+		resourceid.UserSpecifiedSegment("DatabaseAccountName"),
+		resourceid.StaticSegment("gremlinDatabases"),
+		resourceid.UserSpecifiedSegment("GremlinDatabaseName"),
+		resourceid.StaticSegment("graphs"),
+		resourceid.UserSpecifiedSegment("GraphName"),
+	}
+}
+
 // GremlinGraphID parses a GremlinGraph ID into an GremlinGraphId struct
 func GremlinGraphID(input string) (*GremlinGraphId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(GremlinGraphId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := GremlinGraphId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.GremlinDatabaseName, err = id.PopSegment("gremlinDatabases"); err != nil {
-		return nil, err
-	}
-	if resourceId.GraphName, err = id.PopSegment("graphs"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      id.Parsed["SubscriptionId"],
+		ResourceGroup:       id.Parsed["ResourceGroup"],
+		DatabaseAccountName: id.Parsed["DatabaseAccountName"],
+		GremlinDatabaseName: id.Parsed["GremlinDatabaseName"],
+		GraphName:           id.Parsed["GraphName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type MongodbCollectionId struct {
@@ -43,38 +43,37 @@ func (id MongodbCollectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.MongodbDatabaseName, id.CollectionName)
 }
 
+// Segments returns the ordered list of Segments which make up a MongodbCollection ID
+func (id MongodbCollectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DocumentDB"),
+		resourceid.StaticSegment("databaseAccounts"),
+		resourceid.UserSpecifiedSegment("DatabaseAccountName"),
+		resourceid.StaticSegment("mongodbDatabases"),
+		resourceid.UserSpecifiedSegment("MongodbDatabaseName"),
+		resourceid.StaticSegment("collections"),
+		resourceid.UserSpecifiedSegment("CollectionName"),
+	}
+}
+
 // MongodbCollectionID parses a MongodbCollection ID into an MongodbCollectionId struct
 func MongodbCollectionID(input string) (*MongodbCollectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(MongodbCollectionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := MongodbCollectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.MongodbDatabaseName, err = id.PopSegment("mongodbDatabases"); err != nil {
-		return nil, err
-	}
-	if resourceId.CollectionName, err = id.PopSegment("collections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      id.Parsed["SubscriptionId"],
+		ResourceGroup:       id.Parsed["ResourceGroup"],
+		DatabaseAccountName: id.Parsed["DatabaseAccountName"],
+		MongodbDatabaseName: id.Parsed["MongodbDatabaseName"],
+		CollectionName:      id.Parsed["CollectionName"],
 	}
 
 	return &resourceId, nil
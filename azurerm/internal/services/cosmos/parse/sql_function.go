@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SqlFunctionId struct {
@@ -46,41 +46,40 @@ func (id SqlFunctionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, id.UserDefinedFunctionName)
 }
 
+// Segments returns the ordered list of Segments which make up a SqlFunction ID
+func (id SqlFunctionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DocumentDB"),
+		resourceid.StaticSegment("databaseAccounts"),
+		resourceid.UserSpecifiedSegment("DatabaseAccountName"),
+		resourceid.StaticSegment("sqlDatabases"),
+		resourceid.UserSpecifiedSegment("SqlDatabaseName"),
+		resourceid.StaticSegment("containers"),
+		resourceid.UserSpecifiedSegment("ContainerName"),
+		resourceid.StaticSegment("userDefinedFunctions"),
+		resourceid.UserSpecifiedSegment("UserDefinedFunctionName"),
+	}
+}
+
 // SqlFunctionID parses a SqlFunction ID into an SqlFunctionId struct
 func SqlFunctionID(input string) (*SqlFunctionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(SqlFunctionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SqlFunctionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.SqlDatabaseName, err = id.PopSegment("sqlDatabases"); err != nil {
-		return nil, err
-	}
-	if resourceId.ContainerName, err = id.PopSegment("containers"); err != nil {
-		return nil, err
-	}
-	if resourceId.UserDefinedFunctionName, err = id.PopSegment("userDefinedFunctions"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:          id.Parsed["SubscriptionId"],
+		ResourceGroup:           id.Parsed["ResourceGroup"],
+		DatabaseAccountName:     id.Parsed["DatabaseAccountName"],
+		SqlDatabaseName:         id.Parsed["SqlDatabaseName"],
+		ContainerName:           id.Parsed["ContainerName"],
+		UserDefinedFunctionName: id.Parsed["UserDefinedFunctionName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SqlStoredProcedureId struct {
@@ -46,41 +46,40 @@ func (id SqlStoredProcedureId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, id.StoredProcedureName)
 }
 
+// Segments returns the ordered list of Segments which make up a SqlStoredProcedure ID
+func (id SqlStoredProcedureId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DocumentDB"),
+		resourceid.StaticSegment("databaseAccounts"),
+		resourceid.UserSpecifiedSegment("DatabaseAccountName"),
+		resourceid.StaticSegment("sqlDatabases"),
+		resourceid.UserSpecifiedSegment("SqlDatabaseName"),
+		resourceid.StaticSegment("containers"),
+		resourceid.UserSpecifiedSegment("ContainerName"),
+		resourceid.StaticSegment("storedProcedures"),
+		resourceid.UserSpecifiedSegment("StoredProcedureName"),
+	}
+}
+
 // SqlStoredProcedureID parses a SqlStoredProcedure ID into an SqlStoredProcedureId struct
 func SqlStoredProcedureID(input string) (*SqlStoredProcedureId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(SqlStoredProcedureId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SqlStoredProcedureId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.SqlDatabaseName, err = id.PopSegment("sqlDatabases"); err != nil {
-		return nil, err
-	}
-	if resourceId.ContainerName, err = id.PopSegment("containers"); err != nil {
-		return nil, err
-	}
-	if resourceId.StoredProcedureName, err = id.PopSegment("storedProcedures"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      id.Parsed["SubscriptionId"],
+		ResourceGroup:       id.Parsed["ResourceGroup"],
+		DatabaseAccountName: id.Parsed["DatabaseAccountName"],
+		SqlDatabaseName:     id.Parsed["SqlDatabaseName"],
+		ContainerName:       id.Parsed["ContainerName"],
+		StoredProcedureName: id.Parsed["StoredProcedureName"],
 	}
 
 	return &resourceId, nil
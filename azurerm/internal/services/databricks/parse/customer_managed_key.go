@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CustomerManagedKeyId struct {
@@ -37,32 +37,31 @@ func (id CustomerManagedKeyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.CustomerMangagedKeyName)
 }
 
+// Segments returns the ordered list of Segments which make up a CustomerManagedKey ID
+func (id CustomerManagedKeyId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Databricks"),
+		resourceid.StaticSegment("customerMangagedKey"),
+		resourceid.UserSpecifiedSegment("CustomerMangagedKeyName"),
+	}
+}
+
 // CustomerManagedKeyID parses a CustomerManagedKey ID into an CustomerManagedKeyId struct
 func CustomerManagedKeyID(input string) (*CustomerManagedKeyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(CustomerManagedKeyId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := CustomerManagedKeyId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.CustomerMangagedKeyName, err = id.PopSegment("customerMangagedKey"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:          id.Parsed["SubscriptionId"],
+		ResourceGroup:           id.Parsed["ResourceGroup"],
+		CustomerMangagedKeyName: id.Parsed["CustomerMangagedKeyName"],
 	}
 
 	return &resourceId, nil
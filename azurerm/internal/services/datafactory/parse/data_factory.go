@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DataFactoryId struct {
@@ -37,32 +37,31 @@ func (id DataFactoryId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FactoryName)
 }
 
+// Segments returns the ordered list of Segments which make up a DataFactory ID
+func (id DataFactoryId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DataFactory"),
+		resourceid.StaticSegment("factories"),
+		resourceid.UserSpecifiedSegment("FactoryName"),
+	}
+}
+
 // DataFactoryID parses a DataFactory ID into an DataFactoryId struct
 func DataFactoryID(input string) (*DataFactoryId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(DataFactoryId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DataFactoryId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.FactoryName, err = id.PopSegment("factories"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		FactoryName:    id.Parsed["FactoryName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type IntegrationRuntimeId struct {
@@ -40,35 +40,34 @@ func (id IntegrationRuntimeId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FactoryName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a IntegrationRuntime ID
+func (id IntegrationRuntimeId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DataFactory"),
+		resourceid.StaticSegment("factories"),
+		resourceid.UserSpecifiedSegment("FactoryName"),
+		resourceid.StaticSegment("integrationruntimes"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // IntegrationRuntimeID parses a IntegrationRuntime ID into an IntegrationRuntimeId struct
 func IntegrationRuntimeID(input string) (*IntegrationRuntimeId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(IntegrationRuntimeId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := IntegrationRuntimeId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.FactoryName, err = id.PopSegment("factories"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("integrationruntimes"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		FactoryName:    id.Parsed["FactoryName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LinkedServiceId struct {
@@ -40,35 +40,34 @@ func (id LinkedServiceId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FactoryName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a LinkedService ID
+func (id LinkedServiceId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DataFactory"),
+		resourceid.StaticSegment("factories"),
+		resourceid.UserSpecifiedSegment("FactoryName"),
+		resourceid.StaticSegment("linkedservices"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // LinkedServiceID parses a LinkedService ID into an LinkedServiceId struct
 func LinkedServiceID(input string) (*LinkedServiceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(LinkedServiceId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LinkedServiceId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.FactoryName, err = id.PopSegment("factories"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("linkedservices"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		FactoryName:    id.Parsed["FactoryName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
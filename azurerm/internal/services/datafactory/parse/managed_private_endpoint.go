@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ManagedPrivateEndpointId struct {
@@ -43,38 +43,37 @@ func (id ManagedPrivateEndpointId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FactoryName, id.ManagedVirtualNetworkName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a ManagedPrivateEndpoint ID
+func (id ManagedPrivateEndpointId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DataFactory"),
+		resourceid.StaticSegment("factories"),
+		resourceid.UserSpecifiedSegment("FactoryName"),
+		resourceid.StaticSegment("managedVirtualNetworks"),
+		resourceid.UserSpecifiedSegment("ManagedVirtualNetworkName"),
+		resourceid.StaticSegment("managedPrivateEndpoints"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // ManagedPrivateEndpointID parses a ManagedPrivateEndpoint ID into an ManagedPrivateEndpointId struct
 func ManagedPrivateEndpointID(input string) (*ManagedPrivateEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ManagedPrivateEndpointId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ManagedPrivateEndpointId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.FactoryName, err = id.PopSegment("factories"); err != nil {
-		return nil, err
-	}
-	if resourceId.ManagedVirtualNetworkName, err = id.PopSegment("managedVirtualNetworks"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("managedPrivateEndpoints"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:            id.Parsed["SubscriptionId"],
+		ResourceGroup:             id.Parsed["ResourceGroup"],
+		FactoryName:               id.Parsed["FactoryName"],
+		ManagedVirtualNetworkName: id.Parsed["ManagedVirtualNetworkName"],
+		Name:                      id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualNetworkRuleId struct {
@@ -40,35 +40,34 @@ func (id VirtualNetworkRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AccountName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a VirtualNetworkRule ID
+func (id VirtualNetworkRuleId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DataLakeStore"),
+		resourceid.StaticSegment("accounts"),
+		resourceid.UserSpecifiedSegment("AccountName"),
+		resourceid.StaticSegment("virtualNetworkRules"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // VirtualNetworkRuleID parses a VirtualNetworkRule ID into an VirtualNetworkRuleId struct
 func VirtualNetworkRuleID(input string) (*VirtualNetworkRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(VirtualNetworkRuleId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VirtualNetworkRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.AccountName, err = id.PopSegment("accounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("virtualNetworkRules"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		AccountName:    id.Parsed["AccountName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
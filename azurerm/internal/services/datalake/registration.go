@@ -1,6 +1,8 @@
 package datalake
 
 import (
+	"strings"
+
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 )
 
@@ -18,6 +20,15 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+// ResourceProviderNamespace returns the Resource Provider Namespace used by the specified Resource Type
+func (r Registration) ResourceProviderNamespace(resourceType string) string {
+	if strings.HasPrefix(resourceType, "azurerm_data_lake_analytics_") {
+		return "Microsoft.DataLakeAnalytics"
+	}
+
+	return "Microsoft.DataLakeStore"
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
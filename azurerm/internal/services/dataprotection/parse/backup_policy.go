@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BackupPolicyId struct {
@@ -40,35 +40,34 @@ func (id BackupPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BackupVaultName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a BackupPolicy ID
+func (id BackupPolicyId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DataProtection"),
+		resourceid.StaticSegment("backupVaults"),
+		resourceid.UserSpecifiedSegment("BackupVaultName"),
+		resourceid.StaticSegment("backupPolicies"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // BackupPolicyID parses a BackupPolicy ID into an BackupPolicyId struct
 func BackupPolicyID(input string) (*BackupPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(BackupPolicyId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := BackupPolicyId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.BackupVaultName, err = id.PopSegment("backupVaults"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("backupPolicies"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:  id.Parsed["SubscriptionId"],
+		ResourceGroup:   id.Parsed["ResourceGroup"],
+		BackupVaultName: id.Parsed["BackupVaultName"],
+		Name:            id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DataSetId struct {
@@ -43,38 +43,37 @@ func (id DataSetId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AccountName, id.ShareName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a DataSet ID
+func (id DataSetId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DataShare"),
+		resourceid.StaticSegment("accounts"),
+		resourceid.UserSpecifiedSegment("AccountName"),
+		resourceid.StaticSegment("shares"),
+		resourceid.UserSpecifiedSegment("ShareName"),
+		resourceid.StaticSegment("dataSets"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // DataSetID parses a DataSet ID into an DataSetId struct
 func DataSetID(input string) (*DataSetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(DataSetId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DataSetId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.AccountName, err = id.PopSegment("accounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.ShareName, err = id.PopSegment("shares"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("dataSets"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		AccountName:    id.Parsed["AccountName"],
+		ShareName:      id.Parsed["ShareName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
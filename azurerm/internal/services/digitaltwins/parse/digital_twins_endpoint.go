@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DigitalTwinsEndpointId struct {
@@ -40,35 +40,34 @@ func (id DigitalTwinsEndpointId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DigitalTwinsInstanceName, id.EndpointName)
 }
 
+// Segments returns the ordered list of Segments which make up a DigitalTwinsEndpoint ID
+func (id DigitalTwinsEndpointId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DigitalTwins"),
+		resourceid.StaticSegment("digitalTwinsInstances"),
+		resourceid.UserSpecifiedSegment("DigitalTwinsInstanceName"),
+		resourceid.StaticSegment("endpoints"),
+		resourceid.UserSpecifiedSegment("EndpointName"),
+	}
+}
+
 // DigitalTwinsEndpointID parses a DigitalTwinsEndpoint ID into an DigitalTwinsEndpointId struct
 func DigitalTwinsEndpointID(input string) (*DigitalTwinsEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(DigitalTwinsEndpointId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DigitalTwinsEndpointId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DigitalTwinsInstanceName, err = id.PopSegment("digitalTwinsInstances"); err != nil {
-		return nil, err
-	}
-	if resourceId.EndpointName, err = id.PopSegment("endpoints"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:           id.Parsed["SubscriptionId"],
+		ResourceGroup:            id.Parsed["ResourceGroup"],
+		DigitalTwinsInstanceName: id.Parsed["DigitalTwinsInstanceName"],
+		EndpointName:             id.Parsed["EndpointName"],
 	}
 
 	return &resourceId, nil
@@ -308,7 +308,7 @@ func flattenArmDNSZoneSOARecord(input *dns.RecordSet) []interface{} {
 
 	metaData := make(map[string]interface{})
 	if input.Metadata != nil {
-		metaData = tags.Flatten(input.Metadata)
+		metaData = utils.FlattenMapStringPtrString(input.Metadata)
 	}
 
 	fqdn := ""
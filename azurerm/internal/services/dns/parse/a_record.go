@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ARecordId struct {
@@ -40,35 +40,34 @@ func (id ARecordId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.AName)
 }
 
+// Segments returns the ordered list of Segments which make up a ARecord ID
+func (id ARecordId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("dnszones"),
+		resourceid.UserSpecifiedSegment("DnszoneName"),
+		resourceid.StaticSegment("A"),
+		resourceid.UserSpecifiedSegment("AName"),
+	}
+}
+
 // ARecordID parses a ARecord ID into an ARecordId struct
 func ARecordID(input string) (*ARecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ARecordId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ARecordId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
-		return nil, err
-	}
-	if resourceId.AName, err = id.PopSegment("A"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		DnszoneName:    id.Parsed["DnszoneName"],
+		AName:          id.Parsed["AName"],
 	}
 
 	return &resourceId, nil
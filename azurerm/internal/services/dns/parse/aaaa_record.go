@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AaaaRecordId struct {
@@ -40,35 +40,34 @@ func (id AaaaRecordId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.AAAAName)
 }
 
+// Segments returns the ordered list of Segments which make up a AaaaRecord ID
+func (id AaaaRecordId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("dnszones"),
+		resourceid.UserSpecifiedSegment("DnszoneName"),
+		resourceid.StaticSegment("AAAA"),
+		resourceid.UserSpecifiedSegment("AAAAName"),
+	}
+}
+
 // AaaaRecordID parses a AaaaRecord ID into an AaaaRecordId struct
 func AaaaRecordID(input string) (*AaaaRecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(AaaaRecordId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := AaaaRecordId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
-		return nil, err
-	}
-	if resourceId.AAAAName, err = id.PopSegment("AAAA"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		DnszoneName:    id.Parsed["DnszoneName"],
+		AAAAName:       id.Parsed["AAAAName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DomainTopicId struct {
@@ -40,35 +40,34 @@ func (id DomainTopicId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DomainName, id.TopicName)
 }
 
+// Segments returns the ordered list of Segments which make up a DomainTopic ID
+func (id DomainTopicId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.EventGrid"),
+		resourceid.StaticSegment("domains"),
+		resourceid.UserSpecifiedSegment("DomainName"),
+		resourceid.StaticSegment("topics"),
+		resourceid.UserSpecifiedSegment("TopicName"),
+	}
+}
+
 // DomainTopicID parses a DomainTopic ID into an DomainTopicId struct
 func DomainTopicID(input string) (*DomainTopicId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(DomainTopicId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DomainTopicId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DomainName, err = id.PopSegment("domains"); err != nil {
-		return nil, err
-	}
-	if resourceId.TopicName, err = id.PopSegment("topics"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		DomainName:     id.Parsed["DomainName"],
+		TopicName:      id.Parsed["TopicName"],
 	}
 
 	return &resourceId, nil
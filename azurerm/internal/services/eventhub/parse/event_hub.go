@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EventHubId struct {
@@ -40,35 +40,34 @@ func (id EventHubId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a EventHub ID
+func (id EventHubId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.EventHub"),
+		resourceid.StaticSegment("namespaces"),
+		resourceid.UserSpecifiedSegment("NamespaceName"),
+		resourceid.StaticSegment("eventhubs"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // EventHubID parses a EventHub ID into an EventHubId struct
 func EventHubID(input string) (*EventHubId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(EventHubId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := EventHubId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("eventhubs"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		NamespaceName:  id.Parsed["NamespaceName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EventHubConsumerGroupId struct {
@@ -43,38 +43,37 @@ func (id EventHubConsumerGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.EventhubName, id.ConsumergroupName)
 }
 
+// Segments returns the ordered list of Segments which make up a EventHubConsumerGroup ID
+func (id EventHubConsumerGroupId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.EventHub"),
+		resourceid.StaticSegment("namespaces"),
+		resourceid.UserSpecifiedSegment("NamespaceName"),
+		resourceid.StaticSegment("eventhubs"),
+		resourceid.UserSpecifiedSegment("EventhubName"),
+		resourceid.StaticSegment("consumergroups"),
+		resourceid.UserSpecifiedSegment("ConsumergroupName"),
+	}
+}
+
 // EventHubConsumerGroupID parses a EventHubConsumerGroup ID into an EventHubConsumerGroupId struct
 func EventHubConsumerGroupID(input string) (*EventHubConsumerGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(EventHubConsumerGroupId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := EventHubConsumerGroupId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.EventhubName, err = id.PopSegment("eventhubs"); err != nil {
-		return nil, err
-	}
-	if resourceId.ConsumergroupName, err = id.PopSegment("consumergroups"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:    id.Parsed["SubscriptionId"],
+		ResourceGroup:     id.Parsed["ResourceGroup"],
+		NamespaceName:     id.Parsed["NamespaceName"],
+		EventhubName:      id.Parsed["EventhubName"],
+		ConsumergroupName: id.Parsed["ConsumergroupName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NamespaceAuthorizationRuleId struct {
@@ -40,35 +40,34 @@ func (id NamespaceAuthorizationRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.AuthorizationRuleName)
 }
 
+// Segments returns the ordered list of Segments which make up a NamespaceAuthorizationRule ID
+func (id NamespaceAuthorizationRuleId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.EventHub"),
+		resourceid.StaticSegment("namespaces"),
+		resourceid.UserSpecifiedSegment("NamespaceName"),
+		resourceid.StaticSegment("authorizationRules"),
+		resourceid.UserSpecifiedSegment("AuthorizationRuleName"),
+	}
+}
+
 // NamespaceAuthorizationRuleID parses a NamespaceAuthorizationRule ID into an NamespaceAuthorizationRuleId struct
 func NamespaceAuthorizationRuleID(input string) (*NamespaceAuthorizationRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(NamespaceAuthorizationRuleId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := NamespaceAuthorizationRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.AuthorizationRuleName, err = id.PopSegment("authorizationRules"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:        id.Parsed["SubscriptionId"],
+		ResourceGroup:         id.Parsed["ResourceGroup"],
+		NamespaceName:         id.Parsed["NamespaceName"],
+		AuthorizationRuleName: id.Parsed["AuthorizationRuleName"],
 	}
 
 	return &resourceId, nil
@@ -81,50 +80,16 @@ func NamespaceAuthorizationRuleID(input string) (*NamespaceAuthorizationRuleId,
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func NamespaceAuthorizationRuleIDInsensitively(input string) (*NamespaceAuthorizationRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(NamespaceAuthorizationRuleId{}).Parse(input, true)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := NamespaceAuthorizationRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	// find the correct casing for the 'namespaces' segment
-	namespacesKey := "namespaces"
-	for key := range id.Path {
-		if strings.EqualFold(key, namespacesKey) {
-			namespacesKey = key
-			break
-		}
-	}
-	if resourceId.NamespaceName, err = id.PopSegment(namespacesKey); err != nil {
-		return nil, err
-	}
-
-	// find the correct casing for the 'authorizationRules' segment
-	authorizationRulesKey := "authorizationRules"
-	for key := range id.Path {
-		if strings.EqualFold(key, authorizationRulesKey) {
-			authorizationRulesKey = key
-			break
-		}
-	}
-	if resourceId.AuthorizationRuleName, err = id.PopSegment(authorizationRulesKey); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:        id.Parsed["SubscriptionId"],
+		ResourceGroup:         id.Parsed["ResourceGroup"],
+		NamespaceName:         id.Parsed["NamespaceName"],
+		AuthorizationRuleName: id.Parsed["AuthorizationRuleName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallId struct {
@@ -37,32 +37,31 @@ func (id FirewallId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName)
 }
 
+// Segments returns the ordered list of Segments which make up a Firewall ID
+func (id FirewallId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("azureFirewalls"),
+		resourceid.UserSpecifiedSegment("AzureFirewallName"),
+	}
+}
+
 // FirewallID parses a Firewall ID into an FirewallId struct
 func FirewallID(input string) (*FirewallId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(FirewallId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FirewallId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:    id.Parsed["SubscriptionId"],
+		ResourceGroup:     id.Parsed["ResourceGroup"],
+		AzureFirewallName: id.Parsed["AzureFirewallName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallApplicationRuleCollectionId struct {
@@ -40,35 +40,34 @@ func (id FirewallApplicationRuleCollectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName, id.ApplicationRuleCollectionName)
 }
 
+// Segments returns the ordered list of Segments which make up a FirewallApplicationRuleCollection ID
+func (id FirewallApplicationRuleCollectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("azureFirewalls"),
+		resourceid.UserSpecifiedSegment("AzureFirewallName"),
+		resourceid.StaticSegment("applicationRuleCollections"),
+		resourceid.UserSpecifiedSegment("ApplicationRuleCollectionName"),
+	}
+}
+
 // FirewallApplicationRuleCollectionID parses a FirewallApplicationRuleCollection ID into an FirewallApplicationRuleCollectionId struct
 func FirewallApplicationRuleCollectionID(input string) (*FirewallApplicationRuleCollectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(FirewallApplicationRuleCollectionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FirewallApplicationRuleCollectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
-		return nil, err
-	}
-	if resourceId.ApplicationRuleCollectionName, err = id.PopSegment("applicationRuleCollections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:                id.Parsed["SubscriptionId"],
+		ResourceGroup:                 id.Parsed["ResourceGroup"],
+		AzureFirewallName:             id.Parsed["AzureFirewallName"],
+		ApplicationRuleCollectionName: id.Parsed["ApplicationRuleCollectionName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallNatRuleCollectionId struct {
@@ -40,35 +40,34 @@ func (id FirewallNatRuleCollectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName, id.NatRuleCollectionName)
 }
 
+// Segments returns the ordered list of Segments which make up a FirewallNatRuleCollection ID
+func (id FirewallNatRuleCollectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("azureFirewalls"),
+		resourceid.UserSpecifiedSegment("AzureFirewallName"),
+		resourceid.StaticSegment("natRuleCollections"),
+		resourceid.UserSpecifiedSegment("NatRuleCollectionName"),
+	}
+}
+
 // FirewallNatRuleCollectionID parses a FirewallNatRuleCollection ID into an FirewallNatRuleCollectionId struct
 func FirewallNatRuleCollectionID(input string) (*FirewallNatRuleCollectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(FirewallNatRuleCollectionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FirewallNatRuleCollectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
-		return nil, err
-	}
-	if resourceId.NatRuleCollectionName, err = id.PopSegment("natRuleCollections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:        id.Parsed["SubscriptionId"],
+		ResourceGroup:         id.Parsed["ResourceGroup"],
+		AzureFirewallName:     id.Parsed["AzureFirewallName"],
+		NatRuleCollectionName: id.Parsed["NatRuleCollectionName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallNetworkRuleCollectionId struct {
@@ -40,35 +40,34 @@ func (id FirewallNetworkRuleCollectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName, id.NetworkRuleCollectionName)
 }
 
+// Segments returns the ordered list of Segments which make up a FirewallNetworkRuleCollection ID
+func (id FirewallNetworkRuleCollectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("azureFirewalls"),
+		resourceid.UserSpecifiedSegment("AzureFirewallName"),
+		resourceid.StaticSegment("networkRuleCollections"),
+		resourceid.UserSpecifiedSegment("NetworkRuleCollectionName"),
+	}
+}
+
 // FirewallNetworkRuleCollectionID parses a FirewallNetworkRuleCollection ID into an FirewallNetworkRuleCollectionId struct
 func FirewallNetworkRuleCollectionID(input string) (*FirewallNetworkRuleCollectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(FirewallNetworkRuleCollectionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FirewallNetworkRuleCollectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
-		return nil, err
-	}
-	if resourceId.NetworkRuleCollectionName, err = id.PopSegment("networkRuleCollections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:            id.Parsed["SubscriptionId"],
+		ResourceGroup:             id.Parsed["ResourceGroup"],
+		AzureFirewallName:         id.Parsed["AzureFirewallName"],
+		NetworkRuleCollectionName: id.Parsed["NetworkRuleCollectionName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallPolicyRuleCollectionGroupId struct {
@@ -40,35 +40,34 @@ func (id FirewallPolicyRuleCollectionGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
 }
 
+// Segments returns the ordered list of Segments which make up a FirewallPolicyRuleCollectionGroup ID
+func (id FirewallPolicyRuleCollectionGroupId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("firewallPolicies"),
+		resourceid.UserSpecifiedSegment("FirewallPolicyName"),
+		resourceid.StaticSegment("ruleCollectionGroups"),
+		resourceid.UserSpecifiedSegment("RuleCollectionGroupName"),
+	}
+}
+
 // FirewallPolicyRuleCollectionGroupID parses a FirewallPolicyRuleCollectionGroup ID into an FirewallPolicyRuleCollectionGroupId struct
 func FirewallPolicyRuleCollectionGroupID(input string) (*FirewallPolicyRuleCollectionGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(FirewallPolicyRuleCollectionGroupId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FirewallPolicyRuleCollectionGroupId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.FirewallPolicyName, err = id.PopSegment("firewallPolicies"); err != nil {
-		return nil, err
-	}
-	if resourceId.RuleCollectionGroupName, err = id.PopSegment("ruleCollectionGroups"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:          id.Parsed["SubscriptionId"],
+		ResourceGroup:           id.Parsed["ResourceGroup"],
+		FirewallPolicyName:      id.Parsed["FirewallPolicyName"],
+		RuleCollectionGroupName: id.Parsed["RuleCollectionGroupName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BackendPoolId struct {
@@ -40,35 +40,34 @@ func (id BackendPoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FrontDoorName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a BackendPool ID
+func (id BackendPoolId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("frontDoors"),
+		resourceid.UserSpecifiedSegment("FrontDoorName"),
+		resourceid.StaticSegment("backendPools"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // BackendPoolID parses a BackendPool ID into an BackendPoolId struct
 func BackendPoolID(input string) (*BackendPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(BackendPoolId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := BackendPoolId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.FrontDoorName, err = id.PopSegment("frontDoors"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("backendPools"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		FrontDoorName:  id.Parsed["FrontDoorName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -81,50 +80,16 @@ func BackendPoolID(input string) (*BackendPoolId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func BackendPoolIDInsensitively(input string) (*BackendPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(BackendPoolId{}).Parse(input, true)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := BackendPoolId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	// find the correct casing for the 'frontDoors' segment
-	frontDoorsKey := "frontDoors"
-	for key := range id.Path {
-		if strings.EqualFold(key, frontDoorsKey) {
-			frontDoorsKey = key
-			break
-		}
-	}
-	if resourceId.FrontDoorName, err = id.PopSegment(frontDoorsKey); err != nil {
-		return nil, err
-	}
-
-	// find the correct casing for the 'backendPools' segment
-	backendPoolsKey := "backendPools"
-	for key := range id.Path {
-		if strings.EqualFold(key, backendPoolsKey) {
-			backendPoolsKey = key
-			break
-		}
-	}
-	if resourceId.Name, err = id.PopSegment(backendPoolsKey); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		FrontDoorName:  id.Parsed["FrontDoorName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CustomHttpsConfigurationId struct {
@@ -40,35 +40,34 @@ func (id CustomHttpsConfigurationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FrontDoorName, id.CustomHttpsConfigurationName)
 }
 
+// Segments returns the ordered list of Segments which make up a CustomHttpsConfiguration ID
+func (id CustomHttpsConfigurationId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("frontDoors"),
+		resourceid.UserSpecifiedSegment("FrontDoorName"),
+		resourceid.StaticSegment("customHttpsConfiguration"),
+		resourceid.UserSpecifiedSegment("CustomHttpsConfigurationName"),
+	}
+}
+
 // CustomHttpsConfigurationID parses a CustomHttpsConfiguration ID into an CustomHttpsConfigurationId struct
 func CustomHttpsConfigurationID(input string) (*CustomHttpsConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(CustomHttpsConfigurationId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := CustomHttpsConfigurationId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.FrontDoorName, err = id.PopSegment("frontDoors"); err != nil {
-		return nil, err
-	}
-	if resourceId.CustomHttpsConfigurationName, err = id.PopSegment("customHttpsConfiguration"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:               id.Parsed["SubscriptionId"],
+		ResourceGroup:                id.Parsed["ResourceGroup"],
+		FrontDoorName:                id.Parsed["FrontDoorName"],
+		CustomHttpsConfigurationName: id.Parsed["CustomHttpsConfigurationName"],
 	}
 
 	return &resourceId, nil
@@ -81,50 +80,16 @@ func CustomHttpsConfigurationID(input string) (*CustomHttpsConfigurationId, erro
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func CustomHttpsConfigurationIDInsensitively(input string) (*CustomHttpsConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(CustomHttpsConfigurationId{}).Parse(input, true)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := CustomHttpsConfigurationId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	// find the correct casing for the 'frontDoors' segment
-	frontDoorsKey := "frontDoors"
-	for key := range id.Path {
-		if strings.EqualFold(key, frontDoorsKey) {
-			frontDoorsKey = key
-			break
-		}
-	}
-	if resourceId.FrontDoorName, err = id.PopSegment(frontDoorsKey); err != nil {
-		return nil, err
-	}
-
-	// find the correct casing for the 'customHttpsConfiguration' segment
-	customHttpsConfigurationKey := "customHttpsConfiguration"
-	for key := range id.Path {
-		if strings.EqualFold(key, customHttpsConfigurationKey) {
-			customHttpsConfigurationKey = key
-			break
-		}
-	}
-	if resourceId.CustomHttpsConfigurationName, err = id.PopSegment(customHttpsConfigurationKey); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:               id.Parsed["SubscriptionId"],
+		ResourceGroup:                id.Parsed["ResourceGroup"],
+		FrontDoorName:                id.Parsed["FrontDoorName"],
+		CustomHttpsConfigurationName: id.Parsed["CustomHttpsConfigurationName"],
 	}
 
 	return &resourceId, nil
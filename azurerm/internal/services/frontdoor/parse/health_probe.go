@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type HealthProbeId struct {
@@ -40,35 +40,34 @@ func (id HealthProbeId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FrontDoorName, id.HealthProbeSettingName)
 }
 
+// Segments returns the ordered list of Segments which make up a HealthProbe ID
+func (id HealthProbeId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("frontDoors"),
+		resourceid.UserSpecifiedSegment("FrontDoorName"),
+		resourceid.StaticSegment("healthProbeSettings"),
+		resourceid.UserSpecifiedSegment("HealthProbeSettingName"),
+	}
+}
+
 // HealthProbeID parses a HealthProbe ID into an HealthProbeId struct
 func HealthProbeID(input string) (*HealthProbeId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(HealthProbeId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := HealthProbeId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.FrontDoorName, err = id.PopSegment("frontDoors"); err != nil {
-		return nil, err
-	}
-	if resourceId.HealthProbeSettingName, err = id.PopSegment("healthProbeSettings"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         id.Parsed["SubscriptionId"],
+		ResourceGroup:          id.Parsed["ResourceGroup"],
+		FrontDoorName:          id.Parsed["FrontDoorName"],
+		HealthProbeSettingName: id.Parsed["HealthProbeSettingName"],
 	}
 
 	return &resourceId, nil
@@ -81,50 +80,16 @@ func HealthProbeID(input string) (*HealthProbeId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func HealthProbeIDInsensitively(input string) (*HealthProbeId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(HealthProbeId{}).Parse(input, true)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := HealthProbeId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	// find the correct casing for the 'frontDoors' segment
-	frontDoorsKey := "frontDoors"
-	for key := range id.Path {
-		if strings.EqualFold(key, frontDoorsKey) {
-			frontDoorsKey = key
-			break
-		}
-	}
-	if resourceId.FrontDoorName, err = id.PopSegment(frontDoorsKey); err != nil {
-		return nil, err
-	}
-
-	// find the correct casing for the 'healthProbeSettings' segment
-	healthProbeSettingsKey := "healthProbeSettings"
-	for key := range id.Path {
-		if strings.EqualFold(key, healthProbeSettingsKey) {
-			healthProbeSettingsKey = key
-			break
-		}
-	}
-	if resourceId.HealthProbeSettingName, err = id.PopSegment(healthProbeSettingsKey); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         id.Parsed["SubscriptionId"],
+		ResourceGroup:          id.Parsed["ResourceGroup"],
+		FrontDoorName:          id.Parsed["FrontDoorName"],
+		HealthProbeSettingName: id.Parsed["HealthProbeSettingName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancingId struct {
@@ -40,35 +40,34 @@ func (id LoadBalancingId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FrontDoorName, id.LoadBalancingSettingName)
 }
 
+// Segments returns the ordered list of Segments which make up a LoadBalancing ID
+func (id LoadBalancingId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("frontDoors"),
+		resourceid.UserSpecifiedSegment("FrontDoorName"),
+		resourceid.StaticSegment("loadBalancingSettings"),
+		resourceid.UserSpecifiedSegment("LoadBalancingSettingName"),
+	}
+}
+
 // LoadBalancingID parses a LoadBalancing ID into an LoadBalancingId struct
 func LoadBalancingID(input string) (*LoadBalancingId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(LoadBalancingId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LoadBalancingId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.FrontDoorName, err = id.PopSegment("frontDoors"); err != nil {
-		return nil, err
-	}
-	if resourceId.LoadBalancingSettingName, err = id.PopSegment("loadBalancingSettings"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:           id.Parsed["SubscriptionId"],
+		ResourceGroup:            id.Parsed["ResourceGroup"],
+		FrontDoorName:            id.Parsed["FrontDoorName"],
+		LoadBalancingSettingName: id.Parsed["LoadBalancingSettingName"],
 	}
 
 	return &resourceId, nil
@@ -81,50 +80,16 @@ func LoadBalancingID(input string) (*LoadBalancingId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func LoadBalancingIDInsensitively(input string) (*LoadBalancingId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(LoadBalancingId{}).Parse(input, true)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LoadBalancingId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	// find the correct casing for the 'frontDoors' segment
-	frontDoorsKey := "frontDoors"
-	for key := range id.Path {
-		if strings.EqualFold(key, frontDoorsKey) {
-			frontDoorsKey = key
-			break
-		}
-	}
-	if resourceId.FrontDoorName, err = id.PopSegment(frontDoorsKey); err != nil {
-		return nil, err
-	}
-
-	// find the correct casing for the 'loadBalancingSettings' segment
-	loadBalancingSettingsKey := "loadBalancingSettings"
-	for key := range id.Path {
-		if strings.EqualFold(key, loadBalancingSettingsKey) {
-			loadBalancingSettingsKey = key
-			break
-		}
-	}
-	if resourceId.LoadBalancingSettingName, err = id.PopSegment(loadBalancingSettingsKey); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:           id.Parsed["SubscriptionId"],
+		ResourceGroup:            id.Parsed["ResourceGroup"],
+		FrontDoorName:            id.Parsed["FrontDoorName"],
+		LoadBalancingSettingName: id.Parsed["LoadBalancingSettingName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageTargetId struct {
@@ -40,35 +40,34 @@ func (id StorageTargetId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.CacheName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a StorageTarget ID
+func (id StorageTargetId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.StorageCache"),
+		resourceid.StaticSegment("caches"),
+		resourceid.UserSpecifiedSegment("CacheName"),
+		resourceid.StaticSegment("storageTargets"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // StorageTargetID parses a StorageTarget ID into an StorageTargetId struct
 func StorageTargetID(input string) (*StorageTargetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(StorageTargetId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := StorageTargetId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.CacheName, err = id.PopSegment("caches"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("storageTargets"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		CacheName:      id.Parsed["CacheName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -29,3 +29,8 @@ func (r Registration) WebsiteCategories() []string {
 		"IoT Central",
 	}
 }
+
+// ResourceProviderNamespace returns the Resource Provider Namespace used by the specified Resource Type
+func (r Registration) ResourceProviderNamespace(_ string) string {
+	return "Microsoft.IoTCentral"
+}
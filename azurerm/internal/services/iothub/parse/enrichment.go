@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EnrichmentId struct {
@@ -40,35 +40,34 @@ func (id EnrichmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.IotHubName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a Enrichment ID
+func (id EnrichmentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Devices"),
+		resourceid.StaticSegment("IotHubs"),
+		resourceid.UserSpecifiedSegment("IotHubName"),
+		resourceid.StaticSegment("Enrichments"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // EnrichmentID parses a Enrichment ID into an EnrichmentId struct
 func EnrichmentID(input string) (*EnrichmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(EnrichmentId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := EnrichmentId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.IotHubName, err = id.PopSegment("IotHubs"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("Enrichments"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		IotHubName:     id.Parsed["IotHubName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ReferenceDataSetId struct {
@@ -40,35 +40,34 @@ func (id ReferenceDataSetId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.EnvironmentName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a ReferenceDataSet ID
+func (id ReferenceDataSetId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.TimeSeriesInsights"),
+		resourceid.StaticSegment("environments"),
+		resourceid.UserSpecifiedSegment("EnvironmentName"),
+		resourceid.StaticSegment("referenceDataSets"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // ReferenceDataSetID parses a ReferenceDataSet ID into an ReferenceDataSetId struct
 func ReferenceDataSetID(input string) (*ReferenceDataSetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ReferenceDataSetId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ReferenceDataSetId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.EnvironmentName, err = id.PopSegment("environments"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("referenceDataSets"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:  id.Parsed["SubscriptionId"],
+		ResourceGroup:   id.Parsed["ResourceGroup"],
+		EnvironmentName: id.Parsed["EnvironmentName"],
+		Name:            id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
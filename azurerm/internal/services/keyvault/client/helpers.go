@@ -3,51 +3,60 @@ package client
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/url"
 	"strings"
 	"sync"
 
+	"github.com/Azure/azure-sdk-for-go/services/preview/keyvault/mgmt/2020-04-01-preview/keyvault"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
-	resourcesClient "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/client"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-var keyVaultsCache = map[string]keyVaultDetails{}
-var keysmith = &sync.RWMutex{}
-var lock = map[string]*sync.RWMutex{}
+var (
+	// keyVaultsCache is a map of the (lower-cased) name of the Key Vault to the details for that Key Vault. Since
+	// the name of a Key Vault is globally unique (as it's used in the Data Plane URI) this is shared across all
+	// Resources and Subscriptions - meaning a Key Vault only needs to be looked up once per Provider run.
+	keyVaultsCache     = map[string]keyVaultDetails{}
+	keyVaultsCacheLock = &sync.RWMutex{}
+
+	// keyVaultsNotFound is a set of the (lower-cased) names of the Key Vaults which couldn't be found when listing
+	// the Key Vaults within each Subscription, so that Resources within a deleted Key Vault don't each re-list the
+	// Key Vaults within every Subscription. This is guarded by keyVaultsCacheLock.
+	keyVaultsNotFound = map[string]struct{}{}
+
+	// keyVaultSubscriptionLocks ensures that the Key Vaults within a Subscription are only listed once at a time
+	keyVaultSubscriptionLocks     = map[string]*sync.Mutex{}
+	keyVaultSubscriptionLocksLock = &sync.Mutex{}
+)
 
 type keyVaultDetails struct {
 	keyVaultId       string
 	dataPlaneBaseUri string
 	resourceGroup    string
+	subscriptionId   string
 }
 
 func (c *Client) AddToCache(keyVaultId parse.VaultId, dataPlaneUri string) {
 	cacheKey := c.cacheKeyForKeyVault(keyVaultId.Name)
-	keysmith.Lock()
+	keyVaultsCacheLock.Lock()
 	keyVaultsCache[cacheKey] = keyVaultDetails{
 		keyVaultId:       keyVaultId.ID(),
 		dataPlaneBaseUri: dataPlaneUri,
 		resourceGroup:    keyVaultId.ResourceGroup,
+		subscriptionId:   keyVaultId.SubscriptionId,
 	}
-	keysmith.Unlock()
+	delete(keyVaultsNotFound, cacheKey)
+	keyVaultsCacheLock.Unlock()
 }
 
 func (c *Client) BaseUriForKeyVault(ctx context.Context, keyVaultId parse.VaultId) (*string, error) {
-	cacheKey := c.cacheKeyForKeyVault(keyVaultId.Name)
-	keysmith.Lock()
-	if lock[cacheKey] == nil {
-		lock[cacheKey] = &sync.RWMutex{}
-	}
-	keysmith.Unlock()
-	lock[cacheKey].Lock()
-	defer lock[cacheKey].Unlock()
-
-	if keyVaultId.SubscriptionId != c.VaultsClient.SubscriptionID {
-		c.VaultsClient = c.KeyVaultClientForSubscription(keyVaultId.SubscriptionId)
+	if v, ok := c.cachedKeyVault(keyVaultId); ok {
+		return utils.String(v.dataPlaneBaseUri), nil
 	}
 
-	resp, err := c.VaultsClient.Get(ctx, keyVaultId.ResourceGroup, keyVaultId.Name)
+	vaultsClient := c.vaultsClientForSubscription(keyVaultId.SubscriptionId)
+	resp, err := vaultsClient.Get(ctx, keyVaultId.ResourceGroup, keyVaultId.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return nil, fmt.Errorf("%s was not found", keyVaultId)
@@ -59,24 +68,18 @@ func (c *Client) BaseUriForKeyVault(ctx context.Context, keyVaultId parse.VaultI
 		return nil, fmt.Errorf("`properties` was nil for %s", keyVaultId)
 	}
 
+	c.AddToCache(keyVaultId, *resp.Properties.VaultURI)
+
 	return resp.Properties.VaultURI, nil
 }
 
 func (c *Client) Exists(ctx context.Context, keyVaultId parse.VaultId) (bool, error) {
-	cacheKey := c.cacheKeyForKeyVault(keyVaultId.Name)
-	keysmith.Lock()
-	if lock[cacheKey] == nil {
-		lock[cacheKey] = &sync.RWMutex{}
-	}
-	keysmith.Unlock()
-	lock[cacheKey].Lock()
-	defer lock[cacheKey].Unlock()
-
-	if _, ok := keyVaultsCache[cacheKey]; ok {
+	if _, ok := c.cachedKeyVault(keyVaultId); ok {
 		return true, nil
 	}
 
-	resp, err := c.VaultsClient.Get(ctx, keyVaultId.ResourceGroup, keyVaultId.Name)
+	vaultsClient := c.vaultsClientForSubscription(keyVaultId.SubscriptionId)
+	resp, err := vaultsClient.Get(ctx, keyVaultId.ResourceGroup, keyVaultId.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return false, nil
@@ -93,76 +96,203 @@ func (c *Client) Exists(ctx context.Context, keyVaultId parse.VaultId) (bool, er
 	return true, nil
 }
 
-func (c *Client) KeyVaultIDFromBaseUrl(ctx context.Context, resourcesClient *resourcesClient.Client, keyVaultBaseUrl string) (*string, error) {
+// KeyVaultIDFromBaseUrl returns the Resource ID of the Key Vault with the specified Data Plane URI, which is
+// looked up from the cache - or when the Key Vault isn't cached, by listing the Key Vaults within the current
+// Subscription (and any other Subscriptions containing a cached Key Vault). This returns nil when the Key Vault
+// wasn't found.
+func (c *Client) KeyVaultIDFromBaseUrl(ctx context.Context, keyVaultBaseUrl string) (*string, error) {
 	keyVaultName, err := c.parseNameFromBaseUrl(keyVaultBaseUrl)
 	if err != nil {
 		return nil, err
 	}
 
 	cacheKey := c.cacheKeyForKeyVault(*keyVaultName)
-	keysmith.Lock()
-	if lock[cacheKey] == nil {
-		lock[cacheKey] = &sync.RWMutex{}
+	if v, ok := c.cachedKeyVaultByName(cacheKey); ok {
+		return utils.String(v.keyVaultId), nil
+	}
+	if c.cachedAsNotFound(cacheKey) {
+		return nil, nil
 	}
-	keysmith.Unlock()
-	lock[cacheKey].Lock()
-	defer lock[cacheKey].Unlock()
 
-	if v, ok := keyVaultsCache[cacheKey]; ok {
-		return &v.keyVaultId, nil
+	for _, subscriptionId := range c.subscriptionIdsToSearch() {
+		if err := c.populateCacheForSubscription(ctx, subscriptionId, cacheKey); err != nil {
+			return nil, err
+		}
+
+		if v, ok := c.cachedKeyVaultByName(cacheKey); ok {
+			return utils.String(v.keyVaultId), nil
+		}
+		if c.cachedAsNotFound(cacheKey) {
+			return nil, nil
+		}
 	}
 
-	filter := fmt.Sprintf("resourceType eq 'Microsoft.KeyVault/vaults' and name eq '%s'", *keyVaultName)
-	result, err := resourcesClient.ResourcesClient.List(ctx, filter, "", utils.Int32(5))
-	if err != nil {
-		return nil, fmt.Errorf("listing resources matching %q: %+v", filter, err)
+	keyVaultsCacheLock.Lock()
+	if _, ok := keyVaultsCache[cacheKey]; !ok {
+		keyVaultsNotFound[cacheKey] = struct{}{}
 	}
+	keyVaultsCacheLock.Unlock()
 
-	for result.NotDone() {
-		for _, v := range result.Values() {
-			if v.ID == nil {
-				continue
-			}
+	// we haven't found it, but Data Sources and Resources need to handle this error separately
+	return nil, nil
+}
+
+// KeyVaultIDFromState returns the Resource ID of the Key Vault with the specified Data Plane URI - using the
+// Key Vault ID from the state (when it's set and the name matches the Data Plane URI) to retrieve the Key Vault
+// directly, rather than listing the Key Vaults within each Subscription. When the Key Vault ID in the state
+// isn't usable (or no longer exists) this falls back to KeyVaultIDFromBaseUrl.
+func (c *Client) KeyVaultIDFromState(ctx context.Context, keyVaultIdFromState string, keyVaultBaseUrl string) (*string, error) {
+	keyVaultName, err := c.parseNameFromBaseUrl(keyVaultBaseUrl)
+	if err != nil {
+		return nil, err
+	}
 
-			id, err := parse.VaultID(*v.ID)
+	// the Key Vault ID from the state is always checked, rather than relying on a cached miss - since a miss
+	// only means the Key Vault wasn't found within the Subscriptions which were searched, which may not include
+	// the Subscription containing this Key Vault
+	if keyVaultIdFromState != "" {
+		keyVaultId, err := parse.VaultID(keyVaultIdFromState)
+		if err == nil && strings.EqualFold(keyVaultId.Name, *keyVaultName) {
+			exists, err := c.Exists(ctx, *keyVaultId)
 			if err != nil {
-				return nil, fmt.Errorf("parsing %q: %+v", *v.ID, err)
+				return nil, err
 			}
-			if !strings.EqualFold(id.Name, *keyVaultName) {
-				continue
+			if exists {
+				return utils.String(keyVaultId.ID()), nil
 			}
 
-			props, err := c.VaultsClient.Get(ctx, id.ResourceGroup, id.Name)
+			// the Key Vault may have been recreated elsewhere, so this is looked up below
+			log.Printf("[DEBUG] %s was not found - searching for the Key Vault at URL %q..", *keyVaultId, keyVaultBaseUrl)
+		}
+	}
+
+	return c.KeyVaultIDFromBaseUrl(ctx, keyVaultBaseUrl)
+}
+
+func (c *Client) Purge(keyVaultId parse.VaultId) {
+	cacheKey := c.cacheKeyForKeyVault(keyVaultId.Name)
+	keyVaultsCacheLock.Lock()
+	delete(keyVaultsCache, cacheKey)
+	keyVaultsCacheLock.Unlock()
+}
+
+// cachedKeyVault returns the cached details for the specified Key Vault - providing the cached Key Vault has the
+// same Resource ID, since a Key Vault with the same name could have been recreated elsewhere
+func (c *Client) cachedKeyVault(keyVaultId parse.VaultId) (*keyVaultDetails, bool) {
+	v, ok := c.cachedKeyVaultByName(c.cacheKeyForKeyVault(keyVaultId.Name))
+	if !ok || !strings.EqualFold(v.keyVaultId, keyVaultId.ID()) {
+		return nil, false
+	}
+
+	return v, true
+}
+
+func (c *Client) cachedKeyVaultByName(cacheKey string) (*keyVaultDetails, bool) {
+	keyVaultsCacheLock.RLock()
+	defer keyVaultsCacheLock.RUnlock()
+
+	v, ok := keyVaultsCache[cacheKey]
+	if !ok {
+		return nil, false
+	}
+
+	return &v, true
+}
+
+// cachedAsNotFound returns whether the specified Key Vault couldn't be found when listing the Key Vaults
+// within each Subscription
+func (c *Client) cachedAsNotFound(cacheKey string) bool {
+	keyVaultsCacheLock.RLock()
+	defer keyVaultsCacheLock.RUnlock()
+
+	_, ok := keyVaultsNotFound[cacheKey]
+	return ok
+}
+
+// populateCacheForSubscription lists all of the Key Vaults within the specified Subscription and adds these
+// to the cache - unless the Key Vault being searched for was added to the cache whilst waiting for the lock
+func (c *Client) populateCacheForSubscription(ctx context.Context, subscriptionId string, cacheKey string) error {
+	subscriptionLock := c.lockForSubscription(subscriptionId)
+	subscriptionLock.Lock()
+	defer subscriptionLock.Unlock()
+
+	if _, ok := c.cachedKeyVaultByName(cacheKey); ok {
+		return nil
+	}
+	if c.cachedAsNotFound(cacheKey) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cache Miss - listing the Key Vaults within Subscription %q..", subscriptionId)
+	vaultsClient := c.vaultsClientForSubscription(subscriptionId)
+	iterator, err := vaultsClient.ListBySubscriptionComplete(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("listing Key Vaults within Subscription %q: %+v", subscriptionId, err)
+	}
+
+	for iterator.NotDone() {
+		vault := iterator.Value()
+		if vault.ID != nil && vault.Properties != nil && vault.Properties.VaultURI != nil {
+			id, err := parse.VaultID(*vault.ID)
 			if err != nil {
-				return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
-			}
-			if props.Properties == nil || props.Properties.VaultURI == nil {
-				return nil, fmt.Errorf("retrieving %s: `properties.VaultUri` was nil", *id)
+				return fmt.Errorf("parsing %q: %+v", *vault.ID, err)
 			}
 
-			c.AddToCache(*id, *props.Properties.VaultURI)
-			return utils.String(id.ID()), nil
+			c.AddToCache(*id, *vault.Properties.VaultURI)
 		}
 
-		if err := result.NextWithContext(ctx); err != nil {
-			return nil, fmt.Errorf("iterating over results: %+v", err)
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Key Vaults within Subscription %q: %+v", subscriptionId, err)
 		}
 	}
 
-	// we haven't found it, but Data Sources and Resources need to handle this error separately
-	return nil, nil
+	return nil
 }
 
-func (c *Client) Purge(keyVaultId parse.VaultId) {
-	cacheKey := c.cacheKeyForKeyVault(keyVaultId.Name)
-	keysmith.Lock()
-	if lock[cacheKey] == nil {
-		lock[cacheKey] = &sync.RWMutex{}
+// subscriptionIdsToSearch returns the current Subscription ID, followed by any other Subscription IDs
+// which contain a cached Key Vault
+func (c *Client) subscriptionIdsToSearch() []string {
+	subscriptionIds := []string{c.VaultsClient.SubscriptionID}
+	found := map[string]struct{}{
+		strings.ToLower(c.VaultsClient.SubscriptionID): {},
 	}
-	keysmith.Unlock()
-	lock[cacheKey].Lock()
-	delete(keyVaultsCache, cacheKey)
-	lock[cacheKey].Unlock()
+
+	keyVaultsCacheLock.RLock()
+	defer keyVaultsCacheLock.RUnlock()
+
+	for _, v := range keyVaultsCache {
+		key := strings.ToLower(v.subscriptionId)
+		if _, exists := found[key]; exists || v.subscriptionId == "" {
+			continue
+		}
+
+		found[key] = struct{}{}
+		subscriptionIds = append(subscriptionIds, v.subscriptionId)
+	}
+
+	return subscriptionIds
+}
+
+func (c *Client) lockForSubscription(subscriptionId string) *sync.Mutex {
+	keyVaultSubscriptionLocksLock.Lock()
+	defer keyVaultSubscriptionLocksLock.Unlock()
+
+	key := strings.ToLower(subscriptionId)
+	if keyVaultSubscriptionLocks[key] == nil {
+		keyVaultSubscriptionLocks[key] = &sync.Mutex{}
+	}
+
+	return keyVaultSubscriptionLocks[key]
+}
+
+// vaultsClientForSubscription returns a Vaults Client for the specified Subscription - rather than
+// modifying the shared Vaults Client, which would be a race condition
+func (c *Client) vaultsClientForSubscription(subscriptionId string) *keyvault.VaultsClient {
+	if subscriptionId == "" || strings.EqualFold(subscriptionId, c.VaultsClient.SubscriptionID) {
+		return c.VaultsClient
+	}
+
+	return c.KeyVaultClientForSubscription(subscriptionId)
 }
 
 func (c *Client) cacheKeyForKeyVault(name string) string {
@@ -0,0 +1,341 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/keyvault/mgmt/2020-04-01-preview/keyvault"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+)
+
+const testSubscriptionId = "00000000-0000-0000-0000-000000000000"
+
+func TestParseNameFromBaseUrl(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected *string
+	}{
+		{
+			input:    "",
+			expected: nil,
+		},
+		{
+			input:    "https://example.com",
+			expected: nil,
+		},
+		{
+			input:    "https://the-keyvault.managedhsm.azure.net",
+			expected: nil,
+		},
+		{
+			input:    "https://the-keyvault.vault.azure.net",
+			expected: stringPointer("the-keyvault"),
+		},
+		{
+			input:    "https://the-keyvault.vault.azure.net/",
+			expected: stringPointer("the-keyvault"),
+		},
+		{
+			input:    "https://the-keyvault.vault.azure.net:443/secrets/hello/abc123",
+			expected: stringPointer("the-keyvault"),
+		},
+		{
+			input:    "https://the-keyvault.vault.microsoftazure.de",
+			expected: stringPointer("the-keyvault"),
+		},
+		{
+			input:    "https://the-keyvault.vault.usgovcloudapi.net",
+			expected: stringPointer("the-keyvault"),
+		},
+		{
+			input:    "https://the-keyvault.vault.cloudapi.microsoft",
+			expected: stringPointer("the-keyvault"),
+		},
+		{
+			input:    "https://the-keyvault.vault.azure.cn",
+			expected: stringPointer("the-keyvault"),
+		},
+	}
+
+	client := &Client{}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual, err := client.parseNameFromBaseUrl(v.input)
+		if v.expected == nil {
+			if err == nil {
+				t.Fatalf("expected an error but got %q", *actual)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", v.input, err)
+		}
+		if *actual != *v.expected {
+			t.Fatalf("expected %q but got %q", *v.expected, *actual)
+		}
+	}
+}
+
+func TestKeyVaultIDFromBaseUrlUsesCache(t *testing.T) {
+	resetKeyVaultsCache()
+	server, requests := newTestVaultsServer(t)
+	defer server.Close()
+	client := newTestClient(server.URL)
+
+	id := parse.NewVaultID(testSubscriptionId, "group1", "cachedVault")
+	client.AddToCache(id, "https://cachedvault.vault.azure.net/")
+
+	actual, err := client.KeyVaultIDFromBaseUrl(context.TODO(), "https://CachedVault.vault.azure.net/")
+	if err != nil {
+		t.Fatalf("retrieving Key Vault ID: %+v", err)
+	}
+	if actual == nil || *actual != id.ID() {
+		t.Fatalf("expected %q but got %v", id.ID(), actual)
+	}
+	if v := requests.list(); v != 0 {
+		t.Fatalf("expected no requests to list the Key Vaults but got %d", v)
+	}
+}
+
+func TestKeyVaultIDFromBaseUrlConcurrentLookupsListOnce(t *testing.T) {
+	resetKeyVaultsCache()
+	server, requests := newTestVaultsServer(t, "vault1", "vault2", "vault3")
+	defer server.Close()
+	client := newTestClient(server.URL)
+
+	wg := sync.WaitGroup{}
+	errors := make(chan error, 30)
+	for i := 0; i < 30; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("vault%d", i%3+1)
+			actual, err := client.KeyVaultIDFromBaseUrl(context.TODO(), fmt.Sprintf("https://%s.vault.azure.net/", name))
+			if err != nil {
+				errors <- err
+				return
+			}
+			expected := parse.NewVaultID(testSubscriptionId, "group1", name).ID()
+			if actual == nil || *actual != expected {
+				errors <- fmt.Errorf("expected %q but got %v", expected, actual)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errors)
+
+	for err := range errors {
+		t.Fatal(err)
+	}
+	if v := requests.list(); v != 1 {
+		t.Fatalf("expected the Key Vaults to be listed once but got %d", v)
+	}
+}
+
+func TestKeyVaultIDFromBaseUrlCachesMisses(t *testing.T) {
+	resetKeyVaultsCache()
+	server, requests := newTestVaultsServer(t, "vault1")
+	defer server.Close()
+	client := newTestClient(server.URL)
+
+	for i := 0; i < 3; i++ {
+		actual, err := client.KeyVaultIDFromBaseUrl(context.TODO(), "https://deleted.vault.azure.net/")
+		if err != nil {
+			t.Fatalf("retrieving Key Vault ID: %+v", err)
+		}
+		if actual != nil {
+			t.Fatalf("expected no Key Vault ID but got %q", *actual)
+		}
+	}
+	if v := requests.list(); v != 1 {
+		t.Fatalf("expected the Key Vaults to be listed once but got %d", v)
+	}
+
+	// adding the Key Vault to the cache (e.g. when it's recreated) should clear the miss
+	id := parse.NewVaultID(testSubscriptionId, "group1", "deleted")
+	client.AddToCache(id, "https://deleted.vault.azure.net/")
+	actual, err := client.KeyVaultIDFromBaseUrl(context.TODO(), "https://deleted.vault.azure.net/")
+	if err != nil {
+		t.Fatalf("retrieving Key Vault ID: %+v", err)
+	}
+	if actual == nil || *actual != id.ID() {
+		t.Fatalf("expected %q but got %v", id.ID(), actual)
+	}
+}
+
+func TestKeyVaultIDFromStateDoesNotList(t *testing.T) {
+	resetKeyVaultsCache()
+	server, requests := newTestVaultsServer(t, "vault1")
+	defer server.Close()
+	client := newTestClient(server.URL)
+
+	id := parse.NewVaultID(testSubscriptionId, "group1", "vault1")
+	for i := 0; i < 3; i++ {
+		actual, err := client.KeyVaultIDFromState(context.TODO(), id.ID(), "https://vault1.vault.azure.net/")
+		if err != nil {
+			t.Fatalf("retrieving Key Vault ID: %+v", err)
+		}
+		if actual == nil || *actual != id.ID() {
+			t.Fatalf("expected %q but got %v", id.ID(), actual)
+		}
+	}
+	if v := requests.get(); v != 1 {
+		t.Fatalf("expected the Key Vault to be retrieved once but got %d", v)
+	}
+	if v := requests.list(); v != 0 {
+		t.Fatalf("expected no requests to list the Key Vaults but got %d", v)
+	}
+}
+
+func TestKeyVaultIDFromStateDeletedKeyVault(t *testing.T) {
+	resetKeyVaultsCache()
+	server, requests := newTestVaultsServer(t, "vault1")
+	defer server.Close()
+	client := newTestClient(server.URL)
+
+	id := parse.NewVaultID(testSubscriptionId, "group1", "deleted")
+	for i := 0; i < 3; i++ {
+		actual, err := client.KeyVaultIDFromState(context.TODO(), id.ID(), "https://deleted.vault.azure.net/")
+		if err != nil {
+			t.Fatalf("retrieving Key Vault ID: %+v", err)
+		}
+		if actual != nil {
+			t.Fatalf("expected no Key Vault ID but got %q", *actual)
+		}
+	}
+	if v := requests.get(); v != 3 {
+		t.Fatalf("expected the Key Vault to be retrieved 3 times but got %d", v)
+	}
+	if v := requests.list(); v != 1 {
+		t.Fatalf("expected the Key Vaults to be listed once but got %d", v)
+	}
+}
+
+func TestKeyVaultIDFromStateInAnotherSubscriptionAfterCachedMiss(t *testing.T) {
+	resetKeyVaultsCache()
+	id := parse.NewVaultID("11111111-1111-1111-1111-111111111111", "group1", "other")
+	server, requests := newTestVaultsServerWithIds(t, id)
+	defer server.Close()
+	client := newTestClient(server.URL)
+
+	// a lookup without the Key Vault ID (e.g. from another Service) only searches the current Subscription
+	actual, err := client.KeyVaultIDFromBaseUrl(context.TODO(), "https://other.vault.azure.net/")
+	if err != nil {
+		t.Fatalf("retrieving Key Vault ID: %+v", err)
+	}
+	if actual != nil {
+		t.Fatalf("expected no Key Vault ID but got %q", *actual)
+	}
+
+	// whereas the Key Vault ID from the state should still be found
+	actual, err = client.KeyVaultIDFromState(context.TODO(), id.ID(), "https://other.vault.azure.net/")
+	if err != nil {
+		t.Fatalf("retrieving Key Vault ID: %+v", err)
+	}
+	if actual == nil || *actual != id.ID() {
+		t.Fatalf("expected %q but got %v", id.ID(), actual)
+	}
+
+	// and the cached miss should be cleared
+	actual, err = client.KeyVaultIDFromBaseUrl(context.TODO(), "https://other.vault.azure.net/")
+	if err != nil {
+		t.Fatalf("retrieving Key Vault ID: %+v", err)
+	}
+	if actual == nil || *actual != id.ID() {
+		t.Fatalf("expected %q but got %v", id.ID(), actual)
+	}
+	if v := requests.list(); v != 1 {
+		t.Fatalf("expected the Key Vaults to be listed once but got %d", v)
+	}
+}
+
+type testVaultsRequests struct {
+	gets  int32
+	lists int32
+}
+
+func (r *testVaultsRequests) get() int32 {
+	return atomic.LoadInt32(&r.gets)
+}
+
+func (r *testVaultsRequests) list() int32 {
+	return atomic.LoadInt32(&r.lists)
+}
+
+// newTestVaultsServer returns a server which mimics the Key Vaults API, containing the specified Key Vaults
+// within the Resource Group `group1` in the Subscription `testSubscriptionId`
+func newTestVaultsServer(t *testing.T, names ...string) (*httptest.Server, *testVaultsRequests) {
+	ids := make([]parse.VaultId, 0, len(names))
+	for _, name := range names {
+		ids = append(ids, parse.NewVaultID(testSubscriptionId, "group1", name))
+	}
+
+	return newTestVaultsServerWithIds(t, ids...)
+}
+
+// newTestVaultsServerWithIds returns a server which mimics the Key Vaults API, containing the specified Key Vaults
+func newTestVaultsServerWithIds(t *testing.T, ids ...parse.VaultId) (*httptest.Server, *testVaultsRequests) {
+	requests := &testVaultsRequests{}
+	vaults := map[string]string{}
+	for _, id := range ids {
+		vaults[strings.ToLower(id.ID())] = fmt.Sprintf(`{"id": %q, "name": %q, "properties": {"vaultUri": "https://%s.vault.azure.net/"}}`, id.ID(), id.Name, id.Name)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if segments := strings.Split(strings.ToLower(r.URL.Path), "/"); len(segments) == 6 && strings.HasSuffix(r.URL.Path, "/providers/Microsoft.KeyVault/vaults") {
+			atomic.AddInt32(&requests.lists, 1)
+			prefix := fmt.Sprintf("/subscriptions/%s/", segments[2])
+			values := make([]string, 0)
+			for k, v := range vaults {
+				if strings.HasPrefix(k, prefix) {
+					values = append(values, v)
+				}
+			}
+			fmt.Fprintf(w, `{"value": [%s]}`, strings.Join(values, ","))
+			return
+		}
+
+		atomic.AddInt32(&requests.gets, 1)
+		if v, ok := vaults[strings.ToLower(r.URL.Path)]; ok {
+			fmt.Fprint(w, v)
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error": {"code": "ResourceNotFound"}}`)
+	}))
+
+	return server, requests
+}
+
+func newTestClient(baseUri string) *Client {
+	vaultsClient := keyvault.NewVaultsClientWithBaseURI(baseUri, testSubscriptionId)
+	return &Client{
+		VaultsClient: &vaultsClient,
+		options: &common.ClientOptions{
+			ResourceManagerEndpoint: baseUri,
+			SubscriptionId:          testSubscriptionId,
+		},
+	}
+}
+
+func resetKeyVaultsCache() {
+	keyVaultsCacheLock.Lock()
+	keyVaultsCache = map[string]keyVaultDetails{}
+	keyVaultsNotFound = map[string]struct{}{}
+	keyVaultsCacheLock.Unlock()
+}
+
+func stringPointer(input string) *string {
+	return &input
+}
@@ -2,16 +2,29 @@ package keyvault
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"log"
+	"math/big"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	azValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/keyrotationpolicies"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
@@ -19,6 +32,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"golang.org/x/crypto/ssh"
 )
 
 func resourceKeyVaultKey() *pluginsdk.Resource {
@@ -68,8 +82,10 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 			},
 
 			"key_size": {
-				Type:          pluginsdk.TypeInt,
-				Optional:      true,
+				Type:     pluginsdk.TypeInt,
+				Optional: true,
+				// the size of an imported Key is determined by the `key_material`
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"curve"},
 			},
@@ -113,6 +129,47 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 				ConflictsWith: []string{"key_size"},
 			},
 
+			"key_material": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"key_size", "curve", "rotation_policy.0.automatic"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"jwk": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							StateFunc:    keyVaultKeyMaterialStateFunc,
+							ValidateFunc: validation.StringIsJSON,
+							ExactlyOneOf: []string{"key_material.0.jwk", "key_material.0.pem", "key_material.0.byok_transfer_blob"},
+						},
+
+						"pem": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							StateFunc:    keyVaultKeyMaterialStateFunc,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"key_material.0.jwk", "key_material.0.pem", "key_material.0.byok_transfer_blob"},
+						},
+
+						"byok_transfer_blob": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							StateFunc:    keyVaultKeyMaterialBlobStateFunc,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"key_material.0.jwk", "key_material.0.pem", "key_material.0.byok_transfer_blob"},
+						},
+					},
+				},
+			},
+
 			"not_before_date": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -125,6 +182,54 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 				ValidateFunc: validation.IsRFC3339Time,
 			},
 
+			"rotation_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expire_after": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: azValidate.ISO8601Duration,
+							AtLeastOneOf: []string{"rotation_policy.0.expire_after", "rotation_policy.0.automatic"},
+						},
+
+						"notify_before_expiry": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: azValidate.ISO8601Duration,
+							RequiredWith: []string{"rotation_policy.0.expire_after"},
+						},
+
+						"automatic": {
+							Type:         pluginsdk.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							AtLeastOneOf: []string{"rotation_policy.0.expire_after", "rotation_policy.0.automatic"},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"time_after_creation": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: azValidate.ISO8601Duration,
+										ExactlyOneOf: []string{"rotation_policy.0.automatic.0.time_after_creation", "rotation_policy.0.automatic.0.time_before_expiry"},
+									},
+
+									"time_before_expiry": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: azValidate.ISO8601Duration,
+										ExactlyOneOf: []string{"rotation_policy.0.automatic.0.time_after_creation", "rotation_policy.0.automatic.0.time_before_expiry"},
+										RequiredWith: []string{"rotation_policy.0.expire_after"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			// Computed
 			"version": {
 				Type:     pluginsdk.TypeString,
@@ -156,6 +261,16 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"public_key_pem": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"public_key_openssh": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -164,6 +279,7 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
+	rotationPoliciesClient := meta.(*clients.Client).KeyVault.KeyRotationPoliciesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -194,45 +310,73 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 	keyOptions := expandKeyVaultKeyOptions(d)
 	t := d.Get("tags").(map[string]interface{})
 
-	// TODO: support Importing Keys once this is fixed:
-	// https://github.com/Azure/azure-rest-api-specs/issues/1747
-	parameters := keyvault.KeyCreateParameters{
-		Kty:    keyvault.JSONWebKeyType(keyType),
-		KeyOps: keyOptions,
-		KeyAttributes: &keyvault.KeyAttributes{
-			Enabled: utils.Bool(true),
-		},
-
-		Tags: tags.Expand(t),
-	}
-
-	if parameters.Kty == keyvault.EC || parameters.Kty == keyvault.ECHSM {
-		curveName := d.Get("curve").(string)
-		parameters.Curve = keyvault.JSONWebKeyCurveName(curveName)
-	} else if parameters.Kty == keyvault.RSA || parameters.Kty == keyvault.RSAHSM {
-		keySize, ok := d.GetOk("key_size")
-		if !ok {
-			return fmt.Errorf("Key size is required when creating an RSA key")
-		}
-		parameters.KeySize = utils.Int32(int32(keySize.(int)))
+	attributes := &keyvault.KeyAttributes{
+		Enabled: utils.Bool(true),
 	}
-	// TODO: support `oct` once this is fixed
-	// https://github.com/Azure/azure-rest-api-specs/issues/1739#issuecomment-332236257
 
 	if v, ok := d.GetOk("not_before_date"); ok {
 		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
 		notBeforeUnixTime := date.UnixTime(notBeforeDate)
-		parameters.KeyAttributes.NotBefore = &notBeforeUnixTime
+		attributes.NotBefore = &notBeforeUnixTime
 	}
 
 	if v, ok := d.GetOk("expiration_date"); ok {
 		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
 		expirationUnixTime := date.UnixTime(expirationDate)
-		parameters.KeyAttributes.Expires = &expirationUnixTime
+		attributes.Expires = &expirationUnixTime
+	}
+
+	// when `key_material` is specified the Key is imported, rather than generated by Key Vault
+	var importParameters *keyvault.KeyImportParameters
+	if v, ok := d.GetOk("key_material"); ok {
+		key, hsm, err := expandKeyVaultKeyMaterial(v.([]interface{}), keyvault.JSONWebKeyType(keyType))
+		if err != nil {
+			return fmt.Errorf("expanding `key_material`: %+v", err)
+		}
+		key.KeyOps = flattenKeyVaultKeyOperations(keyOptions)
+
+		importParameters = &keyvault.KeyImportParameters{
+			Hsm:           utils.Bool(hsm),
+			Key:           key,
+			KeyAttributes: attributes,
+			Tags:          tags.Expand(t),
+		}
 	}
 
-	if resp, err := client.CreateKey(ctx, *keyVaultBaseUri, name, parameters); err != nil {
-		if meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeyVaults && utils.ResponseWasConflict(resp.Response) {
+	var resp autorest.Response
+	if importParameters != nil {
+		var result keyvault.KeyBundle
+		result, err = client.ImportKey(ctx, *keyVaultBaseUri, name, *importParameters)
+		resp = result.Response
+	} else {
+		parameters := keyvault.KeyCreateParameters{
+			Kty:           keyvault.JSONWebKeyType(keyType),
+			KeyOps:        keyOptions,
+			KeyAttributes: attributes,
+
+			Tags: tags.Expand(t),
+		}
+
+		if parameters.Kty == keyvault.EC || parameters.Kty == keyvault.ECHSM {
+			curveName := d.Get("curve").(string)
+			parameters.Curve = keyvault.JSONWebKeyCurveName(curveName)
+		} else if parameters.Kty == keyvault.RSA || parameters.Kty == keyvault.RSAHSM {
+			keySize, ok := d.GetOk("key_size")
+			if !ok {
+				return fmt.Errorf("Key size is required when creating an RSA key")
+			}
+			parameters.KeySize = utils.Int32(int32(keySize.(int)))
+		}
+		// TODO: support `oct` once this is fixed
+		// https://github.com/Azure/azure-rest-api-specs/issues/1739#issuecomment-332236257
+
+		var result keyvault.KeyBundle
+		result, err = client.CreateKey(ctx, *keyVaultBaseUri, name, parameters)
+		resp = result.Response
+	}
+
+	if err != nil {
+		if meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeyVaults && utils.ResponseWasConflict(resp) {
 			recoveredKey, err := client.RecoverDeletedKey(ctx, *keyVaultBaseUri, name)
 			if err != nil {
 				return err
@@ -254,11 +398,28 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 				}
 				log.Printf("[DEBUG] Key %q recovered with ID: %q", name, *kid)
 			}
+
+			// the recovered Key contains the previous key material, so this is imported as a new version
+			if importParameters != nil {
+				if _, err := client.ImportKey(ctx, *keyVaultBaseUri, name, *importParameters); err != nil {
+					return fmt.Errorf("Error Importing Key into the recovered Key %q: %+v", name, err)
+				}
+			}
+		} else if importParameters != nil {
+			return fmt.Errorf("Error Importing Key: %+v", err)
 		} else {
 			return fmt.Errorf("Error Creating Key: %+v", err)
 		}
 	}
 
+	if v, ok := d.GetOk("rotation_policy"); ok {
+		rotationPolicyId := keyrotationpolicies.NewKeyID(*keyVaultBaseUri, name)
+		policy := expandKeyVaultKeyRotationPolicy(v.([]interface{}))
+		if _, err := rotationPoliciesClient.Update(ctx, rotationPolicyId, policy); err != nil {
+			return fmt.Errorf("setting the Rotation Policy for %s: %+v", rotationPolicyId, err)
+		}
+	}
+
 	// "" indicates the latest version
 	read, err := client.GetKey(ctx, *keyVaultBaseUri, name, "")
 	if err != nil {
@@ -273,7 +434,7 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
-	resourcesClient := meta.(*clients.Client).Resource
+	rotationPoliciesClient := meta.(*clients.Client).KeyVault.KeyRotationPoliciesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -282,7 +443,7 @@ func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromState(ctx, d.Get("key_vault_id").(string), id.KeyVaultBaseUrl)
 	if err != nil {
 		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
 	}
@@ -332,13 +493,22 @@ func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if d.HasChange("rotation_policy") {
+		// removing the `rotation_policy` block resets the Rotation Policy, since it can't be deleted
+		rotationPolicyId := keyrotationpolicies.NewKeyID(id.KeyVaultBaseUrl, id.Name)
+		policy := expandKeyVaultKeyRotationPolicy(d.Get("rotation_policy").([]interface{}))
+		if _, err := rotationPoliciesClient.Update(ctx, rotationPolicyId, policy); err != nil {
+			return fmt.Errorf("updating the Rotation Policy for %s: %+v", rotationPolicyId, err)
+		}
+	}
+
 	return resourceKeyVaultKeyRead(d, meta)
 }
 
 func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
-	resourcesClient := meta.(*clients.Client).Resource
+	rotationPoliciesClient := meta.(*clients.Client).KeyVault.KeyRotationPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -347,7 +517,11 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	// `key_type` is Required, so it's only unset when this Key is being imported
+	importing := d.Get("key_type").(string) == ""
+	rotationPolicyConfigured := len(d.Get("rotation_policy").([]interface{})) > 0
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromState(ctx, d.Get("key_vault_id").(string), id.KeyVaultBaseUrl)
 	if err != nil {
 		return fmt.Errorf("Error retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
 	}
@@ -405,6 +579,22 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 
 		d.Set("curve", key.Crv)
+
+		// the key material isn't returned from the API, so instead we check the fingerprint of the
+		// imported key material still matches the public key of the current version of this Key
+		if v, ok := d.GetOk("key_material"); ok {
+			if !keyVaultKeyMaterialMatches(v.([]interface{}), *key) {
+				log.Printf("[DEBUG] The `key_material` for Key %q (Key Vault %q) no longer matches the current version - removing from state", id.Name, id.KeyVaultBaseUrl)
+				d.Set("key_material", []interface{}{})
+			}
+		}
+
+		publicKeyPem, publicKeyOpenSSH, err := flattenKeyVaultKeyPublicKey(*key)
+		if err != nil {
+			return fmt.Errorf("encoding the Public Key for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+		d.Set("public_key_pem", publicKeyPem)
+		d.Set("public_key_openssh", publicKeyOpenSSH)
 	}
 
 	if attributes := resp.Attributes; attributes != nil {
@@ -417,6 +607,24 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
+	// the Rotation Policy requires the `GetRotationPolicy` permission, which existing Access Policies are
+	// unlikely to grant - so it's only retrieved when it's configured, or when this Key is being imported
+	if rotationPolicyConfigured || importing {
+		rotationPolicyId := keyrotationpolicies.NewKeyID(id.KeyVaultBaseUrl, id.Name)
+		rotationPolicy, err := rotationPoliciesClient.Get(ctx, rotationPolicyId)
+		if err != nil {
+			if rotationPolicyConfigured {
+				return fmt.Errorf("retrieving the Rotation Policy for %s: %+v", rotationPolicyId, err)
+			}
+
+			log.Printf("[DEBUG] Unable to retrieve the Rotation Policy for %s - skipping: %+v", rotationPolicyId, err)
+		} else {
+			if err := d.Set("rotation_policy", flattenKeyVaultKeyRotationPolicy(rotationPolicy.Model)); err != nil {
+				return fmt.Errorf("setting `rotation_policy`: %+v", err)
+			}
+		}
+	}
+
 	// Computed
 	d.Set("version", id.Version)
 	d.Set("versionless_id", id.VersionlessID())
@@ -427,7 +635,6 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 func resourceKeyVaultKeyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
-	resourcesClient := meta.(*clients.Client).Resource
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -436,7 +643,7 @@ func resourceKeyVaultKeyDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromState(ctx, d.Get("key_vault_id").(string), id.KeyVaultBaseUrl)
 	if err != nil {
 		return fmt.Errorf("Error retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
 	}
@@ -519,3 +726,449 @@ func flattenKeyVaultKeyOptions(input *[]string) []interface{} {
 
 	return results
 }
+
+func expandKeyVaultKeyRotationPolicy(input []interface{}) keyrotationpolicies.KeyRotationPolicy {
+	// an empty Rotation Policy resets the Rotation Policy for this Key
+	lifetimeActions := make([]keyrotationpolicies.LifetimeAction, 0)
+	policy := keyrotationpolicies.KeyRotationPolicy{
+		Attributes:      &keyrotationpolicies.KeyRotationPolicyAttributes{},
+		LifetimeActions: &lifetimeActions,
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return policy
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	if v := raw["expire_after"].(string); v != "" {
+		policy.Attributes.ExpiryTime = utils.String(v)
+	}
+
+	if v := raw["notify_before_expiry"].(string); v != "" {
+		notify := keyrotationpolicies.ActionTypeNotify
+		lifetimeActions = append(lifetimeActions, keyrotationpolicies.LifetimeAction{
+			Action: &keyrotationpolicies.LifetimeActionType{
+				Type: &notify,
+			},
+			Trigger: &keyrotationpolicies.LifetimeActionTrigger{
+				TimeBeforeExpiry: utils.String(v),
+			},
+		})
+	}
+
+	if automatic := raw["automatic"].([]interface{}); len(automatic) > 0 && automatic[0] != nil {
+		automaticRaw := automatic[0].(map[string]interface{})
+
+		trigger := keyrotationpolicies.LifetimeActionTrigger{}
+		if v := automaticRaw["time_after_creation"].(string); v != "" {
+			trigger.TimeAfterCreate = utils.String(v)
+		}
+		if v := automaticRaw["time_before_expiry"].(string); v != "" {
+			trigger.TimeBeforeExpiry = utils.String(v)
+		}
+
+		rotate := keyrotationpolicies.ActionTypeRotate
+		lifetimeActions = append(lifetimeActions, keyrotationpolicies.LifetimeAction{
+			Action: &keyrotationpolicies.LifetimeActionType{
+				Type: &rotate,
+			},
+			Trigger: &trigger,
+		})
+	}
+
+	return policy
+}
+
+func flattenKeyVaultKeyRotationPolicy(input *keyrotationpolicies.KeyRotationPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	expireAfter := ""
+	if input.Attributes != nil && input.Attributes.ExpiryTime != nil {
+		expireAfter = *input.Attributes.ExpiryTime
+	}
+
+	notifyBeforeExpiry := ""
+	automatic := make([]interface{}, 0)
+	if input.LifetimeActions != nil {
+		for _, action := range *input.LifetimeActions {
+			if action.Action == nil || action.Action.Type == nil || action.Trigger == nil {
+				continue
+			}
+
+			timeAfterCreation := ""
+			if action.Trigger.TimeAfterCreate != nil {
+				timeAfterCreation = *action.Trigger.TimeAfterCreate
+			}
+			timeBeforeExpiry := ""
+			if action.Trigger.TimeBeforeExpiry != nil {
+				timeBeforeExpiry = *action.Trigger.TimeBeforeExpiry
+			}
+
+			switch {
+			case strings.EqualFold(string(*action.Action.Type), string(keyrotationpolicies.ActionTypeNotify)):
+				notifyBeforeExpiry = timeBeforeExpiry
+			case strings.EqualFold(string(*action.Action.Type), string(keyrotationpolicies.ActionTypeRotate)):
+				automatic = append(automatic, map[string]interface{}{
+					"time_after_creation": timeAfterCreation,
+					"time_before_expiry":  timeBeforeExpiry,
+				})
+			}
+		}
+	}
+
+	// every Key has a default Rotation Policy which only notifies prior to expiry, however since
+	// this doesn't expire or rotate the Key it's treated the same as no Rotation Policy
+	if expireAfter == "" && len(automatic) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"expire_after":         expireAfter,
+			"notify_before_expiry": notifyBeforeExpiry,
+			"automatic":            automatic,
+		},
+	}
+}
+
+// flattenKeyVaultKeyPublicKey returns the Public Key in both PEM and OpenSSH format - noting that either
+// value will be empty when the Key Type (e.g. `oct`) or Curve (e.g. `P-256K`) isn't supported by that format
+func flattenKeyVaultKeyPublicKey(input keyvault.JSONWebKey) (string, string, error) {
+	var publicKey interface{}
+
+	switch input.Kty {
+	case keyvault.RSA, keyvault.RSAHSM:
+		if input.N == nil || input.E == nil {
+			return "", "", nil
+		}
+
+		nBytes, err := base64.RawURLEncoding.DecodeString(*input.N)
+		if err != nil {
+			return "", "", fmt.Errorf("decoding N: %+v", err)
+		}
+		eBytes, err := base64.RawURLEncoding.DecodeString(*input.E)
+		if err != nil {
+			return "", "", fmt.Errorf("decoding E: %+v", err)
+		}
+
+		publicKey = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(nBytes),
+			E: int(new(big.Int).SetBytes(eBytes).Int64()),
+		}
+
+	case keyvault.EC, keyvault.ECHSM:
+		if input.X == nil || input.Y == nil {
+			return "", "", nil
+		}
+
+		var curve elliptic.Curve
+		switch input.Crv {
+		case keyvault.P256:
+			curve = elliptic.P256()
+		case keyvault.P384:
+			curve = elliptic.P384()
+		case keyvault.P521:
+			curve = elliptic.P521()
+		default:
+			// e.g. P-256K isn't supported by the Go standard library
+			return "", "", nil
+		}
+
+		xBytes, err := base64.RawURLEncoding.DecodeString(*input.X)
+		if err != nil {
+			return "", "", fmt.Errorf("decoding X: %+v", err)
+		}
+		yBytes, err := base64.RawURLEncoding.DecodeString(*input.Y)
+		if err != nil {
+			return "", "", fmt.Errorf("decoding Y: %+v", err)
+		}
+
+		publicKey = &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(xBytes),
+			Y:     new(big.Int).SetBytes(yBytes),
+		}
+
+	default:
+		return "", "", nil
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", "", fmt.Errorf("marshalling the Public Key: %+v", err)
+	}
+	publicKeyPem := string(pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: der,
+	}))
+
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	if err != nil {
+		return "", "", fmt.Errorf("converting the Public Key to OpenSSH format: %+v", err)
+	}
+	publicKeyOpenSSH := string(ssh.MarshalAuthorizedKey(sshPublicKey))
+
+	return publicKeyPem, publicKeyOpenSSH, nil
+}
+
+func flattenKeyVaultKeyOperations(input *[]keyvault.JSONWebKeyOperation) *[]string {
+	results := make([]string, 0)
+	if input == nil {
+		return &results
+	}
+
+	for _, option := range *input {
+		results = append(results, string(option))
+	}
+
+	return &results
+}
+
+// expandKeyVaultKeyMaterial returns the JSON Web Key to import for the `key_material` block, and whether
+// this should be imported into an HSM - which is determined by the (HSM) Key Type
+func expandKeyVaultKeyMaterial(input []interface{}, keyType keyvault.JSONWebKeyType) (*keyvault.JSONWebKey, bool, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, false, fmt.Errorf("`key_material` must be specified")
+	}
+
+	raw := input[0].(map[string]interface{})
+	hsm := keyType == keyvault.RSAHSM || keyType == keyvault.ECHSM
+
+	// a BYOK transfer blob contains the key material encrypted by an HSM, which Key Vault unwraps
+	if v := raw["byok_transfer_blob"].(string); v != "" {
+		if !hsm {
+			return nil, false, fmt.Errorf("a `byok_transfer_blob` can only be imported when `key_type` is %q or %q", string(keyvault.RSAHSM), string(keyvault.ECHSM))
+		}
+
+		return &keyvault.JSONWebKey{
+			Kty: keyType,
+			T:   utils.String(v),
+		}, true, nil
+	}
+
+	key, err := keyVaultKeyFromKeyMaterial(raw)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// the key material is imported as a software key, which is then protected by an HSM when `hsm` is true
+	expectedKeyType := keyType
+	if keyType == keyvault.RSAHSM {
+		expectedKeyType = keyvault.RSA
+	} else if keyType == keyvault.ECHSM {
+		expectedKeyType = keyvault.EC
+	}
+	if key.Kty != expectedKeyType {
+		return nil, false, fmt.Errorf("the key material is an %q key but `key_type` is %q", string(key.Kty), string(keyType))
+	}
+
+	return key, hsm, nil
+}
+
+// keyVaultKeyFromKeyMaterial parses either the `jwk` or `pem` within the `key_material` block into a JSON Web Key
+func keyVaultKeyFromKeyMaterial(raw map[string]interface{}) (*keyvault.JSONWebKey, error) {
+	if v := raw["jwk"].(string); v != "" {
+		var key keyvault.JSONWebKey
+		if err := json.Unmarshal([]byte(v), &key); err != nil {
+			return nil, fmt.Errorf("parsing `jwk`: %+v", err)
+		}
+
+		// the Key Type/Key Operations are determined by the `key_type` and `key_opts` fields
+		switch key.Kty {
+		case keyvault.RSA, keyvault.RSAHSM:
+			key.Kty = keyvault.RSA
+			if key.N == nil || key.E == nil || key.D == nil {
+				return nil, fmt.Errorf("`jwk` must be an RSA private key containing `n`, `e` and `d`")
+			}
+		case keyvault.EC, keyvault.ECHSM:
+			key.Kty = keyvault.EC
+			if key.X == nil || key.Y == nil || key.D == nil {
+				return nil, fmt.Errorf("`jwk` must be an EC private key containing `x`, `y` and `d`")
+			}
+		default:
+			return nil, fmt.Errorf("`jwk` contains the unsupported Key Type %q", string(key.Kty))
+		}
+		key.Kid = nil
+		key.KeyOps = nil
+
+		return &key, nil
+	}
+
+	// other PEM blocks (e.g. Certificates) are skipped, so that bundles can be used
+	var block *pem.Block
+	rest := []byte(raw["pem"].(string))
+	for {
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("`pem` doesn't contain a PEM encoded private key")
+		}
+		if strings.HasSuffix(block.Type, "PRIVATE KEY") {
+			break
+		}
+	}
+
+	var privateKey interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		privateKey, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("`pem` contains the unsupported PEM block type %q", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing `pem`: %+v", err)
+	}
+
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		if len(key.Primes) != 2 {
+			return nil, fmt.Errorf("`pem` contains a multi-prime RSA private key which isn't supported")
+		}
+		key.Precompute()
+
+		return &keyvault.JSONWebKey{
+			Kty: keyvault.RSA,
+			N:   keyVaultKeyEncodeBigInt(key.N, 0),
+			E:   keyVaultKeyEncodeBigInt(big.NewInt(int64(key.E)), 0),
+			D:   keyVaultKeyEncodeBigInt(key.D, 0),
+			P:   keyVaultKeyEncodeBigInt(key.Primes[0], 0),
+			Q:   keyVaultKeyEncodeBigInt(key.Primes[1], 0),
+			DP:  keyVaultKeyEncodeBigInt(key.Precomputed.Dp, 0),
+			DQ:  keyVaultKeyEncodeBigInt(key.Precomputed.Dq, 0),
+			QI:  keyVaultKeyEncodeBigInt(key.Precomputed.Qinv, 0),
+		}, nil
+
+	case *ecdsa.PrivateKey:
+		var curve keyvault.JSONWebKeyCurveName
+		switch key.Curve {
+		case elliptic.P256():
+			curve = keyvault.P256
+		case elliptic.P384():
+			curve = keyvault.P384
+		case elliptic.P521():
+			curve = keyvault.P521
+		default:
+			return nil, fmt.Errorf("`pem` contains an EC private key using the unsupported curve %q", key.Curve.Params().Name)
+		}
+
+		// the EC components are padded to the size of the curve, as required by RFC 7518
+		size := (key.Curve.Params().BitSize + 7) / 8
+		return &keyvault.JSONWebKey{
+			Kty: keyvault.EC,
+			Crv: curve,
+			X:   keyVaultKeyEncodeBigInt(key.X, size),
+			Y:   keyVaultKeyEncodeBigInt(key.Y, size),
+			D:   keyVaultKeyEncodeBigInt(key.D, size),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("`pem` contains an unsupported private key type %T", privateKey)
+}
+
+// keyVaultKeyMaterialMatches determines whether the fingerprint of the `jwk` or `pem` within the `key_material`
+// matches the public key of the specified JSON Web Key - a BYOK transfer blob can't be decrypted and as such is
+// assumed to match
+func keyVaultKeyMaterialMatches(input []interface{}, remote keyvault.JSONWebKey) bool {
+	if len(input) == 0 || input[0] == nil {
+		return true
+	}
+
+	raw := input[0].(map[string]interface{})
+	for _, field := range []string{"jwk", "pem"} {
+		// during apply this is the key material, otherwise this is the fingerprint from the state
+		if v := raw[field].(string); v != "" {
+			return keyVaultKeyMaterialStateFunc(v) == keyVaultKeyPublicKeyFingerprint(remote)
+		}
+	}
+
+	return true
+}
+
+// keyVaultKeyFingerprintRegex matches a fingerprint returned from keyVaultKeyPublicKeyFingerprint
+var keyVaultKeyFingerprintRegex = regexp.MustCompile("^[0-9a-f]{64}$")
+
+// keyVaultKeyMaterialStateFunc returns the fingerprint of the public key within the `jwk` or `pem`, which is stored
+// in the state rather than the private key - and allows Read to check this still matches the current version of the Key
+func keyVaultKeyMaterialStateFunc(input interface{}) string {
+	v, ok := input.(string)
+	if !ok || v == "" {
+		return ""
+	}
+
+	// this is already a fingerprint (e.g. from the state)
+	if keyVaultKeyFingerprintRegex.MatchString(v) {
+		return v
+	}
+
+	raw := map[string]interface{}{
+		"jwk": "",
+		"pem": v,
+	}
+	if strings.HasPrefix(strings.TrimSpace(v), "{") {
+		raw["jwk"] = v
+		raw["pem"] = ""
+	}
+
+	key, err := keyVaultKeyFromKeyMaterial(raw)
+	if err != nil {
+		// this is caught during Create, but the key material still mustn't be stored in the state
+		return keyVaultKeyMaterialBlobStateFunc(v)
+	}
+
+	return keyVaultKeyPublicKeyFingerprint(*key)
+}
+
+// keyVaultKeyMaterialBlobStateFunc returns a hash of the `byok_transfer_blob`, which is stored in the state rather
+// than the (encrypted) key material
+func keyVaultKeyMaterialBlobStateFunc(input interface{}) string {
+	v, ok := input.(string)
+	if !ok || v == "" {
+		return ""
+	}
+
+	hash := sha256.Sum256([]byte(v))
+	return hex.EncodeToString(hash[:])
+}
+
+// keyVaultKeyPublicKeyFingerprint returns a SHA256 fingerprint of the public components of the JSON Web Key, which
+// are compared numerically since leading zeros may or may not be included
+func keyVaultKeyPublicKeyFingerprint(key keyvault.JSONWebKey) string {
+	components := []*string{key.X, key.Y}
+	if key.N != nil {
+		components = []*string{key.N, key.E}
+	}
+
+	values := make([]string, 0)
+	for _, component := range components {
+		if component == nil {
+			return ""
+		}
+
+		data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(*component, "="))
+		if err != nil {
+			return ""
+		}
+		values = append(values, new(big.Int).SetBytes(data).Text(16))
+	}
+
+	hash := sha256.Sum256([]byte(strings.Join(values, ".")))
+	return hex.EncodeToString(hash[:])
+}
+
+func keyVaultKeyEncodeBigInt(input *big.Int, size int) *string {
+	data := input.Bytes()
+	if len(data) < size {
+		padded := make([]byte, size)
+		copy(padded[size-len(data):], data)
+		data = padded
+	}
+
+	return utils.String(base64.RawURLEncoding.EncodeToString(data))
+}
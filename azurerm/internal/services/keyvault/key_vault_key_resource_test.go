@@ -3,6 +3,7 @@ package keyvault_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -28,6 +29,8 @@ func TestAccKeyVaultKey_basicEC(t *testing.T) {
 			Config: r.basicEC(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("public_key_pem").Exists(),
+				check.That(data.ResourceName).Key("public_key_openssh").Exists(),
 			),
 		},
 		data.ImportStep("key_size"),
@@ -105,6 +108,115 @@ func TestAccKeyVaultKey_basicRSA(t *testing.T) {
 			Config: r.basicRSA(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("public_key_pem").Exists(),
+				check.That(data.ResourceName).Key("public_key_openssh").Exists(),
+			),
+		},
+		data.ImportStep("key_size"),
+	})
+}
+
+func TestAccKeyVaultKey_importPEMRSA(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.importPEM(data, "RSA", "rsa_single.pem"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_size").HasValue("2048"),
+				// only a fingerprint of the key material is stored in the state
+				check.That(data.ResourceName).Key("key_material.0.pem").MatchesRegex(regexp.MustCompile("^[0-9a-f]{64}$")),
+			),
+		},
+		data.ImportStep("key_material"),
+	})
+}
+
+func TestAccKeyVaultKey_importPEMEC(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.importPEM(data, "EC", "ecdsa.pem"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("curve").HasValue("P-256"),
+			),
+		},
+		data.ImportStep("key_material", "key_size"),
+	})
+}
+
+func TestAccKeyVaultKey_importJWK(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.importJWK(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_material.0.jwk").MatchesRegex(regexp.MustCompile("^[0-9a-f]{64}$")),
+			),
+		},
+		data.ImportStep("key_material"),
+	})
+}
+
+func TestAccKeyVaultKey_importedKeyMaterialUpdatedExternally(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.importPEM(data, "RSA", "rsa_single.pem"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.rotateKey),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.importPEM(data, "RSA", "rsa_single.pem"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccKeyVaultKey_rotationPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotationPolicy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.0.expire_after").HasValue("P90D"),
+				check.That(data.ResourceName).Key("rotation_policy.0.notify_before_expiry").HasValue("P29D"),
+				check.That(data.ResourceName).Key("rotation_policy.0.automatic.0.time_before_expiry").HasValue("P30D"),
+			),
+		},
+		data.ImportStep("key_size"),
+		{
+			Config: r.rotationPolicyUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.0.expire_after").HasValue(""),
+				check.That(data.ResourceName).Key("rotation_policy.0.automatic.0.time_after_creation").HasValue("P60D"),
+			),
+		},
+		data.ImportStep("key_size"),
+		{
+			Config: r.basicRSA(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.#").HasValue("0"),
 			),
 		},
 		data.ImportStep("key_size"),
@@ -306,7 +418,7 @@ func (r KeyVaultKeyResource) Exists(ctx context.Context, clients *clients.Client
 		return nil, err
 	}
 
-	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, clients.Resource, id.KeyVaultBaseUrl)
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, id.KeyVaultBaseUrl)
 	if err != nil || keyVaultIdRaw == nil {
 		return nil, fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
 	}
@@ -372,6 +484,30 @@ func (KeyVaultKeyResource) updateExpiryDate(expiryDate string) acceptance.Client
 	}
 }
 
+func (KeyVaultKeyResource) rotateKey(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	name := state.Attributes["name"]
+	keyVaultId, err := parse.VaultID(state.Attributes["key_vault_id"])
+	if err != nil {
+		return err
+	}
+
+	vaultBaseUrl, err := clients.KeyVault.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("looking up base uri for Key %q from %q: %+v", name, keyVaultId, err)
+	}
+
+	// creating a Key with the same name generates a new version using different key material
+	parameters := keyvault.KeyCreateParameters{
+		Kty:     keyvault.RSA,
+		KeySize: utils.Int32(2048),
+	}
+	if _, err = clients.KeyVault.ManagementClient.CreateKey(ctx, *vaultBaseUrl, name, parameters); err != nil {
+		return fmt.Errorf("creating a new version of Key %q: %+v", name, err)
+	}
+
+	return nil
+}
+
 func (KeyVaultKeyResource) Destroy(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	name := state.Attributes["name"]
 	keyVaultId, err := parse.VaultID(state.Attributes["key_vault_id"])
@@ -567,6 +703,123 @@ resource "azurerm_key_vault_key" "test" {
 `, r.templateStandard(data), data.RandomString)
 }
 
+func (r KeyVaultKeyResource) importPEM(data acceptance.TestData, keyType string, fileName string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "%s"
+
+  key_opts = [
+    "sign",
+    "verify",
+  ]
+
+  key_material {
+    pem = file("testdata/%s")
+  }
+}
+`, r.templateStandard(data), data.RandomString, keyType, fileName)
+}
+
+func (r KeyVaultKeyResource) importJWK(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA-HSM"
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+  ]
+
+  key_material {
+    jwk = file("testdata/rsa.jwk.json")
+  }
+}
+`, r.templatePremium(data), data.RandomString)
+}
+
+func (r KeyVaultKeyResource) rotationPolicy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+
+  rotation_policy {
+    expire_after         = "P90D"
+    notify_before_expiry = "P29D"
+
+    automatic {
+      time_before_expiry = "P30D"
+    }
+  }
+}
+`, r.templateStandard(data), data.RandomString)
+}
+
+func (r KeyVaultKeyResource) rotationPolicyUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+
+  rotation_policy {
+    automatic {
+      time_after_creation = "P60D"
+    }
+  }
+}
+`, r.templateStandard(data), data.RandomString)
+}
+
 func (r KeyVaultKeyResource) curveEC(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -832,8 +1085,11 @@ resource "azurerm_key_vault" "test" {
       "Create",
       "Delete",
       "Get",
+      "GetRotationPolicy",
+      "Import",
       "Purge",
       "Recover",
+      "SetRotationPolicy",
       "Update",
     ]
 
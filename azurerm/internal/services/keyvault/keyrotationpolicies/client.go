@@ -0,0 +1,111 @@
+// Package keyrotationpolicies is a hand-written Data Plane client for the Rotation Policy of a Key
+// within a Key Vault. The vendored Key Vault Data Plane SDK (API Version 7.1) doesn't support Rotation
+// Policies, which were introduced in API Version 7.3 - once the SDK is updated this can be removed.
+package keyrotationpolicies
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+const apiVersion = "7.3"
+
+// KeyRotationPoliciesClient is a Data Plane client for the Key Rotation Policies within a Key Vault,
+// where the Base URI for the Key Vault is specified for each request
+type KeyRotationPoliciesClient struct {
+	Client autorest.Client
+}
+
+// NewKeyRotationPoliciesClient returns a KeyRotationPoliciesClient which uses the default autorest
+// User Agent, to which the Provider's User Agent is appended when the client is configured
+func NewKeyRotationPoliciesClient() KeyRotationPoliciesClient {
+	return KeyRotationPoliciesClient{
+		Client: autorest.NewClientWithUserAgent(""),
+	}
+}
+
+type GetResponse struct {
+	HttpResponse *http.Response
+	Model        *KeyRotationPolicy
+}
+
+// Get retrieves the Rotation Policy for the specified Key, which requires the `GetRotationPolicy` permission.
+// Every Key has a Rotation Policy, which by default only notifies 30 days prior to the Key expiring.
+func (c KeyRotationPoliciesClient) Get(ctx context.Context, id KeyId) (result GetResponse, err error) {
+	req, err := c.preparer(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyrotationpolicies.KeyRotationPoliciesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyrotationpolicies.KeyRotationPoliciesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Model, err = c.responder(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyrotationpolicies.KeyRotationPoliciesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+type UpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *KeyRotationPolicy
+}
+
+// Update replaces the Rotation Policy for the specified Key, which requires the `SetRotationPolicy` permission.
+// Since a Rotation Policy can't be deleted, an empty Rotation Policy is used to reset it.
+func (c KeyRotationPoliciesClient) Update(ctx context.Context, id KeyId, input KeyRotationPolicy) (result UpdateResponse, err error) {
+	req, err := c.preparer(ctx, id, autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyrotationpolicies.KeyRotationPoliciesClient", "Update", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyrotationpolicies.KeyRotationPoliciesClient", "Update", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Model, err = c.responder(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyrotationpolicies.KeyRotationPoliciesClient", "Update", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparer prepares a request against the Rotation Policy endpoint for the specified Key
+func (c KeyRotationPoliciesClient) preparer(ctx context.Context, id KeyId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": apiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(id.ID()),
+		autorest.WithPath("/rotationpolicy"),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responder unmarshals the Rotation Policy returned from the API. The method always closes the http.Response Body.
+func (c KeyRotationPoliciesClient) responder(resp *http.Response) (result *KeyRotationPolicy, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	return
+}
@@ -0,0 +1,29 @@
+package keyrotationpolicies
+
+import (
+	"fmt"
+	"strings"
+)
+
+// KeyId is the Data Plane ID of a Key within a Key Vault
+type KeyId struct {
+	KeyVaultBaseUrl string
+	KeyName         string
+}
+
+func NewKeyID(keyVaultBaseUrl, keyName string) KeyId {
+	return KeyId{
+		KeyVaultBaseUrl: keyVaultBaseUrl,
+		KeyName:         keyName,
+	}
+}
+
+// ID returns the Data Plane URI for this Key
+func (id KeyId) ID() string {
+	return fmt.Sprintf("%s/keys/%s", strings.TrimSuffix(id.KeyVaultBaseUrl, "/"), id.KeyName)
+}
+
+// String returns a human readable description of this Key
+func (id KeyId) String() string {
+	return fmt.Sprintf("Key %q (Key Vault %q)", id.KeyName, id.KeyVaultBaseUrl)
+}
@@ -0,0 +1,40 @@
+package keyrotationpolicies
+
+type ActionType string
+
+const (
+	ActionTypeNotify ActionType = "Notify"
+	ActionTypeRotate ActionType = "Rotate"
+)
+
+// KeyRotationPolicy defines when a Key should be rotated and/or when a notification should be sent
+type KeyRotationPolicy struct {
+	Attributes      *KeyRotationPolicyAttributes `json:"attributes,omitempty"`
+	ID              *string                      `json:"id,omitempty"`
+	LifetimeActions *[]LifetimeAction            `json:"lifetimeActions,omitempty"`
+}
+
+type KeyRotationPolicyAttributes struct {
+	Created *int64 `json:"created,omitempty"`
+
+	// ExpiryTime is the ISO 8601 Duration after which newly rotated versions of the Key expire
+	ExpiryTime *string `json:"expiryTime,omitempty"`
+
+	Updated *int64 `json:"updated,omitempty"`
+}
+
+// LifetimeAction is an Action which is performed when the Trigger is met
+type LifetimeAction struct {
+	Action  *LifetimeActionType    `json:"action,omitempty"`
+	Trigger *LifetimeActionTrigger `json:"trigger,omitempty"`
+}
+
+// LifetimeActionTrigger specifies either an ISO 8601 Duration after the Key was created, or before the Key expires
+type LifetimeActionTrigger struct {
+	TimeAfterCreate  *string `json:"timeAfterCreate,omitempty"`
+	TimeBeforeExpiry *string `json:"timeBeforeExpiry,omitempty"`
+}
+
+type LifetimeActionType struct {
+	Type *ActionType `json:"type,omitempty"`
+}
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AttachedDatabaseConfigurationId struct {
@@ -40,35 +40,34 @@ func (id AttachedDatabaseConfigurationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a AttachedDatabaseConfiguration ID
+func (id AttachedDatabaseConfigurationId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Kusto"),
+		resourceid.StaticSegment("Clusters"),
+		resourceid.UserSpecifiedSegment("ClusterName"),
+		resourceid.StaticSegment("AttachedDatabaseConfigurations"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // AttachedDatabaseConfigurationID parses a AttachedDatabaseConfiguration ID into an AttachedDatabaseConfigurationId struct
 func AttachedDatabaseConfigurationID(input string) (*AttachedDatabaseConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(AttachedDatabaseConfigurationId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := AttachedDatabaseConfigurationId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("AttachedDatabaseConfigurations"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		ClusterName:    id.Parsed["ClusterName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
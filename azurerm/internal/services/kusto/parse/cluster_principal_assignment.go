@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ClusterPrincipalAssignmentId struct {
@@ -40,35 +40,34 @@ func (id ClusterPrincipalAssignmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.PrincipalAssignmentName)
 }
 
+// Segments returns the ordered list of Segments which make up a ClusterPrincipalAssignment ID
+func (id ClusterPrincipalAssignmentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Kusto"),
+		resourceid.StaticSegment("Clusters"),
+		resourceid.UserSpecifiedSegment("ClusterName"),
+		resourceid.StaticSegment("PrincipalAssignments"),
+		resourceid.UserSpecifiedSegment("PrincipalAssignmentName"),
+	}
+}
+
 // ClusterPrincipalAssignmentID parses a ClusterPrincipalAssignment ID into an ClusterPrincipalAssignmentId struct
 func ClusterPrincipalAssignmentID(input string) (*ClusterPrincipalAssignmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ClusterPrincipalAssignmentId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ClusterPrincipalAssignmentId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
-		return nil, err
-	}
-	if resourceId.PrincipalAssignmentName, err = id.PopSegment("PrincipalAssignments"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:          id.Parsed["SubscriptionId"],
+		ResourceGroup:           id.Parsed["ResourceGroup"],
+		ClusterName:             id.Parsed["ClusterName"],
+		PrincipalAssignmentName: id.Parsed["PrincipalAssignmentName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DataConnectionId struct {
@@ -43,38 +43,37 @@ func (id DataConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a DataConnection ID
+func (id DataConnectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Kusto"),
+		resourceid.StaticSegment("Clusters"),
+		resourceid.UserSpecifiedSegment("ClusterName"),
+		resourceid.StaticSegment("Databases"),
+		resourceid.UserSpecifiedSegment("DatabaseName"),
+		resourceid.StaticSegment("DataConnections"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // DataConnectionID parses a DataConnection ID into an DataConnectionId struct
 func DataConnectionID(input string) (*DataConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(DataConnectionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DataConnectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
-		return nil, err
-	}
-	if resourceId.DatabaseName, err = id.PopSegment("Databases"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("DataConnections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		ClusterName:    id.Parsed["ClusterName"],
+		DatabaseName:   id.Parsed["DatabaseName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
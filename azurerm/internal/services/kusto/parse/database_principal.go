@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabasePrincipalId struct {
@@ -46,41 +46,40 @@ func (id DatabasePrincipalId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.RoleName, id.FQNName)
 }
 
+// Segments returns the ordered list of Segments which make up a DatabasePrincipal ID
+func (id DatabasePrincipalId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Kusto"),
+		resourceid.StaticSegment("Clusters"),
+		resourceid.UserSpecifiedSegment("ClusterName"),
+		resourceid.StaticSegment("Databases"),
+		resourceid.UserSpecifiedSegment("DatabaseName"),
+		resourceid.StaticSegment("Role"),
+		resourceid.UserSpecifiedSegment("RoleName"),
+		resourceid.StaticSegment("FQN"),
+		resourceid.UserSpecifiedSegment("FQNName"),
+	}
+}
+
 // DatabasePrincipalID parses a DatabasePrincipal ID into an DatabasePrincipalId struct
 func DatabasePrincipalID(input string) (*DatabasePrincipalId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(DatabasePrincipalId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DatabasePrincipalId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
-		return nil, err
-	}
-	if resourceId.DatabaseName, err = id.PopSegment("Databases"); err != nil {
-		return nil, err
-	}
-	if resourceId.RoleName, err = id.PopSegment("Role"); err != nil {
-		return nil, err
-	}
-	if resourceId.FQNName, err = id.PopSegment("FQN"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		ClusterName:    id.Parsed["ClusterName"],
+		DatabaseName:   id.Parsed["DatabaseName"],
+		RoleName:       id.Parsed["RoleName"],
+		FQNName:        id.Parsed["FQNName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabasePrincipalAssignmentId struct {
@@ -43,38 +43,37 @@ func (id DatabasePrincipalAssignmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.PrincipalAssignmentName)
 }
 
+// Segments returns the ordered list of Segments which make up a DatabasePrincipalAssignment ID
+func (id DatabasePrincipalAssignmentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Kusto"),
+		resourceid.StaticSegment("Clusters"),
+		resourceid.UserSpecifiedSegment("ClusterName"),
+		resourceid.StaticSegment("Databases"),
+		resourceid.UserSpecifiedSegment("DatabaseName"),
+		resourceid.StaticSegment("PrincipalAssignments"),
+		resourceid.UserSpecifiedSegment("PrincipalAssignmentName"),
+	}
+}
+
 // DatabasePrincipalAssignmentID parses a DatabasePrincipalAssignment ID into an DatabasePrincipalAssignmentId struct
 func DatabasePrincipalAssignmentID(input string) (*DatabasePrincipalAssignmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(DatabasePrincipalAssignmentId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DatabasePrincipalAssignmentId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
-		return nil, err
-	}
-	if resourceId.DatabaseName, err = id.PopSegment("Databases"); err != nil {
-		return nil, err
-	}
-	if resourceId.PrincipalAssignmentName, err = id.PopSegment("PrincipalAssignments"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:          id.Parsed["SubscriptionId"],
+		ResourceGroup:           id.Parsed["ResourceGroup"],
+		ClusterName:             id.Parsed["ClusterName"],
+		DatabaseName:            id.Parsed["DatabaseName"],
+		PrincipalAssignmentName: id.Parsed["PrincipalAssignmentName"],
 	}
 
 	return &resourceId, nil
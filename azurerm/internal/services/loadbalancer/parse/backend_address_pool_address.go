@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BackendAddressPoolAddressId struct {
@@ -43,38 +43,37 @@ func (id BackendAddressPoolAddressId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName, id.AddressName)
 }
 
+// Segments returns the ordered list of Segments which make up a BackendAddressPoolAddress ID
+func (id BackendAddressPoolAddressId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("loadBalancers"),
+		resourceid.UserSpecifiedSegment("LoadBalancerName"),
+		resourceid.StaticSegment("backendAddressPools"),
+		resourceid.UserSpecifiedSegment("BackendAddressPoolName"),
+		resourceid.StaticSegment("addresses"),
+		resourceid.UserSpecifiedSegment("AddressName"),
+	}
+}
+
 // BackendAddressPoolAddressID parses a BackendAddressPoolAddress ID into an BackendAddressPoolAddressId struct
 func BackendAddressPoolAddressID(input string) (*BackendAddressPoolAddressId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(BackendAddressPoolAddressId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := BackendAddressPoolAddressId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
-		return nil, err
-	}
-	if resourceId.BackendAddressPoolName, err = id.PopSegment("backendAddressPools"); err != nil {
-		return nil, err
-	}
-	if resourceId.AddressName, err = id.PopSegment("addresses"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         id.Parsed["SubscriptionId"],
+		ResourceGroup:          id.Parsed["ResourceGroup"],
+		LoadBalancerName:       id.Parsed["LoadBalancerName"],
+		BackendAddressPoolName: id.Parsed["BackendAddressPoolName"],
+		AddressName:            id.Parsed["AddressName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerBackendAddressPoolId struct {
@@ -40,35 +40,34 @@ func (id LoadBalancerBackendAddressPoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
 }
 
+// Segments returns the ordered list of Segments which make up a LoadBalancerBackendAddressPool ID
+func (id LoadBalancerBackendAddressPoolId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("loadBalancers"),
+		resourceid.UserSpecifiedSegment("LoadBalancerName"),
+		resourceid.StaticSegment("backendAddressPools"),
+		resourceid.UserSpecifiedSegment("BackendAddressPoolName"),
+	}
+}
+
 // LoadBalancerBackendAddressPoolID parses a LoadBalancerBackendAddressPool ID into an LoadBalancerBackendAddressPoolId struct
 func LoadBalancerBackendAddressPoolID(input string) (*LoadBalancerBackendAddressPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(LoadBalancerBackendAddressPoolId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LoadBalancerBackendAddressPoolId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
-		return nil, err
-	}
-	if resourceId.BackendAddressPoolName, err = id.PopSegment("backendAddressPools"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         id.Parsed["SubscriptionId"],
+		ResourceGroup:          id.Parsed["ResourceGroup"],
+		LoadBalancerName:       id.Parsed["LoadBalancerName"],
+		BackendAddressPoolName: id.Parsed["BackendAddressPoolName"],
 	}
 
 	return &resourceId, nil
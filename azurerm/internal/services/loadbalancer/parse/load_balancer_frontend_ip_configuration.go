@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerFrontendIpConfigurationId struct {
@@ -40,35 +40,34 @@ func (id LoadBalancerFrontendIpConfigurationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.FrontendIPConfigurationName)
 }
 
+// Segments returns the ordered list of Segments which make up a LoadBalancerFrontendIpConfiguration ID
+func (id LoadBalancerFrontendIpConfigurationId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("loadBalancers"),
+		resourceid.UserSpecifiedSegment("LoadBalancerName"),
+		resourceid.StaticSegment("frontendIPConfigurations"),
+		resourceid.UserSpecifiedSegment("FrontendIPConfigurationName"),
+	}
+}
+
 // LoadBalancerFrontendIpConfigurationID parses a LoadBalancerFrontendIpConfiguration ID into an LoadBalancerFrontendIpConfigurationId struct
 func LoadBalancerFrontendIpConfigurationID(input string) (*LoadBalancerFrontendIpConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(LoadBalancerFrontendIpConfigurationId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LoadBalancerFrontendIpConfigurationId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
-		return nil, err
-	}
-	if resourceId.FrontendIPConfigurationName, err = id.PopSegment("frontendIPConfigurations"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:              id.Parsed["SubscriptionId"],
+		ResourceGroup:               id.Parsed["ResourceGroup"],
+		LoadBalancerName:            id.Parsed["LoadBalancerName"],
+		FrontendIPConfigurationName: id.Parsed["FrontendIPConfigurationName"],
 	}
 
 	return &resourceId, nil
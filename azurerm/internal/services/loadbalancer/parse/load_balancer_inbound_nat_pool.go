@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerInboundNatPoolId struct {
@@ -40,35 +40,34 @@ func (id LoadBalancerInboundNatPoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.InboundNatPoolName)
 }
 
+// Segments returns the ordered list of Segments which make up a LoadBalancerInboundNatPool ID
+func (id LoadBalancerInboundNatPoolId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("loadBalancers"),
+		resourceid.UserSpecifiedSegment("LoadBalancerName"),
+		resourceid.StaticSegment("inboundNatPools"),
+		resourceid.UserSpecifiedSegment("InboundNatPoolName"),
+	}
+}
+
 // LoadBalancerInboundNatPoolID parses a LoadBalancerInboundNatPool ID into an LoadBalancerInboundNatPoolId struct
 func LoadBalancerInboundNatPoolID(input string) (*LoadBalancerInboundNatPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(LoadBalancerInboundNatPoolId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LoadBalancerInboundNatPoolId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
-		return nil, err
-	}
-	if resourceId.InboundNatPoolName, err = id.PopSegment("inboundNatPools"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     id.Parsed["SubscriptionId"],
+		ResourceGroup:      id.Parsed["ResourceGroup"],
+		LoadBalancerName:   id.Parsed["LoadBalancerName"],
+		InboundNatPoolName: id.Parsed["InboundNatPoolName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsDataSourceWindowsEventId struct {
@@ -40,35 +40,34 @@ func (id LogAnalyticsDataSourceWindowsEventId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.DataSourceName)
 }
 
+// Segments returns the ordered list of Segments which make up a LogAnalyticsDataSourceWindowsEvent ID
+func (id LogAnalyticsDataSourceWindowsEventId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.OperationalInsights"),
+		resourceid.StaticSegment("workspaces"),
+		resourceid.UserSpecifiedSegment("WorkspaceName"),
+		resourceid.StaticSegment("dataSources"),
+		resourceid.UserSpecifiedSegment("DataSourceName"),
+	}
+}
+
 // LogAnalyticsDataSourceWindowsEventID parses a LogAnalyticsDataSourceWindowsEvent ID into an LogAnalyticsDataSourceWindowsEventId struct
 func LogAnalyticsDataSourceWindowsEventID(input string) (*LogAnalyticsDataSourceWindowsEventId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(LogAnalyticsDataSourceWindowsEventId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LogAnalyticsDataSourceWindowsEventId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.DataSourceName, err = id.PopSegment("dataSources"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		WorkspaceName:  id.Parsed["WorkspaceName"],
+		DataSourceName: id.Parsed["DataSourceName"],
 	}
 
 	return &resourceId, nil
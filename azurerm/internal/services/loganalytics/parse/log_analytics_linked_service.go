@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsLinkedServiceId struct {
@@ -40,35 +40,34 @@ func (id LogAnalyticsLinkedServiceId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.LinkedServiceName)
 }
 
+// Segments returns the ordered list of Segments which make up a LogAnalyticsLinkedService ID
+func (id LogAnalyticsLinkedServiceId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.OperationalInsights"),
+		resourceid.StaticSegment("workspaces"),
+		resourceid.UserSpecifiedSegment("WorkspaceName"),
+		resourceid.StaticSegment("linkedServices"),
+		resourceid.UserSpecifiedSegment("LinkedServiceName"),
+	}
+}
+
 // LogAnalyticsLinkedServiceID parses a LogAnalyticsLinkedService ID into an LogAnalyticsLinkedServiceId struct
 func LogAnalyticsLinkedServiceID(input string) (*LogAnalyticsLinkedServiceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(LogAnalyticsLinkedServiceId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LogAnalyticsLinkedServiceId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.LinkedServiceName, err = id.PopSegment("linkedServices"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:    id.Parsed["SubscriptionId"],
+		ResourceGroup:     id.Parsed["ResourceGroup"],
+		WorkspaceName:     id.Parsed["WorkspaceName"],
+		LinkedServiceName: id.Parsed["LinkedServiceName"],
 	}
 
 	return &resourceId, nil
@@ -18,6 +18,15 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+// ResourceProviderNamespace returns the Resource Provider Namespace used by the specified Resource Type
+func (r Registration) ResourceProviderNamespace(resourceType string) string {
+	if resourceType == "azurerm_log_analytics_solution" {
+		return "Microsoft.OperationsManagement"
+	}
+
+	return "Microsoft.OperationalInsights"
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
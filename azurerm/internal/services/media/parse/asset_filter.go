@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AssetFilterId struct {
@@ -43,38 +43,37 @@ func (id AssetFilterId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.MediaserviceName, id.AssetName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a AssetFilter ID
+func (id AssetFilterId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Media"),
+		resourceid.StaticSegment("mediaservices"),
+		resourceid.UserSpecifiedSegment("MediaserviceName"),
+		resourceid.StaticSegment("assets"),
+		resourceid.UserSpecifiedSegment("AssetName"),
+		resourceid.StaticSegment("assetFilters"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // AssetFilterID parses a AssetFilter ID into an AssetFilterId struct
 func AssetFilterID(input string) (*AssetFilterId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(AssetFilterId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := AssetFilterId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.MediaserviceName, err = id.PopSegment("mediaservices"); err != nil {
-		return nil, err
-	}
-	if resourceId.AssetName, err = id.PopSegment("assets"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("assetFilters"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   id.Parsed["SubscriptionId"],
+		ResourceGroup:    id.Parsed["ResourceGroup"],
+		MediaserviceName: id.Parsed["MediaserviceName"],
+		AssetName:        id.Parsed["AssetName"],
+		Name:             id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
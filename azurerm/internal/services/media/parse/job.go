@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type JobId struct {
@@ -43,38 +43,37 @@ func (id JobId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.MediaserviceName, id.TransformName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a Job ID
+func (id JobId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Media"),
+		resourceid.StaticSegment("mediaservices"),
+		resourceid.UserSpecifiedSegment("MediaserviceName"),
+		resourceid.StaticSegment("transforms"),
+		resourceid.UserSpecifiedSegment("TransformName"),
+		resourceid.StaticSegment("jobs"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // JobID parses a Job ID into an JobId struct
 func JobID(input string) (*JobId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(JobId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := JobId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.MediaserviceName, err = id.PopSegment("mediaservices"); err != nil {
-		return nil, err
-	}
-	if resourceId.TransformName, err = id.PopSegment("transforms"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("jobs"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   id.Parsed["SubscriptionId"],
+		ResourceGroup:    id.Parsed["ResourceGroup"],
+		MediaserviceName: id.Parsed["MediaserviceName"],
+		TransformName:    id.Parsed["TransformName"],
+		Name:             id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
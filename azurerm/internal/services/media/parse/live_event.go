@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LiveEventId struct {
@@ -40,35 +40,34 @@ func (id LiveEventId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.MediaserviceName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a LiveEvent ID
+func (id LiveEventId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Media"),
+		resourceid.StaticSegment("mediaservices"),
+		resourceid.UserSpecifiedSegment("MediaserviceName"),
+		resourceid.StaticSegment("liveevents"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // LiveEventID parses a LiveEvent ID into an LiveEventId struct
 func LiveEventID(input string) (*LiveEventId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(LiveEventId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LiveEventId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.MediaserviceName, err = id.PopSegment("mediaservices"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("liveevents"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   id.Parsed["SubscriptionId"],
+		ResourceGroup:    id.Parsed["ResourceGroup"],
+		MediaserviceName: id.Parsed["MediaserviceName"],
+		Name:             id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
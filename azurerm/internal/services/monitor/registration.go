@@ -1,6 +1,8 @@
 package monitor
 
 import (
+	"strings"
+
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 )
 
@@ -18,6 +20,18 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+// ResourceProviderNamespace returns the Resource Provider Namespace used by the specified Resource Type
+func (r Registration) ResourceProviderNamespace(resourceType string) string {
+	switch {
+	case resourceType == "azurerm_monitor_aad_diagnostic_setting":
+		return "microsoft.aadiam"
+	case strings.HasPrefix(resourceType, "azurerm_monitor_action_rule_"), resourceType == "azurerm_monitor_smart_detector_alert_rule":
+		return "Microsoft.AlertsManagement"
+	}
+
+	return "Microsoft.Insights"
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type UserAssignedIdentityId struct {
@@ -37,32 +37,31 @@ func (id UserAssignedIdentityId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a UserAssignedIdentity ID
+func (id UserAssignedIdentityId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.ManagedIdentity"),
+		resourceid.StaticSegment("userAssignedIdentities"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // UserAssignedIdentityID parses a UserAssignedIdentity ID into an UserAssignedIdentityId struct
 func UserAssignedIdentityID(input string) (*UserAssignedIdentityId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(UserAssignedIdentityId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := UserAssignedIdentityId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.Name, err = id.PopSegment("userAssignedIdentities"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -75,38 +74,15 @@ func UserAssignedIdentityID(input string) (*UserAssignedIdentityId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func UserAssignedIdentityIDInsensitively(input string) (*UserAssignedIdentityId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(UserAssignedIdentityId{}).Parse(input, true)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := UserAssignedIdentityId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	// find the correct casing for the 'userAssignedIdentities' segment
-	userAssignedIdentitiesKey := "userAssignedIdentities"
-	for key := range id.Path {
-		if strings.EqualFold(key, userAssignedIdentitiesKey) {
-			userAssignedIdentitiesKey = key
-			break
-		}
-	}
-	if resourceId.Name, err = id.PopSegment(userAssignedIdentitiesKey); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabaseExtendedAuditingPolicyId struct {
@@ -43,38 +43,37 @@ func (id DatabaseExtendedAuditingPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName, id.ExtendedAuditingSettingName)
 }
 
+// Segments returns the ordered list of Segments which make up a DatabaseExtendedAuditingPolicy ID
+func (id DatabaseExtendedAuditingPolicyId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Sql"),
+		resourceid.StaticSegment("servers"),
+		resourceid.UserSpecifiedSegment("ServerName"),
+		resourceid.StaticSegment("databases"),
+		resourceid.UserSpecifiedSegment("DatabaseName"),
+		resourceid.StaticSegment("extendedAuditingSettings"),
+		resourceid.UserSpecifiedSegment("ExtendedAuditingSettingName"),
+	}
+}
+
 // DatabaseExtendedAuditingPolicyID parses a DatabaseExtendedAuditingPolicy ID into an DatabaseExtendedAuditingPolicyId struct
 func DatabaseExtendedAuditingPolicyID(input string) (*DatabaseExtendedAuditingPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(DatabaseExtendedAuditingPolicyId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DatabaseExtendedAuditingPolicyId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
-		return nil, err
-	}
-	if resourceId.DatabaseName, err = id.PopSegment("databases"); err != nil {
-		return nil, err
-	}
-	if resourceId.ExtendedAuditingSettingName, err = id.PopSegment("extendedAuditingSettings"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:              id.Parsed["SubscriptionId"],
+		ResourceGroup:               id.Parsed["ResourceGroup"],
+		ServerName:                  id.Parsed["ServerName"],
+		DatabaseName:                id.Parsed["DatabaseName"],
+		ExtendedAuditingSettingName: id.Parsed["ExtendedAuditingSettingName"],
 	}
 
 	return &resourceId, nil
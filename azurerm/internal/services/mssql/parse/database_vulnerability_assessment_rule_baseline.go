@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabaseVulnerabilityAssessmentRuleBaselineId struct {
@@ -49,44 +49,43 @@ func (id DatabaseVulnerabilityAssessmentRuleBaselineId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName, id.VulnerabilityAssessmentName, id.RuleName, id.BaselineName)
 }
 
+// Segments returns the ordered list of Segments which make up a DatabaseVulnerabilityAssessmentRuleBaseline ID
+func (id DatabaseVulnerabilityAssessmentRuleBaselineId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Sql"),
+		resourceid.StaticSegment("servers"),
+		resourceid.UserSpecifiedSegment("ServerName"),
+		resourceid.StaticSegment("databases"),
+		resourceid.UserSpecifiedSegment("DatabaseName"),
+		resourceid.StaticSegment("vulnerabilityAssessments"),
+		resourceid.UserSpecifiedSegment("VulnerabilityAssessmentName"),
+		resourceid.StaticSegment("rules"),
+		resourceid.UserSpecifiedSegment("RuleName"),
+		resourceid.StaticSegment("baselines"),
+		resourceid.UserSpecifiedSegment("BaselineName"),
+	}
+}
+
 // DatabaseVulnerabilityAssessmentRuleBaselineID parses a DatabaseVulnerabilityAssessmentRuleBaseline ID into an DatabaseVulnerabilityAssessmentRuleBaselineId struct
 func DatabaseVulnerabilityAssessmentRuleBaselineID(input string) (*DatabaseVulnerabilityAssessmentRuleBaselineId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(DatabaseVulnerabilityAssessmentRuleBaselineId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DatabaseVulnerabilityAssessmentRuleBaselineId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
-		return nil, err
-	}
-	if resourceId.DatabaseName, err = id.PopSegment("databases"); err != nil {
-		return nil, err
-	}
-	if resourceId.VulnerabilityAssessmentName, err = id.PopSegment("vulnerabilityAssessments"); err != nil {
-		return nil, err
-	}
-	if resourceId.RuleName, err = id.PopSegment("rules"); err != nil {
-		return nil, err
-	}
-	if resourceId.BaselineName, err = id.PopSegment("baselines"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:              id.Parsed["SubscriptionId"],
+		ResourceGroup:               id.Parsed["ResourceGroup"],
+		ServerName:                  id.Parsed["ServerName"],
+		DatabaseName:                id.Parsed["DatabaseName"],
+		VulnerabilityAssessmentName: id.Parsed["VulnerabilityAssessmentName"],
+		RuleName:                    id.Parsed["RuleName"],
+		BaselineName:                id.Parsed["BaselineName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EncryptionProtectorId struct {
@@ -40,35 +40,34 @@ func (id EncryptionProtectorId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.EncryptionProtectorName)
 }
 
+// Segments returns the ordered list of Segments which make up a EncryptionProtector ID
+func (id EncryptionProtectorId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Sql"),
+		resourceid.StaticSegment("servers"),
+		resourceid.UserSpecifiedSegment("ServerName"),
+		resourceid.StaticSegment("encryptionProtector"),
+		resourceid.UserSpecifiedSegment("EncryptionProtectorName"),
+	}
+}
+
 // EncryptionProtectorID parses a EncryptionProtector ID into an EncryptionProtectorId struct
 func EncryptionProtectorID(input string) (*EncryptionProtectorId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(EncryptionProtectorId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := EncryptionProtectorId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
-		return nil, err
-	}
-	if resourceId.EncryptionProtectorName, err = id.PopSegment("encryptionProtector"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:          id.Parsed["SubscriptionId"],
+		ResourceGroup:           id.Parsed["ResourceGroup"],
+		ServerName:              id.Parsed["ServerName"],
+		EncryptionProtectorName: id.Parsed["EncryptionProtectorName"],
 	}
 
 	return &resourceId, nil
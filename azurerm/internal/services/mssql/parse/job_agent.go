@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type JobAgentId struct {
@@ -40,35 +40,34 @@ func (id JobAgentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a JobAgent ID
+func (id JobAgentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Sql"),
+		resourceid.StaticSegment("servers"),
+		resourceid.UserSpecifiedSegment("ServerName"),
+		resourceid.StaticSegment("jobAgents"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // JobAgentID parses a JobAgent ID into an JobAgentId struct
 func JobAgentID(input string) (*JobAgentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(JobAgentId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := JobAgentId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("jobAgents"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		ServerName:     id.Parsed["ServerName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type JobCredentialId struct {
@@ -43,38 +43,37 @@ func (id JobCredentialId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.JobAgentName, id.CredentialName)
 }
 
+// Segments returns the ordered list of Segments which make up a JobCredential ID
+func (id JobCredentialId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Sql"),
+		resourceid.StaticSegment("servers"),
+		resourceid.UserSpecifiedSegment("ServerName"),
+		resourceid.StaticSegment("jobAgents"),
+		resourceid.UserSpecifiedSegment("JobAgentName"),
+		resourceid.StaticSegment("credentials"),
+		resourceid.UserSpecifiedSegment("CredentialName"),
+	}
+}
+
 // JobCredentialID parses a JobCredential ID into an JobCredentialId struct
 func JobCredentialID(input string) (*JobCredentialId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(JobCredentialId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := JobCredentialId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
-		return nil, err
-	}
-	if resourceId.JobAgentName, err = id.PopSegment("jobAgents"); err != nil {
-		return nil, err
-	}
-	if resourceId.CredentialName, err = id.PopSegment("credentials"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		ServerName:     id.Parsed["ServerName"],
+		JobAgentName:   id.Parsed["JobAgentName"],
+		CredentialName: id.Parsed["CredentialName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ServerVulnerabilityAssessmentId struct {
@@ -40,35 +40,34 @@ func (id ServerVulnerabilityAssessmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.VulnerabilityAssessmentName)
 }
 
+// Segments returns the ordered list of Segments which make up a ServerVulnerabilityAssessment ID
+func (id ServerVulnerabilityAssessmentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Sql"),
+		resourceid.StaticSegment("servers"),
+		resourceid.UserSpecifiedSegment("ServerName"),
+		resourceid.StaticSegment("vulnerabilityAssessments"),
+		resourceid.UserSpecifiedSegment("VulnerabilityAssessmentName"),
+	}
+}
+
 // ServerVulnerabilityAssessmentID parses a ServerVulnerabilityAssessment ID into an ServerVulnerabilityAssessmentId struct
 func ServerVulnerabilityAssessmentID(input string) (*ServerVulnerabilityAssessmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ServerVulnerabilityAssessmentId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ServerVulnerabilityAssessmentId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
-		return nil, err
-	}
-	if resourceId.VulnerabilityAssessmentName, err = id.PopSegment("vulnerabilityAssessments"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:              id.Parsed["SubscriptionId"],
+		ResourceGroup:               id.Parsed["ResourceGroup"],
+		ServerName:                  id.Parsed["ServerName"],
+		VulnerabilityAssessmentName: id.Parsed["VulnerabilityAssessmentName"],
 	}
 
 	return &resourceId, nil
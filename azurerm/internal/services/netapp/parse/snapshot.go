@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SnapshotId struct {
@@ -46,41 +46,40 @@ func (id SnapshotId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetAppAccountName, id.CapacityPoolName, id.VolumeName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a Snapshot ID
+func (id SnapshotId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.NetApp"),
+		resourceid.StaticSegment("netAppAccounts"),
+		resourceid.UserSpecifiedSegment("NetAppAccountName"),
+		resourceid.StaticSegment("capacityPools"),
+		resourceid.UserSpecifiedSegment("CapacityPoolName"),
+		resourceid.StaticSegment("volumes"),
+		resourceid.UserSpecifiedSegment("VolumeName"),
+		resourceid.StaticSegment("snapshots"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // SnapshotID parses a Snapshot ID into an SnapshotId struct
 func SnapshotID(input string) (*SnapshotId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(SnapshotId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SnapshotId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NetAppAccountName, err = id.PopSegment("netAppAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.CapacityPoolName, err = id.PopSegment("capacityPools"); err != nil {
-		return nil, err
-	}
-	if resourceId.VolumeName, err = id.PopSegment("volumes"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("snapshots"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:    id.Parsed["SubscriptionId"],
+		ResourceGroup:     id.Parsed["ResourceGroup"],
+		NetAppAccountName: id.Parsed["NetAppAccountName"],
+		CapacityPoolName:  id.Parsed["CapacityPoolName"],
+		VolumeName:        id.Parsed["VolumeName"],
+		Name:              id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
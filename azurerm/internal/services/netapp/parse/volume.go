@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VolumeId struct {
@@ -43,38 +43,37 @@ func (id VolumeId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetAppAccountName, id.CapacityPoolName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a Volume ID
+func (id VolumeId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.NetApp"),
+		resourceid.StaticSegment("netAppAccounts"),
+		resourceid.UserSpecifiedSegment("NetAppAccountName"),
+		resourceid.StaticSegment("capacityPools"),
+		resourceid.UserSpecifiedSegment("CapacityPoolName"),
+		resourceid.StaticSegment("volumes"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // VolumeID parses a Volume ID into an VolumeId struct
 func VolumeID(input string) (*VolumeId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(VolumeId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VolumeId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NetAppAccountName, err = id.PopSegment("netAppAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.CapacityPoolName, err = id.PopSegment("capacityPools"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("volumes"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:    id.Parsed["SubscriptionId"],
+		ResourceGroup:     id.Parsed["ResourceGroup"],
+		NetAppAccountName: id.Parsed["NetAppAccountName"],
+		CapacityPoolName:  id.Parsed["CapacityPoolName"],
+		Name:              id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
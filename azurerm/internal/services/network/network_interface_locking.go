@@ -1,6 +1,8 @@
 package network
 
 import (
+	"context"
+
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
@@ -12,14 +14,12 @@ type networkInterfaceIPConfigurationLockingDetails struct {
 	virtualNetworkNamesToLock []string
 }
 
-func (details networkInterfaceIPConfigurationLockingDetails) lock() {
-	locks.MultipleByName(&details.subnetNamesToLock, SubnetResourceName)
-	locks.MultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
-}
-
-func (details networkInterfaceIPConfigurationLockingDetails) unlock() {
-	locks.UnlockMultipleByName(&details.subnetNamesToLock, SubnetResourceName)
-	locks.UnlockMultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
+// lock acquires the locks for all of the Subnets and Virtual Networks in a single call
+// to ensure these are locked in a consistent order, avoiding deadlocks
+func (details networkInterfaceIPConfigurationLockingDetails) lock(ctx context.Context) (*locks.Handle, error) {
+	keys := locks.NameKeys(details.subnetNamesToLock, SubnetResourceName)
+	keys = append(keys, locks.NameKeys(details.virtualNetworkNamesToLock, VirtualNetworkResourceName)...)
+	return locks.Acquire(ctx, keys...)
 }
 
 func determineResourcesToLockFromIPConfiguration(input *[]network.InterfaceIPConfiguration) (*networkInterfaceIPConfigurationLockingDetails, error) {
@@ -0,0 +1,83 @@
+package network
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestNetworkInterfaceLockingWithSubnetAssociations(t *testing.T) {
+	subnetId := parse.NewSubnetID("00000000-0000-0000-0000-000000000000", "group1", "locking-network", "locking-subnet")
+	ipConfigurations := []network.InterfaceIPConfiguration{
+		{
+			InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
+				Subnet: &network.Subnet{
+					ID: utils.String(subnetId.ID()),
+				},
+			},
+		},
+	}
+	lockingDetails, err := determineResourcesToLockFromIPConfiguration(&ipConfigurations)
+	if err != nil {
+		t.Fatalf("determining the resources to lock: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 100)
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+
+		// the Network Interface locks the Subnet and Virtual Network in a single call
+		go func() {
+			defer wg.Done()
+			handle, err := lockingDetails.lock(ctx)
+			if err != nil {
+				errs <- err
+				return
+			}
+			time.Sleep(time.Millisecond)
+			handle.Release()
+		}()
+
+		// whereas the Subnet associations lock the Network Security Group, Virtual Network and Subnet individually
+		go func() {
+			defer wg.Done()
+			locks.ByName("locking-nsg", networkSecurityGroupResourceName)
+			defer locks.UnlockByName("locking-nsg", networkSecurityGroupResourceName)
+
+			locks.ByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
+			defer locks.UnlockByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
+
+			time.Sleep(time.Millisecond)
+
+			locks.ByName(subnetId.Name, SubnetResourceName)
+			defer locks.UnlockByName(subnetId.Name, SubnetResourceName)
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		t.Fatalf("timed out waiting for the locks - the locks are likely deadlocked")
+	}
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("acquiring the locks for the Network Interface: %+v", err)
+	}
+}
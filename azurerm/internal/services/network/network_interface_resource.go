@@ -244,8 +244,11 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
-	defer lockingDetails.unlock()
+	lock, err := lockingDetails.lock(ctx)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer lock.Release()
 
 	if len(*ipConfigs) > 0 {
 		properties.IPConfigurations = ipConfigs
@@ -339,8 +342,11 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("Error determining locking details: %+v", err)
 		}
 
-		lockingDetails.lock()
-		defer lockingDetails.unlock()
+		lock, err := lockingDetails.lock(ctx)
+		if err != nil {
+			return fmt.Errorf("Error acquiring locks: %+v", err)
+		}
+		defer lock.Release()
 
 		// then map the fields managed in other resources back
 		ipConfigs = mapFieldsToNetworkInterface(ipConfigs, info)
@@ -500,8 +506,11 @@ func resourceNetworkInterfaceDelete(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
-	defer lockingDetails.unlock()
+	lock, err := lockingDetails.lock(ctx)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer lock.Release()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApplicationGatewayHTTPListenerId struct {
@@ -40,35 +40,34 @@ func (id ApplicationGatewayHTTPListenerId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName, id.HttpListenerName)
 }
 
+// Segments returns the ordered list of Segments which make up a ApplicationGatewayHTTPListener ID
+func (id ApplicationGatewayHTTPListenerId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("applicationGateways"),
+		resourceid.UserSpecifiedSegment("ApplicationGatewayName"),
+		resourceid.StaticSegment("httpListeners"),
+		resourceid.UserSpecifiedSegment("HttpListenerName"),
+	}
+}
+
 // ApplicationGatewayHTTPListenerID parses a ApplicationGatewayHTTPListener ID into an ApplicationGatewayHTTPListenerId struct
 func ApplicationGatewayHTTPListenerID(input string) (*ApplicationGatewayHTTPListenerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ApplicationGatewayHTTPListenerId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ApplicationGatewayHTTPListenerId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ApplicationGatewayName, err = id.PopSegment("applicationGateways"); err != nil {
-		return nil, err
-	}
-	if resourceId.HttpListenerName, err = id.PopSegment("httpListeners"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         id.Parsed["SubscriptionId"],
+		ResourceGroup:          id.Parsed["ResourceGroup"],
+		ApplicationGatewayName: id.Parsed["ApplicationGatewayName"],
+		HttpListenerName:       id.Parsed["HttpListenerName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApplicationGatewayURLPathMapPathRuleId struct {
@@ -43,38 +43,37 @@ func (id ApplicationGatewayURLPathMapPathRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName, id.UrlPathMapName, id.PathRuleName)
 }
 
+// Segments returns the ordered list of Segments which make up a ApplicationGatewayURLPathMapPathRule ID
+func (id ApplicationGatewayURLPathMapPathRuleId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("applicationGateways"),
+		resourceid.UserSpecifiedSegment("ApplicationGatewayName"),
+		resourceid.StaticSegment("urlPathMaps"),
+		resourceid.UserSpecifiedSegment("UrlPathMapName"),
+		resourceid.StaticSegment("pathRules"),
+		resourceid.UserSpecifiedSegment("PathRuleName"),
+	}
+}
+
 // ApplicationGatewayURLPathMapPathRuleID parses a ApplicationGatewayURLPathMapPathRule ID into an ApplicationGatewayURLPathMapPathRuleId struct
 func ApplicationGatewayURLPathMapPathRuleID(input string) (*ApplicationGatewayURLPathMapPathRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ApplicationGatewayURLPathMapPathRuleId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ApplicationGatewayURLPathMapPathRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ApplicationGatewayName, err = id.PopSegment("applicationGateways"); err != nil {
-		return nil, err
-	}
-	if resourceId.UrlPathMapName, err = id.PopSegment("urlPathMaps"); err != nil {
-		return nil, err
-	}
-	if resourceId.PathRuleName, err = id.PopSegment("pathRules"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         id.Parsed["SubscriptionId"],
+		ResourceGroup:          id.Parsed["ResourceGroup"],
+		ApplicationGatewayName: id.Parsed["ApplicationGatewayName"],
+		UrlPathMapName:         id.Parsed["UrlPathMapName"],
+		PathRuleName:           id.Parsed["PathRuleName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BgpConnectionId struct {
@@ -40,35 +40,34 @@ func (id BgpConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a BgpConnection ID
+func (id BgpConnectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("virtualHubs"),
+		resourceid.UserSpecifiedSegment("VirtualHubName"),
+		resourceid.StaticSegment("bgpConnections"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // BgpConnectionID parses a BgpConnection ID into an BgpConnectionId struct
 func BgpConnectionID(input string) (*BgpConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(BgpConnectionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := BgpConnectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("bgpConnections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		VirtualHubName: id.Parsed["VirtualHubName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
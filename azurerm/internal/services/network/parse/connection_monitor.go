@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ConnectionMonitorId struct {
@@ -40,35 +40,34 @@ func (id ConnectionMonitorId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetworkWatcherName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a ConnectionMonitor ID
+func (id ConnectionMonitorId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("networkWatchers"),
+		resourceid.UserSpecifiedSegment("NetworkWatcherName"),
+		resourceid.StaticSegment("connectionMonitors"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // ConnectionMonitorID parses a ConnectionMonitor ID into an ConnectionMonitorId struct
 func ConnectionMonitorID(input string) (*ConnectionMonitorId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ConnectionMonitorId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ConnectionMonitorId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NetworkWatcherName, err = id.PopSegment("networkWatchers"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("connectionMonitors"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     id.Parsed["SubscriptionId"],
+		ResourceGroup:      id.Parsed["ResourceGroup"],
+		NetworkWatcherName: id.Parsed["NetworkWatcherName"],
+		Name:               id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
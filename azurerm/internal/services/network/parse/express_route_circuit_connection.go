@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ExpressRouteCircuitConnectionId struct {
@@ -43,38 +43,37 @@ func (id ExpressRouteCircuitConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ExpressRouteCircuitName, id.PeeringName, id.ConnectionName)
 }
 
+// Segments returns the ordered list of Segments which make up a ExpressRouteCircuitConnection ID
+func (id ExpressRouteCircuitConnectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("expressRouteCircuits"),
+		resourceid.UserSpecifiedSegment("ExpressRouteCircuitName"),
+		resourceid.StaticSegment("peerings"),
+		resourceid.UserSpecifiedSegment("PeeringName"),
+		resourceid.StaticSegment("connections"),
+		resourceid.UserSpecifiedSegment("ConnectionName"),
+	}
+}
+
 // ExpressRouteCircuitConnectionID parses a ExpressRouteCircuitConnection ID into an ExpressRouteCircuitConnectionId struct
 func ExpressRouteCircuitConnectionID(input string) (*ExpressRouteCircuitConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ExpressRouteCircuitConnectionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ExpressRouteCircuitConnectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ExpressRouteCircuitName, err = id.PopSegment("expressRouteCircuits"); err != nil {
-		return nil, err
-	}
-	if resourceId.PeeringName, err = id.PopSegment("peerings"); err != nil {
-		return nil, err
-	}
-	if resourceId.ConnectionName, err = id.PopSegment("connections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:          id.Parsed["SubscriptionId"],
+		ResourceGroup:           id.Parsed["ResourceGroup"],
+		ExpressRouteCircuitName: id.Parsed["ExpressRouteCircuitName"],
+		PeeringName:             id.Parsed["PeeringName"],
+		ConnectionName:          id.Parsed["ConnectionName"],
 	}
 
 	return &resourceId, nil
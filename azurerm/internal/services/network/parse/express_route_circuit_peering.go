@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ExpressRouteCircuitPeeringId struct {
@@ -40,35 +40,34 @@ func (id ExpressRouteCircuitPeeringId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ExpressRouteCircuitName, id.PeeringName)
 }
 
+// Segments returns the ordered list of Segments which make up a ExpressRouteCircuitPeering ID
+func (id ExpressRouteCircuitPeeringId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("expressRouteCircuits"),
+		resourceid.UserSpecifiedSegment("ExpressRouteCircuitName"),
+		resourceid.StaticSegment("peerings"),
+		resourceid.UserSpecifiedSegment("PeeringName"),
+	}
+}
+
 // ExpressRouteCircuitPeeringID parses a ExpressRouteCircuitPeering ID into an ExpressRouteCircuitPeeringId struct
 func ExpressRouteCircuitPeeringID(input string) (*ExpressRouteCircuitPeeringId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ExpressRouteCircuitPeeringId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ExpressRouteCircuitPeeringId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ExpressRouteCircuitName, err = id.PopSegment("expressRouteCircuits"); err != nil {
-		return nil, err
-	}
-	if resourceId.PeeringName, err = id.PopSegment("peerings"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:          id.Parsed["SubscriptionId"],
+		ResourceGroup:           id.Parsed["ResourceGroup"],
+		ExpressRouteCircuitName: id.Parsed["ExpressRouteCircuitName"],
+		PeeringName:             id.Parsed["PeeringName"],
 	}
 
 	return &resourceId, nil
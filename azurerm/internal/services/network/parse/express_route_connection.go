@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ExpressRouteConnectionId struct {
@@ -40,35 +40,34 @@ func (id ExpressRouteConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a ExpressRouteConnection ID
+func (id ExpressRouteConnectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("expressRouteGateways"),
+		resourceid.UserSpecifiedSegment("ExpressRouteGatewayName"),
+		resourceid.StaticSegment("expressRouteConnections"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // ExpressRouteConnectionID parses a ExpressRouteConnection ID into an ExpressRouteConnectionId struct
 func ExpressRouteConnectionID(input string) (*ExpressRouteConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ExpressRouteConnectionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ExpressRouteConnectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ExpressRouteGatewayName, err = id.PopSegment("expressRouteGateways"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("expressRouteConnections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:          id.Parsed["SubscriptionId"],
+		ResourceGroup:           id.Parsed["ResourceGroup"],
+		ExpressRouteGatewayName: id.Parsed["ExpressRouteGatewayName"],
+		Name:                    id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type HubVirtualNetworkConnectionId struct {
@@ -40,35 +40,34 @@ func (id HubVirtualNetworkConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a HubVirtualNetworkConnection ID
+func (id HubVirtualNetworkConnectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("virtualHubs"),
+		resourceid.UserSpecifiedSegment("VirtualHubName"),
+		resourceid.StaticSegment("hubVirtualNetworkConnections"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // HubVirtualNetworkConnectionID parses a HubVirtualNetworkConnection ID into an HubVirtualNetworkConnectionId struct
 func HubVirtualNetworkConnectionID(input string) (*HubVirtualNetworkConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(HubVirtualNetworkConnectionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := HubVirtualNetworkConnectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("hubVirtualNetworkConnections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		VirtualHubName: id.Parsed["VirtualHubName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PacketCaptureId struct {
@@ -40,35 +40,34 @@ func (id PacketCaptureId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetworkWatcherName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a PacketCapture ID
+func (id PacketCaptureId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("networkWatchers"),
+		resourceid.UserSpecifiedSegment("NetworkWatcherName"),
+		resourceid.StaticSegment("packetCaptures"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // PacketCaptureID parses a PacketCapture ID into an PacketCaptureId struct
 func PacketCaptureID(input string) (*PacketCaptureId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(PacketCaptureId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := PacketCaptureId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NetworkWatcherName, err = id.PopSegment("networkWatchers"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("packetCaptures"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     id.Parsed["SubscriptionId"],
+		ResourceGroup:      id.Parsed["ResourceGroup"],
+		NetworkWatcherName: id.Parsed["NetworkWatcherName"],
+		Name:               id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
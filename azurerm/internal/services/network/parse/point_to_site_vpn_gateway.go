@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PointToSiteVpnGatewayId struct {
@@ -37,32 +37,31 @@ func (id PointToSiteVpnGatewayId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.P2sVpnGatewayName)
 }
 
+// Segments returns the ordered list of Segments which make up a PointToSiteVpnGateway ID
+func (id PointToSiteVpnGatewayId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("p2sVpnGateways"),
+		resourceid.UserSpecifiedSegment("P2sVpnGatewayName"),
+	}
+}
+
 // PointToSiteVpnGatewayID parses a PointToSiteVpnGateway ID into an PointToSiteVpnGatewayId struct
 func PointToSiteVpnGatewayID(input string) (*PointToSiteVpnGatewayId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(PointToSiteVpnGatewayId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := PointToSiteVpnGatewayId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.P2sVpnGatewayName, err = id.PopSegment("p2sVpnGateways"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:    id.Parsed["SubscriptionId"],
+		ResourceGroup:     id.Parsed["ResourceGroup"],
+		P2sVpnGatewayName: id.Parsed["P2sVpnGatewayName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PrivateDnsZoneConfigId struct {
@@ -43,38 +43,37 @@ func (id PrivateDnsZoneConfigId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PrivateEndpointName, id.PrivateDnsZoneGroupName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a PrivateDnsZoneConfig ID
+func (id PrivateDnsZoneConfigId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("privateEndpoints"),
+		resourceid.UserSpecifiedSegment("PrivateEndpointName"),
+		resourceid.StaticSegment("privateDnsZoneGroups"),
+		resourceid.UserSpecifiedSegment("PrivateDnsZoneGroupName"),
+		resourceid.StaticSegment("privateDnsZoneConfigs"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // PrivateDnsZoneConfigID parses a PrivateDnsZoneConfig ID into an PrivateDnsZoneConfigId struct
 func PrivateDnsZoneConfigID(input string) (*PrivateDnsZoneConfigId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(PrivateDnsZoneConfigId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := PrivateDnsZoneConfigId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.PrivateEndpointName, err = id.PopSegment("privateEndpoints"); err != nil {
-		return nil, err
-	}
-	if resourceId.PrivateDnsZoneGroupName, err = id.PopSegment("privateDnsZoneGroups"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("privateDnsZoneConfigs"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:          id.Parsed["SubscriptionId"],
+		ResourceGroup:           id.Parsed["ResourceGroup"],
+		PrivateEndpointName:     id.Parsed["PrivateEndpointName"],
+		PrivateDnsZoneGroupName: id.Parsed["PrivateDnsZoneGroupName"],
+		Name:                    id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PrivateDnsZoneGroupId struct {
@@ -40,35 +40,34 @@ func (id PrivateDnsZoneGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PrivateEndpointName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a PrivateDnsZoneGroup ID
+func (id PrivateDnsZoneGroupId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("privateEndpoints"),
+		resourceid.UserSpecifiedSegment("PrivateEndpointName"),
+		resourceid.StaticSegment("privateDnsZoneGroups"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // PrivateDnsZoneGroupID parses a PrivateDnsZoneGroup ID into an PrivateDnsZoneGroupId struct
 func PrivateDnsZoneGroupID(input string) (*PrivateDnsZoneGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(PrivateDnsZoneGroupId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := PrivateDnsZoneGroupId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.PrivateEndpointName, err = id.PopSegment("privateEndpoints"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("privateDnsZoneGroups"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      id.Parsed["SubscriptionId"],
+		ResourceGroup:       id.Parsed["ResourceGroup"],
+		PrivateEndpointName: id.Parsed["PrivateEndpointName"],
+		Name:                id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
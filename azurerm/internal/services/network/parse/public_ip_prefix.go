@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PublicIpPrefixId struct {
@@ -37,32 +37,31 @@ func (id PublicIpPrefixId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PublicIPPrefixeName)
 }
 
+// Segments returns the ordered list of Segments which make up a PublicIpPrefix ID
+func (id PublicIpPrefixId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("publicIPPrefixes"),
+		resourceid.UserSpecifiedSegment("PublicIPPrefixeName"),
+	}
+}
+
 // PublicIpPrefixID parses a PublicIpPrefix ID into an PublicIpPrefixId struct
 func PublicIpPrefixID(input string) (*PublicIpPrefixId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(PublicIpPrefixId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := PublicIpPrefixId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.PublicIPPrefixeName, err = id.PopSegment("publicIPPrefixes"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      id.Parsed["SubscriptionId"],
+		ResourceGroup:       id.Parsed["ResourceGroup"],
+		PublicIPPrefixeName: id.Parsed["PublicIPPrefixeName"],
 	}
 
 	return &resourceId, nil
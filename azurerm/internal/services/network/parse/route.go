@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RouteId struct {
@@ -40,35 +40,34 @@ func (id RouteId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RouteTableName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a Route ID
+func (id RouteId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("routeTables"),
+		resourceid.UserSpecifiedSegment("RouteTableName"),
+		resourceid.StaticSegment("routes"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // RouteID parses a Route ID into an RouteId struct
 func RouteID(input string) (*RouteId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(RouteId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := RouteId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.RouteTableName, err = id.PopSegment("routeTables"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("routes"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		RouteTableName: id.Parsed["RouteTableName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SubnetId struct {
@@ -40,35 +40,34 @@ func (id SubnetId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a Subnet ID
+func (id SubnetId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("virtualNetworks"),
+		resourceid.UserSpecifiedSegment("VirtualNetworkName"),
+		resourceid.StaticSegment("subnets"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // SubnetID parses a Subnet ID into an SubnetId struct
 func SubnetID(input string) (*SubnetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(SubnetId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SubnetId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VirtualNetworkName, err = id.PopSegment("virtualNetworks"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("subnets"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     id.Parsed["SubscriptionId"],
+		ResourceGroup:      id.Parsed["ResourceGroup"],
+		VirtualNetworkName: id.Parsed["VirtualNetworkName"],
+		Name:               id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -81,50 +80,16 @@ func SubnetID(input string) (*SubnetId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func SubnetIDInsensitively(input string) (*SubnetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(SubnetId{}).Parse(input, true)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SubnetId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	// find the correct casing for the 'virtualNetworks' segment
-	virtualNetworksKey := "virtualNetworks"
-	for key := range id.Path {
-		if strings.EqualFold(key, virtualNetworksKey) {
-			virtualNetworksKey = key
-			break
-		}
-	}
-	if resourceId.VirtualNetworkName, err = id.PopSegment(virtualNetworksKey); err != nil {
-		return nil, err
-	}
-
-	// find the correct casing for the 'subnets' segment
-	subnetsKey := "subnets"
-	for key := range id.Path {
-		if strings.EqualFold(key, subnetsKey) {
-			subnetsKey = key
-			break
-		}
-	}
-	if resourceId.Name, err = id.PopSegment(subnetsKey); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     id.Parsed["SubscriptionId"],
+		ResourceGroup:      id.Parsed["ResourceGroup"],
+		VirtualNetworkName: id.Parsed["VirtualNetworkName"],
+		Name:               id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualHubIpConfigurationId struct {
@@ -40,35 +40,34 @@ func (id VirtualHubIpConfigurationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.IpConfigurationName)
 }
 
+// Segments returns the ordered list of Segments which make up a VirtualHubIpConfiguration ID
+func (id VirtualHubIpConfigurationId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("virtualHubs"),
+		resourceid.UserSpecifiedSegment("VirtualHubName"),
+		resourceid.StaticSegment("ipConfigurations"),
+		resourceid.UserSpecifiedSegment("IpConfigurationName"),
+	}
+}
+
 // VirtualHubIpConfigurationID parses a VirtualHubIpConfiguration ID into an VirtualHubIpConfigurationId struct
 func VirtualHubIpConfigurationID(input string) (*VirtualHubIpConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(VirtualHubIpConfigurationId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VirtualHubIpConfigurationId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
-		return nil, err
-	}
-	if resourceId.IpConfigurationName, err = id.PopSegment("ipConfigurations"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      id.Parsed["SubscriptionId"],
+		ResourceGroup:       id.Parsed["ResourceGroup"],
+		VirtualHubName:      id.Parsed["VirtualHubName"],
+		IpConfigurationName: id.Parsed["IpConfigurationName"],
 	}
 
 	return &resourceId, nil
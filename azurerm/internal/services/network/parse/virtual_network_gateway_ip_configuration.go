@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualNetworkGatewayIpConfigurationId struct {
@@ -40,35 +40,34 @@ func (id VirtualNetworkGatewayIpConfigurationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkGatewayName, id.IpConfigurationName)
 }
 
+// Segments returns the ordered list of Segments which make up a VirtualNetworkGatewayIpConfiguration ID
+func (id VirtualNetworkGatewayIpConfigurationId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("virtualNetworkGateways"),
+		resourceid.UserSpecifiedSegment("VirtualNetworkGatewayName"),
+		resourceid.StaticSegment("ipConfigurations"),
+		resourceid.UserSpecifiedSegment("IpConfigurationName"),
+	}
+}
+
 // VirtualNetworkGatewayIpConfigurationID parses a VirtualNetworkGatewayIpConfiguration ID into an VirtualNetworkGatewayIpConfigurationId struct
 func VirtualNetworkGatewayIpConfigurationID(input string) (*VirtualNetworkGatewayIpConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(VirtualNetworkGatewayIpConfigurationId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VirtualNetworkGatewayIpConfigurationId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VirtualNetworkGatewayName, err = id.PopSegment("virtualNetworkGateways"); err != nil {
-		return nil, err
-	}
-	if resourceId.IpConfigurationName, err = id.PopSegment("ipConfigurations"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:            id.Parsed["SubscriptionId"],
+		ResourceGroup:             id.Parsed["ResourceGroup"],
+		VirtualNetworkGatewayName: id.Parsed["VirtualNetworkGatewayName"],
+		IpConfigurationName:       id.Parsed["IpConfigurationName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VpnConnectionId struct {
@@ -40,35 +40,34 @@ func (id VpnConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VpnGatewayName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a VpnConnection ID
+func (id VpnConnectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("vpnGateways"),
+		resourceid.UserSpecifiedSegment("VpnGatewayName"),
+		resourceid.StaticSegment("vpnConnections"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // VpnConnectionID parses a VpnConnection ID into an VpnConnectionId struct
 func VpnConnectionID(input string) (*VpnConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(VpnConnectionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VpnConnectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VpnGatewayName, err = id.PopSegment("vpnGateways"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("vpnConnections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		VpnGatewayName: id.Parsed["VpnGatewayName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
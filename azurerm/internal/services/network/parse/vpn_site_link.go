@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VpnSiteLinkId struct {
@@ -40,35 +40,34 @@ func (id VpnSiteLinkId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VpnSiteName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a VpnSiteLink ID
+func (id VpnSiteLinkId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("vpnSites"),
+		resourceid.UserSpecifiedSegment("VpnSiteName"),
+		resourceid.StaticSegment("vpnSiteLinks"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // VpnSiteLinkID parses a VpnSiteLink ID into an VpnSiteLinkId struct
 func VpnSiteLinkID(input string) (*VpnSiteLinkId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(VpnSiteLinkId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VpnSiteLinkId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VpnSiteName, err = id.PopSegment("vpnSites"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("vpnSiteLinks"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		VpnSiteName:    id.Parsed["VpnSiteName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
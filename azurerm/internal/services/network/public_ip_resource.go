@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -33,6 +34,8 @@ func resourcePublicIp() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourcePublicIpCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -169,6 +172,42 @@ func resourcePublicIp() *pluginsdk.Resource {
 	}
 }
 
+// resourcePublicIpCustomizeDiff validates the Availability Zones (when changed) are supported by the SKU and
+// the Location, rather than finding out once the Public IP Address fails to be created
+func resourcePublicIpCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.HasChange("availability_zone") && !d.HasChange("zones") {
+		return nil
+	}
+	if !d.NewValueKnown("availability_zone") || !d.NewValueKnown("zones") || !d.NewValueKnown("location") {
+		return nil
+	}
+
+	zones := make([]string, 0)
+	switch availabilityZone := d.Get("availability_zone").(string); availabilityZone {
+	case "", "No-Zone":
+	case "Zone-Redundant":
+		zones = append(zones, "1", "2")
+	default:
+		zones = append(zones, availabilityZone)
+	}
+	for _, zone := range d.Get("zones").([]interface{}) {
+		zones = append(zones, zone.(string))
+	}
+	if len(zones) == 0 {
+		return nil
+	}
+
+	if strings.EqualFold(d.Get("sku").(string), "Basic") {
+		return fmt.Errorf("Availability Zones are not available on the `Basic` SKU")
+	}
+
+	if err := location.ValidateAvailabilityZones(ctx, d.Get("location").(string), zones, "", ""); err != nil {
+		return fmt.Errorf("validating the Availability Zones: %+v", err)
+	}
+
+	return nil
+}
+
 func resourcePublicIpCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.PublicIPsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
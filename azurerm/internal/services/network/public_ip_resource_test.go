@@ -115,6 +115,31 @@ func TestAccPublicIpStatic_zonesZoneRedundant(t *testing.T) {
 	})
 }
 
+func TestAccPublicIpStatic_zonesBasicSku(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_public_ip", "test")
+	r := PublicIPResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.withZoneAndSku(data, data.Locations.Primary, "1", "Basic"),
+			ExpectError: regexp.MustCompile("Availability Zones are not available on the `Basic` SKU"),
+		},
+	})
+}
+
+func TestAccPublicIpStatic_zonesUnsupportedLocation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_public_ip", "test")
+	r := PublicIPResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// West Central US doesn't support Availability Zones
+			Config:      r.withZoneAndSku(data, "West Central US", "1", "Standard"),
+			ExpectError: regexp.MustCompile("doesn't support Availability Zones"),
+		},
+	})
+}
+
 func TestAccPublicIpStatic_basic_withDNSLabel(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_public_ip", "test")
 	r := PublicIPResource{}
@@ -482,6 +507,28 @@ resource "azurerm_public_ip" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, availabilityZone)
 }
 
+func (PublicIPResource) withZoneAndSku(data acceptance.TestData, location string, availabilityZone string, sku string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "%s"
+  availability_zone   = "%s"
+}
+`, data.RandomInteger, location, data.RandomInteger, sku, availabilityZone)
+}
+
 func (PublicIPResource) basic_withDNSLabel(data acceptance.TestData, dnsNameLabel string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
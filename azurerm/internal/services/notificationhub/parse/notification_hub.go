@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NotificationHubId struct {
@@ -40,35 +40,34 @@ func (id NotificationHubId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a NotificationHub ID
+func (id NotificationHubId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.NotificationHubs"),
+		resourceid.StaticSegment("namespaces"),
+		resourceid.UserSpecifiedSegment("NamespaceName"),
+		resourceid.StaticSegment("notificationHubs"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // NotificationHubID parses a NotificationHub ID into an NotificationHubId struct
 func NotificationHubID(input string) (*NotificationHubId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(NotificationHubId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := NotificationHubId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("notificationHubs"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		NamespaceName:  id.Parsed["NamespaceName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
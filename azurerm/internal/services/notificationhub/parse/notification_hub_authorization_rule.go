@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NotificationHubAuthorizationRuleId struct {
@@ -43,38 +43,37 @@ func (id NotificationHubAuthorizationRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.NotificationHubName, id.AuthorizationRuleName)
 }
 
+// Segments returns the ordered list of Segments which make up a NotificationHubAuthorizationRule ID
+func (id NotificationHubAuthorizationRuleId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.NotificationHubs"),
+		resourceid.StaticSegment("namespaces"),
+		resourceid.UserSpecifiedSegment("NamespaceName"),
+		resourceid.StaticSegment("notificationHubs"),
+		resourceid.UserSpecifiedSegment("NotificationHubName"),
+		resourceid.StaticSegment("AuthorizationRules"),
+		resourceid.UserSpecifiedSegment("AuthorizationRuleName"),
+	}
+}
+
 // NotificationHubAuthorizationRuleID parses a NotificationHubAuthorizationRule ID into an NotificationHubAuthorizationRuleId struct
 func NotificationHubAuthorizationRuleID(input string) (*NotificationHubAuthorizationRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(NotificationHubAuthorizationRuleId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := NotificationHubAuthorizationRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.NotificationHubName, err = id.PopSegment("notificationHubs"); err != nil {
-		return nil, err
-	}
-	if resourceId.AuthorizationRuleName, err = id.PopSegment("AuthorizationRules"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:        id.Parsed["SubscriptionId"],
+		ResourceGroup:         id.Parsed["ResourceGroup"],
+		NamespaceName:         id.Parsed["NamespaceName"],
+		NotificationHubName:   id.Parsed["NotificationHubName"],
+		AuthorizationRuleName: id.Parsed["AuthorizationRuleName"],
 	}
 
 	return &resourceId, nil
@@ -27,7 +27,7 @@ func (br assignmentBaseResource) createFunc(resourceName, scopeFieldName string)
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.Policy.AssignmentsClient
-			id := parse.NewPolicyAssignmentId(metadata.ResourceData.Get(scopeFieldName).(string), metadata.ResourceData.Get("name").(string))
+			id := parse.NewPolicyAssignmentID(metadata.ResourceData.Get(scopeFieldName).(string), metadata.ResourceData.Get("name").(string))
 			existing, err := client.Get(ctx, id.Scope, id.Name)
 			if err != nil {
 				if !utils.ResponseWasNotFound(existing.Response) {
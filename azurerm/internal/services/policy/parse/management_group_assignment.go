@@ -1,10 +1,12 @@
 package parse
 
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
 import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ManagementGroupAssignmentId struct {
@@ -33,25 +35,30 @@ func (id ManagementGroupAssignmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.ManagementGroupName, id.PolicyAssignmentName)
 }
 
+// Segments returns the ordered list of Segments which make up a ManagementGroupAssignment ID
+func (id ManagementGroupAssignmentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Management"),
+		resourceid.StaticSegment("managementGroups"),
+		resourceid.UserSpecifiedSegment("ManagementGroupName"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Authorization"),
+		resourceid.StaticSegment("policyAssignments"),
+		resourceid.UserSpecifiedSegment("PolicyAssignmentName"),
+	}
+}
+
 // ManagementGroupAssignmentID parses a ManagementGroupAssignment ID into an ManagementGroupAssignmentId struct
 func ManagementGroupAssignmentID(input string) (*ManagementGroupAssignmentId, error) {
-	// TODO: the generator should support outputting this method too
-	id, err := azure.ParseAzureResourceIDWithoutSubscription(input)
+	id, err := resourceid.NewParser(ManagementGroupAssignmentId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
-	resourceId := ManagementGroupAssignmentId{}
-
-	if resourceId.ManagementGroupName, err = id.PopSegment("managementGroups"); err != nil {
-		return nil, err
-	}
-	if resourceId.PolicyAssignmentName, err = id.PopSegment("policyAssignments"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+	resourceId := ManagementGroupAssignmentId{
+		ManagementGroupName:  id.Parsed["ManagementGroupName"],
+		PolicyAssignmentName: id.Parsed["PolicyAssignmentName"],
 	}
 
 	return &resourceId, nil
@@ -1,5 +1,7 @@
 package parse
 
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
 import (
 	"testing"
 
@@ -9,8 +11,8 @@ import (
 var _ resourceid.Formatter = ManagementGroupAssignmentId{}
 
 func TestManagementGroupAssignmentIDFormatter(t *testing.T) {
-	actual := NewManagementGroupAssignmentID("managementGroup1", "assignment1").ID()
-	expected := "/providers/Microsoft.Management/managementGroups/managementGroup1/providers/Microsoft.Authorization/policyAssignments/assignment1"
+	actual := NewManagementGroupAssignmentID("group1", "assignment1").ID()
+	expected := "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1"
 	if actual != expected {
 		t.Fatalf("Expected %q but got %q", expected, actual)
 	}
@@ -43,28 +45,28 @@ func TestManagementGroupAssignmentID(t *testing.T) {
 
 		{
 			// missing PolicyAssignmentName
-			Input: "/providers/Microsoft.Management/managementGroups/managementGroup1/providers/Microsoft.Authorization/",
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/",
 			Error: true,
 		},
 
 		{
 			// missing value for PolicyAssignmentName
-			Input: "/providers/Microsoft.Management/managementGroups/managementGroup1/providers/Microsoft.Authorization/policyAssignments/",
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyAssignments/",
 			Error: true,
 		},
 
 		{
 			// valid
-			Input: "/providers/Microsoft.Management/managementGroups/managementGroup1/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1",
 			Expected: &ManagementGroupAssignmentId{
-				ManagementGroupName:  "managementGroup1",
+				ManagementGroupName:  "group1",
 				PolicyAssignmentName: "assignment1",
 			},
 		},
 
 		{
 			// upper-cased
-			Input: "/PROVIDERS/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS/MANAGEMENTGROUP1/PROVIDERS/MICROSOFT.AUTHORIZATION/POLICYASSIGNMENTS/ASSIGNMENT1",
+			Input: "/PROVIDERS/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS/GROUP1/PROVIDERS/MICROSOFT.AUTHORIZATION/POLICYASSIGNMENTS/ASSIGNMENT1",
 			Error: true,
 		},
 	}
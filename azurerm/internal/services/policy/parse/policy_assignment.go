@@ -0,0 +1,62 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+type PolicyAssignmentId struct {
+	Scope string
+	Name  string
+}
+
+func NewPolicyAssignmentID(scope, name string) PolicyAssignmentId {
+	return PolicyAssignmentId{
+		Scope: scope,
+		Name:  name,
+	}
+}
+
+func (id PolicyAssignmentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Scope %q", id.Scope),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Policy Assignment", segmentsStr)
+}
+
+func (id PolicyAssignmentId) ID() string {
+	fmtString := "%s/providers/Microsoft.Authorization/policyAssignments/%s"
+	return fmt.Sprintf(fmtString, strings.TrimSuffix(id.Scope, "/"), id.Name)
+}
+
+// Segments returns the ordered list of Segments which make up a PolicyAssignment ID
+func (id PolicyAssignmentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.ScopeSegment("Scope"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Authorization"),
+		resourceid.StaticSegment("policyAssignments").Insensitively(),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
+// PolicyAssignmentID parses a PolicyAssignment ID into an PolicyAssignmentId struct
+func PolicyAssignmentID(input string) (*PolicyAssignmentId, error) {
+	id, err := resourceid.NewParser(PolicyAssignmentId{}).Parse(input, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PolicyAssignmentId{
+		Scope: id.Parsed["Scope"],
+		Name:  id.Parsed["Name"],
+	}
+
+	return &resourceId, nil
+}
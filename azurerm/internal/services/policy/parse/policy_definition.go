@@ -0,0 +1,62 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+type PolicyDefinitionId struct {
+	Scope string
+	Name  string
+}
+
+func NewPolicyDefinitionID(scope, name string) PolicyDefinitionId {
+	return PolicyDefinitionId{
+		Scope: scope,
+		Name:  name,
+	}
+}
+
+func (id PolicyDefinitionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Scope %q", id.Scope),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Policy Definition", segmentsStr)
+}
+
+func (id PolicyDefinitionId) ID() string {
+	fmtString := "%s/providers/Microsoft.Authorization/policyDefinitions/%s"
+	return fmt.Sprintf(fmtString, strings.TrimSuffix(id.Scope, "/"), id.Name)
+}
+
+// Segments returns the ordered list of Segments which make up a PolicyDefinition ID
+func (id PolicyDefinitionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.ScopeSegment("Scope"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Authorization"),
+		resourceid.StaticSegment("policyDefinitions").Insensitively(),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
+// PolicyDefinitionID parses a PolicyDefinition ID into an PolicyDefinitionId struct
+func PolicyDefinitionID(input string) (*PolicyDefinitionId, error) {
+	id, err := resourceid.NewParser(PolicyDefinitionId{}).Parse(input, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PolicyDefinitionId{
+		Scope: id.Parsed["Scope"],
+		Name:  id.Parsed["Name"],
+	}
+
+	return &resourceId, nil
+}
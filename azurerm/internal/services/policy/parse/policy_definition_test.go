@@ -0,0 +1,111 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = PolicyDefinitionId{}
+
+func TestPolicyDefinitionIDFormatter(t *testing.T) {
+	actual := NewPolicyDefinitionID("/subscriptions/12345678-1234-9876-4563-123456789012", "definition1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyDefinitions/definition1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestPolicyDefinitionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *PolicyDefinitionId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyDefinitions/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyDefinitions/definition1",
+			Expected: &PolicyDefinitionId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012",
+				Name:  "definition1",
+			},
+		},
+
+		{
+			// valid at the Scope "/"
+			Input: "/providers/Microsoft.Authorization/policyDefinitions/definition1",
+			Expected: &PolicyDefinitionId{
+				Scope: "/",
+				Name:  "definition1",
+			},
+		},
+
+		{
+			// valid at the Scope "/providers/Microsoft.Management/managementGroups/group1"
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyDefinitions/definition1",
+			Expected: &PolicyDefinitionId{
+				Scope: "/providers/Microsoft.Management/managementGroups/group1",
+				Name:  "definition1",
+			},
+		},
+
+		{
+			// case-insensitive segments
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policydefinitions/definition1",
+			Expected: &PolicyDefinitionId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012",
+				Name:  "definition1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.AUTHORIZATION/POLICYDEFINITIONS/DEFINITION1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := PolicyDefinitionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
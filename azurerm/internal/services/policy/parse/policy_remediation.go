@@ -0,0 +1,62 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+type PolicyRemediationId struct {
+	Scope           string
+	RemediationName string
+}
+
+func NewPolicyRemediationID(scope, remediationName string) PolicyRemediationId {
+	return PolicyRemediationId{
+		Scope:           scope,
+		RemediationName: remediationName,
+	}
+}
+
+func (id PolicyRemediationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Remediation Name %q", id.RemediationName),
+		fmt.Sprintf("Scope %q", id.Scope),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Policy Remediation", segmentsStr)
+}
+
+func (id PolicyRemediationId) ID() string {
+	fmtString := "%s/providers/Microsoft.PolicyInsights/remediations/%s"
+	return fmt.Sprintf(fmtString, strings.TrimSuffix(id.Scope, "/"), id.RemediationName)
+}
+
+// Segments returns the ordered list of Segments which make up a PolicyRemediation ID
+func (id PolicyRemediationId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.ScopeSegment("Scope"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.PolicyInsights"),
+		resourceid.StaticSegment("remediations").Insensitively(),
+		resourceid.UserSpecifiedSegment("RemediationName"),
+	}
+}
+
+// PolicyRemediationID parses a PolicyRemediation ID into an PolicyRemediationId struct
+func PolicyRemediationID(input string) (*PolicyRemediationId, error) {
+	id, err := resourceid.NewParser(PolicyRemediationId{}).Parse(input, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PolicyRemediationId{
+		Scope:           id.Parsed["Scope"],
+		RemediationName: id.Parsed["RemediationName"],
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,120 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = PolicyRemediationId{}
+
+func TestPolicyRemediationIDFormatter(t *testing.T) {
+	actual := NewPolicyRemediationID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1", "remediation1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.PolicyInsights/remediations/remediation1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestPolicyRemediationID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *PolicyRemediationId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing RemediationName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.PolicyInsights/",
+			Error: true,
+		},
+
+		{
+			// missing value for RemediationName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.PolicyInsights/remediations/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.PolicyInsights/remediations/remediation1",
+			Expected: &PolicyRemediationId{
+				Scope:           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+				RemediationName: "remediation1",
+			},
+		},
+
+		{
+			// valid at the Scope "/providers/Microsoft.Management/managementGroups/group1"
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.PolicyInsights/remediations/remediation1",
+			Expected: &PolicyRemediationId{
+				Scope:           "/providers/Microsoft.Management/managementGroups/group1",
+				RemediationName: "remediation1",
+			},
+		},
+
+		{
+			// valid at the Scope "/subscriptions/12345678-1234-9876-4563-123456789012"
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.PolicyInsights/remediations/remediation1",
+			Expected: &PolicyRemediationId{
+				Scope:           "/subscriptions/12345678-1234-9876-4563-123456789012",
+				RemediationName: "remediation1",
+			},
+		},
+
+		{
+			// valid at the Scope "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/vm1"
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/vm1/providers/Microsoft.PolicyInsights/remediations/remediation1",
+			Expected: &PolicyRemediationId{
+				Scope:           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/vm1",
+				RemediationName: "remediation1",
+			},
+		},
+
+		{
+			// case-insensitive segments
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.PolicyInsights/remediations/remediation1",
+			Expected: &PolicyRemediationId{
+				Scope:           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+				RemediationName: "remediation1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.POLICYINSIGHTS/REMEDIATIONS/REMEDIATION1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := PolicyRemediationID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.RemediationName != v.Expected.RemediationName {
+			t.Fatalf("Expected %q but got %q for RemediationName", v.Expected.RemediationName, actual.RemediationName)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+type PolicySetDefinitionId struct {
+	Scope string
+	Name  string
+}
+
+func NewPolicySetDefinitionID(scope, name string) PolicySetDefinitionId {
+	return PolicySetDefinitionId{
+		Scope: scope,
+		Name:  name,
+	}
+}
+
+func (id PolicySetDefinitionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Scope %q", id.Scope),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Policy Set Definition", segmentsStr)
+}
+
+func (id PolicySetDefinitionId) ID() string {
+	fmtString := "%s/providers/Microsoft.Authorization/policySetDefinitions/%s"
+	return fmt.Sprintf(fmtString, strings.TrimSuffix(id.Scope, "/"), id.Name)
+}
+
+// Segments returns the ordered list of Segments which make up a PolicySetDefinition ID
+func (id PolicySetDefinitionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.ScopeSegment("Scope"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Authorization"),
+		resourceid.StaticSegment("policySetDefinitions").Insensitively(),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
+// PolicySetDefinitionID parses a PolicySetDefinition ID into an PolicySetDefinitionId struct
+func PolicySetDefinitionID(input string) (*PolicySetDefinitionId, error) {
+	id, err := resourceid.NewParser(PolicySetDefinitionId{}).Parse(input, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PolicySetDefinitionId{
+		Scope: id.Parsed["Scope"],
+		Name:  id.Parsed["Name"],
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,111 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = PolicySetDefinitionId{}
+
+func TestPolicySetDefinitionIDFormatter(t *testing.T) {
+	actual := NewPolicySetDefinitionID("/subscriptions/12345678-1234-9876-4563-123456789012", "setDefinition1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policySetDefinitions/setDefinition1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestPolicySetDefinitionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *PolicySetDefinitionId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policySetDefinitions/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policySetDefinitions/setDefinition1",
+			Expected: &PolicySetDefinitionId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012",
+				Name:  "setDefinition1",
+			},
+		},
+
+		{
+			// valid at the Scope "/"
+			Input: "/providers/Microsoft.Authorization/policySetDefinitions/setDefinition1",
+			Expected: &PolicySetDefinitionId{
+				Scope: "/",
+				Name:  "setDefinition1",
+			},
+		},
+
+		{
+			// valid at the Scope "/providers/Microsoft.Management/managementGroups/group1"
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policySetDefinitions/setDefinition1",
+			Expected: &PolicySetDefinitionId{
+				Scope: "/providers/Microsoft.Management/managementGroups/group1",
+				Name:  "setDefinition1",
+			},
+		},
+
+		{
+			// case-insensitive segments
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policysetdefinitions/setDefinition1",
+			Expected: &PolicySetDefinitionId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012",
+				Name:  "setDefinition1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.AUTHORIZATION/POLICYSETDEFINITIONS/SETDEFINITION1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := PolicySetDefinitionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
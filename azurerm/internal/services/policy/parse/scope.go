@@ -90,3 +90,29 @@ func isManagementGroupId(input string) bool {
 	_, err := parseMgmtGroup.ManagementGroupID(input)
 	return err == nil
 }
+
+// policyScopeIdForScope returns the typed Policy Scope for the specified Scope - which is nil for the Tenant (Root) Scope
+func policyScopeIdForScope(scope string) (PolicyScopeId, error) {
+	if scope == "" || scope == "/" {
+		return nil, nil
+	}
+
+	return PolicyScopeID(scope)
+}
+
+// PolicyScopeId returns the typed Scope which this Policy Definition is defined within - which is nil for
+// Built-In Policy Definitions
+func (id PolicyDefinitionId) PolicyScopeId() (PolicyScopeId, error) {
+	return policyScopeIdForScope(id.Scope)
+}
+
+// PolicyScopeId returns the typed Scope which this Policy Set Definition is defined within - which is nil for
+// Built-In Policy Set Definitions
+func (id PolicySetDefinitionId) PolicyScopeId() (PolicyScopeId, error) {
+	return policyScopeIdForScope(id.Scope)
+}
+
+// PolicyScopeId returns the typed Scope which this Policy Remediation is defined within
+func (id PolicyRemediationId) PolicyScopeId() (PolicyScopeId, error) {
+	return PolicyScopeID(id.Scope)
+}
@@ -96,3 +96,94 @@ func TestPolicyScopeID(t *testing.T) {
 		}
 	}
 }
+
+func TestPolicyDefinitionPolicyScopeId(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected PolicyScopeId
+	}{
+		{
+			Name:     "built-in policy definition",
+			Input:    "/providers/Microsoft.Authorization/policyDefinitions/00000000-0000-0000-0000-000000000000",
+			Expected: nil,
+		},
+		{
+			Name:  "policy definition in a subscription",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyDefinitions/def1",
+			Expected: ScopeAtSubscription{
+				scopeId:        "/subscriptions/00000000-0000-0000-0000-000000000000",
+				SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			Name:  "policy definition in a management group",
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyDefinitions/def1",
+			Expected: ScopeAtManagementGroup{
+				scopeId:             "/providers/Microsoft.Management/managementGroups/group1",
+				ManagementGroupName: "group1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		id, err := PolicyDefinitionID(v.Input)
+		if err != nil {
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		actual, err := id.PolicyScopeId()
+		if err != nil {
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestPolicyRemediationPolicyScopeId(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected PolicyScopeId
+	}{
+		{
+			Name:  "remediation in a resource group",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.policyinsights/remediations/remediation1",
+			Expected: ScopeAtResourceGroup{
+				scopeId:        "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1",
+				SubscriptionId: "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:  "group1",
+			},
+		},
+		{
+			Name:  "remediation in a resource",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1/providers/Microsoft.PolicyInsights/remediations/remediation1",
+			Expected: ScopeAtResource{
+				scopeId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		id, err := PolicyRemediationID(v.Input)
+		if err != nil {
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		actual, err := id.PolicyScopeId()
+		if err != nil {
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SubscriptionAssignmentId struct {
@@ -34,27 +34,28 @@ func (id SubscriptionAssignmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.PolicyAssignmentName)
 }
 
+// Segments returns the ordered list of Segments which make up a SubscriptionAssignment ID
+func (id SubscriptionAssignmentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Authorization"),
+		resourceid.StaticSegment("policyAssignments"),
+		resourceid.UserSpecifiedSegment("PolicyAssignmentName"),
+	}
+}
+
 // SubscriptionAssignmentID parses a SubscriptionAssignment ID into an SubscriptionAssignmentId struct
 func SubscriptionAssignmentID(input string) (*SubscriptionAssignmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(SubscriptionAssignmentId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SubscriptionAssignmentId{
-		SubscriptionId: id.SubscriptionID,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.PolicyAssignmentName, err = id.PopSegment("policyAssignments"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:       id.Parsed["SubscriptionId"],
+		PolicyAssignmentName: id.Parsed["PolicyAssignmentName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualMachineConfigurationPolicyAssignmentId struct {
@@ -40,35 +40,36 @@ func (id VirtualMachineConfigurationPolicyAssignmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName, id.GuestConfigurationAssignmentName)
 }
 
+// Segments returns the ordered list of Segments which make up a VirtualMachineConfigurationPolicyAssignment ID
+func (id VirtualMachineConfigurationPolicyAssignmentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Compute"),
+		resourceid.StaticSegment("virtualMachines"),
+		resourceid.UserSpecifiedSegment("VirtualMachineName"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.GuestConfiguration"),
+		resourceid.StaticSegment("guestConfigurationAssignments"),
+		resourceid.UserSpecifiedSegment("GuestConfigurationAssignmentName"),
+	}
+}
+
 // VirtualMachineConfigurationPolicyAssignmentID parses a VirtualMachineConfigurationPolicyAssignment ID into an VirtualMachineConfigurationPolicyAssignmentId struct
 func VirtualMachineConfigurationPolicyAssignmentID(input string) (*VirtualMachineConfigurationPolicyAssignmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(VirtualMachineConfigurationPolicyAssignmentId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VirtualMachineConfigurationPolicyAssignmentId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VirtualMachineName, err = id.PopSegment("virtualMachines"); err != nil {
-		return nil, err
-	}
-	if resourceId.GuestConfigurationAssignmentName, err = id.PopSegment("guestConfigurationAssignments"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:                   id.Parsed["SubscriptionId"],
+		ResourceGroup:                    id.Parsed["ResourceGroup"],
+		VirtualMachineName:               id.Parsed["VirtualMachineName"],
+		GuestConfigurationAssignmentName: id.Parsed["GuestConfigurationAssignmentName"],
 	}
 
 	return &resourceId, nil
@@ -81,50 +82,16 @@ func VirtualMachineConfigurationPolicyAssignmentID(input string) (*VirtualMachin
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func VirtualMachineConfigurationPolicyAssignmentIDInsensitively(input string) (*VirtualMachineConfigurationPolicyAssignmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(VirtualMachineConfigurationPolicyAssignmentId{}).Parse(input, true)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VirtualMachineConfigurationPolicyAssignmentId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	// find the correct casing for the 'virtualMachines' segment
-	virtualMachinesKey := "virtualMachines"
-	for key := range id.Path {
-		if strings.EqualFold(key, virtualMachinesKey) {
-			virtualMachinesKey = key
-			break
-		}
-	}
-	if resourceId.VirtualMachineName, err = id.PopSegment(virtualMachinesKey); err != nil {
-		return nil, err
-	}
-
-	// find the correct casing for the 'guestConfigurationAssignments' segment
-	guestConfigurationAssignmentsKey := "guestConfigurationAssignments"
-	for key := range id.Path {
-		if strings.EqualFold(key, guestConfigurationAssignmentsKey) {
-			guestConfigurationAssignmentsKey = key
-			break
-		}
-	}
-	if resourceId.GuestConfigurationAssignmentName, err = id.PopSegment(guestConfigurationAssignmentsKey); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:                   id.Parsed["SubscriptionId"],
+		ResourceGroup:                    id.Parsed["ResourceGroup"],
+		VirtualMachineName:               id.Parsed["VirtualMachineName"],
+		GuestConfigurationAssignmentName: id.Parsed["GuestConfigurationAssignmentName"],
 	}
 
 	return &resourceId, nil
@@ -147,7 +147,7 @@ func resourceArmPolicyAssignmentCreate(d *pluginsdk.ResourceData, meta interface
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := parse.NewPolicyAssignmentId(d.Get("scope").(string), d.Get("name").(string))
+	id := parse.NewPolicyAssignmentID(d.Get("scope").(string), d.Get("name").(string))
 
 	existing, err := client.Get(ctx, id.Scope, id.Name)
 	if err != nil {
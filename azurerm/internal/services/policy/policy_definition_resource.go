@@ -244,8 +244,13 @@ func resourceArmPolicyDefinitionRead(d *pluginsdk.ResourceData, meta interface{}
 		return err
 	}
 
+	policyScopeId, err := id.PolicyScopeId()
+	if err != nil {
+		return err
+	}
+
 	managementGroupName := ""
-	switch scopeId := id.PolicyScopeId.(type) { // nolint gocritic
+	switch scopeId := policyScopeId.(type) { // nolint gocritic
 	case parse.ScopeAtManagementGroup:
 		managementGroupName = scopeId.ManagementGroupName
 	}
@@ -299,8 +304,13 @@ func resourceArmPolicyDefinitionDelete(d *pluginsdk.ResourceData, meta interface
 		return err
 	}
 
+	policyScopeId, err := id.PolicyScopeId()
+	if err != nil {
+		return err
+	}
+
 	managementGroupName := ""
-	switch scopeId := id.PolicyScopeId.(type) { // nolint gocritic
+	switch scopeId := policyScopeId.(type) { // nolint gocritic
 	case parse.ScopeAtManagementGroup:
 		managementGroupName = scopeId.ManagementGroupName
 	}
@@ -127,8 +127,13 @@ func (r PolicyDefinitionResource) Exists(ctx context.Context, client *clients.Cl
 		return nil, err
 	}
 
+	scopeId, err := id.PolicyScopeId()
+	if err != nil {
+		return nil, err
+	}
+
 	var resp policy.Definition
-	switch scope := id.PolicyScopeId.(type) {
+	switch scope := scopeId.(type) {
 	case parse.ScopeAtSubscription:
 		resp, err = definitionsClient.Get(ctx, id.Name)
 	case parse.ScopeAtManagementGroup:
@@ -164,18 +164,23 @@ func resourceArmPolicyRemediationRead(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("reading Policy Remediation: %+v", err)
 	}
 
-	resp, err := RemediationGetAtScope(ctx, client, id.Name, id.PolicyScopeId)
+	scopeId, err := id.PolicyScopeId()
+	if err != nil {
+		return fmt.Errorf("reading Policy Remediation: %+v", err)
+	}
+
+	resp, err := RemediationGetAtScope(ctx, client, id.RemediationName, scopeId)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[INFO] Policy Remediation %q does not exist - removing from state", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("reading Policy Remediation %q (Scope %q): %+v", id.Name, id.ScopeId(), err)
+		return fmt.Errorf("reading Policy Remediation %q (Scope %q): %+v", id.RemediationName, id.Scope, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("scope", id.ScopeId())
+	d.Set("name", id.RemediationName)
+	d.Set("scope", id.Scope)
 
 	if props := resp.RemediationProperties; props != nil {
 		locations := []interface{}{}
@@ -204,52 +209,57 @@ func resourceArmPolicyRemediationDelete(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
+	scopeId, err := id.PolicyScopeId()
+	if err != nil {
+		return err
+	}
+
 	// we have to cancel the remediation first before deleting it when the resource_discovery_mode is set to ReEvaluateCompliance
 	// therefore we first retrieve the remediation to see if the resource_discovery_mode is switched to ReEvaluateCompliance
-	existing, err := RemediationGetAtScope(ctx, client, id.Name, id.PolicyScopeId)
+	existing, err := RemediationGetAtScope(ctx, client, id.RemediationName, scopeId)
 	if err != nil {
 		if utils.ResponseWasNotFound(existing.Response) {
 			return nil
 		}
-		return fmt.Errorf("retrieving Policy Remediation %q (Scope %q): %+v", id.Name, id.ScopeId(), err)
+		return fmt.Errorf("retrieving Policy Remediation %q (Scope %q): %+v", id.RemediationName, id.Scope, err)
 	}
 
 	if existing.RemediationProperties != nil && existing.RemediationProperties.ResourceDiscoveryMode == policyinsights.ReEvaluateCompliance {
 		log.Printf("[DEBUG] cancelling the remediation first before deleting it when `resource_discovery_mode` is set to `ReEvaluateCompliance`")
-		if err := cancelRemediation(ctx, client, id.Name, id.PolicyScopeId); err != nil {
-			return fmt.Errorf("cancelling Policy Remediation %q (Scope %q): %+v", id.Name, id.ScopeId(), err)
+		if err := cancelRemediation(ctx, client, id.RemediationName, scopeId); err != nil {
+			return fmt.Errorf("cancelling Policy Remediation %q (Scope %q): %+v", id.RemediationName, id.Scope, err)
 		}
 
-		log.Printf("[DEBUG] waiting for the Policy Remediation %q (Scope %q) to be canceled", id.Name, id.ScopeId())
+		log.Printf("[DEBUG] waiting for the Policy Remediation %q (Scope %q) to be canceled", id.RemediationName, id.Scope)
 		stateConf := &pluginsdk.StateChangeConf{
 			Pending: []string{"Cancelling"},
 			Target: []string{
 				"Succeeded", "Canceled", "Failed",
 			},
-			Refresh:    policyRemediationCancellationRefreshFunc(ctx, client, id.Name, id.PolicyScopeId),
+			Refresh:    policyRemediationCancellationRefreshFunc(ctx, client, id.RemediationName, scopeId),
 			MinTimeout: 10 * time.Second,
 			Timeout:    d.Timeout(pluginsdk.TimeoutDelete),
 		}
 
 		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-			return fmt.Errorf("waiting for Policy Remediation %q to be canceled: %+v", id.Name, err)
+			return fmt.Errorf("waiting for Policy Remediation %q to be canceled: %+v", id.RemediationName, err)
 		}
 	}
 
-	switch scope := id.PolicyScopeId.(type) {
+	switch scope := scopeId.(type) {
 	case parse.ScopeAtSubscription:
-		_, err = client.DeleteAtSubscription(ctx, scope.SubscriptionId, id.Name)
+		_, err = client.DeleteAtSubscription(ctx, scope.SubscriptionId, id.RemediationName)
 	case parse.ScopeAtResourceGroup:
-		_, err = client.DeleteAtResourceGroup(ctx, scope.SubscriptionId, scope.ResourceGroup, id.Name)
+		_, err = client.DeleteAtResourceGroup(ctx, scope.SubscriptionId, scope.ResourceGroup, id.RemediationName)
 	case parse.ScopeAtResource:
-		_, err = client.DeleteAtResource(ctx, scope.ScopeId(), id.Name)
+		_, err = client.DeleteAtResource(ctx, scope.ScopeId(), id.RemediationName)
 	case parse.ScopeAtManagementGroup:
-		_, err = client.DeleteAtManagementGroup(ctx, scope.ManagementGroupName, id.Name)
+		_, err = client.DeleteAtManagementGroup(ctx, scope.ManagementGroupName, id.RemediationName)
 	default:
-		return fmt.Errorf("deleting Policy Remediation %q: invalid scope type", id.Name)
+		return fmt.Errorf("deleting Policy Remediation %q: invalid scope type", id.RemediationName)
 	}
 	if err != nil {
-		return fmt.Errorf("deleting Policy Remediation %q (Scope %q): %+v", id.Name, id.ScopeId(), err)
+		return fmt.Errorf("deleting Policy Remediation %q (Scope %q): %+v", id.RemediationName, id.Scope, err)
 	}
 
 	return nil
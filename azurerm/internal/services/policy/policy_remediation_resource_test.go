@@ -149,7 +149,12 @@ func (r PolicyRemediationResource) Exists(ctx context.Context, client *clients.C
 		return nil, err
 	}
 
-	resp, err := policy.RemediationGetAtScope(ctx, client.Policy.RemediationsClient, id.Name, id.PolicyScopeId)
+	scopeId, err := id.PolicyScopeId()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := policy.RemediationGetAtScope(ctx, client.Policy.RemediationsClient, id.RemediationName, scopeId)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
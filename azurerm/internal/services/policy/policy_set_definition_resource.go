@@ -376,18 +376,23 @@ func resourceArmPolicySetDefinitionUpdate(d *pluginsdk.ResourceData, meta interf
 		return err
 	}
 
+	policyScopeId, err := id.PolicyScopeId()
+	if err != nil {
+		return err
+	}
+
 	managementGroupName := ""
-	if scopeId, ok := id.PolicyScopeId.(parse.ScopeAtManagementGroup); ok {
+	if scopeId, ok := policyScopeId.(parse.ScopeAtManagementGroup); ok {
 		managementGroupName = scopeId.ManagementGroupName
 	}
 
 	// retrieve
 	existing, err := getPolicySetDefinitionByName(ctx, client, id.Name, managementGroupName)
 	if err != nil {
-		return fmt.Errorf("retrieving Policy Set Definition %q (Scope %q): %+v", id.Name, id.ScopeId(), err)
+		return fmt.Errorf("retrieving Policy Set Definition %q (Scope %q): %+v", id.Name, id.Scope, err)
 	}
 	if existing.SetDefinitionProperties == nil {
-		return fmt.Errorf("retrieving Policy Set Definition %q (Scope %q): `properties` was nil", id.Name, id.ScopeId())
+		return fmt.Errorf("retrieving Policy Set Definition %q (Scope %q): `properties` was nil", id.Name, id.Scope)
 	}
 
 	if d.HasChange("policy_type") {
@@ -480,8 +485,13 @@ func resourceArmPolicySetDefinitionRead(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
+	policyScopeId, err := id.PolicyScopeId()
+	if err != nil {
+		return err
+	}
+
 	managementGroupName := ""
-	if scopeId, ok := id.PolicyScopeId.(parse.ScopeAtManagementGroup); ok {
+	if scopeId, ok := policyScopeId.(parse.ScopeAtManagementGroup); ok {
 		managementGroupName = scopeId.ManagementGroupName
 	}
 
@@ -558,8 +568,13 @@ func resourceArmPolicySetDefinitionDelete(d *pluginsdk.ResourceData, meta interf
 		return err
 	}
 
+	policyScopeId, err := id.PolicyScopeId()
+	if err != nil {
+		return err
+	}
+
 	managementGroupName := ""
-	if scopeId, ok := id.PolicyScopeId.(parse.ScopeAtManagementGroup); ok {
+	if scopeId, ok := policyScopeId.(parse.ScopeAtManagementGroup); ok {
 		managementGroupName = scopeId.ManagementGroupName
 	}
 
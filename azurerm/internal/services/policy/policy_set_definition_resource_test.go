@@ -972,8 +972,13 @@ func (r PolicySetDefinitionResource) Exists(ctx context.Context, client *clients
 		return nil, err
 	}
 
+	scopeId, err := id.PolicyScopeId()
+	if err != nil {
+		return nil, err
+	}
+
 	var resp policy.SetDefinition
-	if mgmtGroupID, ok := id.PolicyScopeId.(parse.ScopeAtManagementGroup); ok {
+	if mgmtGroupID, ok := scopeId.(parse.ScopeAtManagementGroup); ok {
 		resp, err = client.Policy.SetDefinitionsClient.GetAtManagementGroup(ctx, id.Name, mgmtGroupID.ManagementGroupName)
 	} else {
 		resp, err = client.Policy.SetDefinitionsClient.Get(ctx, id.Name)
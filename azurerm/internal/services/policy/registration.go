@@ -36,6 +36,18 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+// ResourceProviderNamespace returns the Resource Provider Namespace used by the specified Resource Type
+func (r Registration) ResourceProviderNamespace(resourceType string) string {
+	switch resourceType {
+	case "azurerm_policy_remediation":
+		return "Microsoft.PolicyInsights"
+	case "azurerm_policy_virtual_machine_configuration_assignment", "azurerm_virtual_machine_configuration_policy_assignment":
+		return "Microsoft.GuestConfiguration"
+	}
+
+	return "Microsoft.Authorization"
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
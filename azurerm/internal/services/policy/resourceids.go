@@ -1,5 +1,10 @@
 package policy
 
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ManagementGroupAssignment -id=/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PolicyAssignment -id=/{scope}/providers/Microsoft.Authorization/policyAssignments/assignment1 -scopes=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1,/subscriptions/12345678-1234-9876-4563-123456789012,/providers/Microsoft.Management/managementGroups/group1,/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/vm1 -insensitive=policyAssignments
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PolicyDefinition -id=/{scope}/providers/Microsoft.Authorization/policyDefinitions/definition1 -scopes=/subscriptions/12345678-1234-9876-4563-123456789012,/providers/Microsoft.Management/managementGroups/group1,/ -insensitive=policyDefinitions
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PolicyRemediation -id=/{scope}/providers/Microsoft.PolicyInsights/remediations/remediation1 -scopes=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1,/subscriptions/12345678-1234-9876-4563-123456789012,/providers/Microsoft.Management/managementGroups/group1,/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/vm1 -insensitive=remediations
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PolicySetDefinition -id=/{scope}/providers/Microsoft.Authorization/policySetDefinitions/setDefinition1 -scopes=/subscriptions/12345678-1234-9876-4563-123456789012,/providers/Microsoft.Management/managementGroups/group1,/ -insensitive=policySetDefinitions
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ResourceGroupAssignment -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/policyAssignments/assignment1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SubscriptionAssignment -id=/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyAssignments/assignment1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=VirtualMachineConfigurationAssignment -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/vm1/providers/Microsoft.GuestConfiguration/guestConfigurationAssignments/assignment1 -rewrite=true
@@ -1,5 +1,7 @@
 package validate
 
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
 import "testing"
 
 func TestManagementGroupAssignmentID(t *testing.T) {
@@ -28,25 +30,25 @@ func TestManagementGroupAssignmentID(t *testing.T) {
 
 		{
 			// missing PolicyAssignmentName
-			Input: "/providers/Microsoft.Management/managementGroups/managementGroup1/providers/Microsoft.Authorization/",
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/",
 			Valid: false,
 		},
 
 		{
 			// missing value for PolicyAssignmentName
-			Input: "/providers/Microsoft.Management/managementGroups/managementGroup1/providers/Microsoft.Authorization/policyAssignments/",
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyAssignments/",
 			Valid: false,
 		},
 
 		{
 			// valid
-			Input: "/providers/Microsoft.Management/managementGroups/managementGroup1/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1",
 			Valid: true,
 		},
 
 		{
 			// upper-cased
-			Input: "/PROVIDERS/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS/MANAGEMENTGROUP1/PROVIDERS/MICROSOFT.AUTHORIZATION/POLICYASSIGNMENTS/ASSIGNMENT1",
+			Input: "/PROVIDERS/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS/GROUP1/PROVIDERS/MICROSOFT.AUTHORIZATION/POLICYASSIGNMENTS/ASSIGNMENT1",
 			Valid: false,
 		},
 	}
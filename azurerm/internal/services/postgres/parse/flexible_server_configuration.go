@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FlexibleServerConfigurationId struct {
@@ -40,35 +40,34 @@ func (id FlexibleServerConfigurationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName, id.ConfigurationName)
 }
 
+// Segments returns the ordered list of Segments which make up a FlexibleServerConfiguration ID
+func (id FlexibleServerConfigurationId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DBforPostgreSQL"),
+		resourceid.StaticSegment("flexibleServers"),
+		resourceid.UserSpecifiedSegment("FlexibleServerName"),
+		resourceid.StaticSegment("configurations"),
+		resourceid.UserSpecifiedSegment("ConfigurationName"),
+	}
+}
+
 // FlexibleServerConfigurationID parses a FlexibleServerConfiguration ID into an FlexibleServerConfigurationId struct
 func FlexibleServerConfigurationID(input string) (*FlexibleServerConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(FlexibleServerConfigurationId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FlexibleServerConfigurationId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.FlexibleServerName, err = id.PopSegment("flexibleServers"); err != nil {
-		return nil, err
-	}
-	if resourceId.ConfigurationName, err = id.PopSegment("configurations"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     id.Parsed["SubscriptionId"],
+		ResourceGroup:      id.Parsed["ResourceGroup"],
+		FlexibleServerName: id.Parsed["FlexibleServerName"],
+		ConfigurationName:  id.Parsed["ConfigurationName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FlexibleServerFirewallRuleId struct {
@@ -40,35 +40,34 @@ func (id FlexibleServerFirewallRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName, id.FirewallRuleName)
 }
 
+// Segments returns the ordered list of Segments which make up a FlexibleServerFirewallRule ID
+func (id FlexibleServerFirewallRuleId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.DBforPostgreSQL"),
+		resourceid.StaticSegment("flexibleServers"),
+		resourceid.UserSpecifiedSegment("FlexibleServerName"),
+		resourceid.StaticSegment("firewallRules"),
+		resourceid.UserSpecifiedSegment("FirewallRuleName"),
+	}
+}
+
 // FlexibleServerFirewallRuleID parses a FlexibleServerFirewallRule ID into an FlexibleServerFirewallRuleId struct
 func FlexibleServerFirewallRuleID(input string) (*FlexibleServerFirewallRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(FlexibleServerFirewallRuleId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FlexibleServerFirewallRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.FlexibleServerName, err = id.PopSegment("flexibleServers"); err != nil {
-		return nil, err
-	}
-	if resourceId.FirewallRuleName, err = id.PopSegment("firewallRules"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     id.Parsed["SubscriptionId"],
+		ResourceGroup:      id.Parsed["ResourceGroup"],
+		FlexibleServerName: id.Parsed["FlexibleServerName"],
+		FirewallRuleName:   id.Parsed["FirewallRuleName"],
 	}
 
 	return &resourceId, nil
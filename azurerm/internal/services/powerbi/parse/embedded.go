@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EmbeddedId struct {
@@ -37,32 +37,31 @@ func (id EmbeddedId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.CapacityName)
 }
 
+// Segments returns the ordered list of Segments which make up a Embedded ID
+func (id EmbeddedId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.PowerBIDedicated"),
+		resourceid.StaticSegment("capacities"),
+		resourceid.UserSpecifiedSegment("CapacityName"),
+	}
+}
+
 // EmbeddedID parses a Embedded ID into an EmbeddedId struct
 func EmbeddedID(input string) (*EmbeddedId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(EmbeddedId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := EmbeddedId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.CapacityName, err = id.PopSegment("capacities"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		CapacityName:   id.Parsed["CapacityName"],
 	}
 
 	return &resourceId, nil
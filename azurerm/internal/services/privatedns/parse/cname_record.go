@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CnameRecordId struct {
@@ -40,35 +40,34 @@ func (id CnameRecordId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PrivateDnsZoneName, id.CNAMEName)
 }
 
+// Segments returns the ordered list of Segments which make up a CnameRecord ID
+func (id CnameRecordId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Network"),
+		resourceid.StaticSegment("privateDnsZones"),
+		resourceid.UserSpecifiedSegment("PrivateDnsZoneName"),
+		resourceid.StaticSegment("CNAME"),
+		resourceid.UserSpecifiedSegment("CNAMEName"),
+	}
+}
+
 // CnameRecordID parses a CnameRecord ID into an CnameRecordId struct
 func CnameRecordID(input string) (*CnameRecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(CnameRecordId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := CnameRecordId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.PrivateDnsZoneName, err = id.PopSegment("privateDnsZones"); err != nil {
-		return nil, err
-	}
-	if resourceId.CNAMEName, err = id.PopSegment("CNAME"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     id.Parsed["SubscriptionId"],
+		ResourceGroup:      id.Parsed["ResourceGroup"],
+		PrivateDnsZoneName: id.Parsed["PrivateDnsZoneName"],
+		CNAMEName:          id.Parsed["CNAMEName"],
 	}
 
 	return &resourceId, nil
@@ -304,7 +304,7 @@ func flattenPrivateDNSZoneSOARecord(input *privatedns.RecordSet) []interface{} {
 
 	metaData := make(map[string]interface{})
 	if input.Metadata != nil {
-		metaData = tags.Flatten(input.Metadata)
+		metaData = utils.FlattenMapStringPtrString(input.Metadata)
 	}
 
 	fqdn := ""
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LinkedServerId struct {
@@ -40,35 +40,34 @@ func (id LinkedServerId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RediName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a LinkedServer ID
+func (id LinkedServerId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Cache"),
+		resourceid.StaticSegment("Redis"),
+		resourceid.UserSpecifiedSegment("RediName"),
+		resourceid.StaticSegment("linkedServers"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // LinkedServerID parses a LinkedServer ID into an LinkedServerId struct
 func LinkedServerID(input string) (*LinkedServerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(LinkedServerId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LinkedServerId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.RediName, err = id.PopSegment("Redis"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("linkedServers"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		RediName:       id.Parsed["RediName"],
+		Name:           id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
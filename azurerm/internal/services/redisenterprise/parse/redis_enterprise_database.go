@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RedisEnterpriseDatabaseId struct {
@@ -40,35 +40,34 @@ func (id RedisEnterpriseDatabaseId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
 }
 
+// Segments returns the ordered list of Segments which make up a RedisEnterpriseDatabase ID
+func (id RedisEnterpriseDatabaseId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Cache"),
+		resourceid.StaticSegment("redisEnterprise"),
+		resourceid.UserSpecifiedSegment("RedisEnterpriseName"),
+		resourceid.StaticSegment("databases"),
+		resourceid.UserSpecifiedSegment("DatabaseName"),
+	}
+}
+
 // RedisEnterpriseDatabaseID parses a RedisEnterpriseDatabase ID into an RedisEnterpriseDatabaseId struct
 func RedisEnterpriseDatabaseID(input string) (*RedisEnterpriseDatabaseId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(RedisEnterpriseDatabaseId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := RedisEnterpriseDatabaseId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.RedisEnterpriseName, err = id.PopSegment("redisEnterprise"); err != nil {
-		return nil, err
-	}
-	if resourceId.DatabaseName, err = id.PopSegment("databases"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      id.Parsed["SubscriptionId"],
+		ResourceGroup:       id.Parsed["ResourceGroup"],
+		RedisEnterpriseName: id.Parsed["RedisEnterpriseName"],
+		DatabaseName:        id.Parsed["DatabaseName"],
 	}
 
 	return &resourceId, nil
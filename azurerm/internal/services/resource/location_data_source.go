@@ -0,0 +1,73 @@
+package resource
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceLocation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceLocationRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"location": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"paired_region": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"availability_zones_supported": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLocationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client)
+	_, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
+
+	// the Regions are looked up from the built-in catalogue, so this doesn't require network access
+	environmentName := client.Account.Environment.Name
+	input := d.Get("location").(string)
+	region, found := location.FindRegion(environmentName, input)
+	if !found {
+		return fmt.Errorf("the Location %q was not found in the Azure Environment %q", input, environmentName)
+	}
+
+	d.SetId(fmt.Sprintf("/subscriptions/%s/locations/%s", client.Account.SubscriptionId, region.Name))
+
+	d.Set("name", region.Name)
+	d.Set("display_name", region.DisplayName)
+	d.Set("paired_region", region.PairedRegion)
+	d.Set("availability_zones_supported", region.SupportsAvailabilityZones)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package resource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type LocationDataSource struct {
+}
+
+func TestAccDataSourceLocation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_location", "test")
+	r := LocationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic("West Europe"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").HasValue("westeurope"),
+				check.That(data.ResourceName).Key("display_name").HasValue("West Europe"),
+				check.That(data.ResourceName).Key("paired_region").HasValue("northeurope"),
+				check.That(data.ResourceName).Key("availability_zones_supported").HasValue("true"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceLocation_notFound(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_location", "test")
+	r := LocationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config:      r.basic("westeurpoe"),
+			ExpectError: regexp.MustCompile("was not found in the Azure Environment"),
+		},
+	})
+}
+
+func (LocationDataSource) basic(location string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_location" "test" {
+  location = %q
+}
+`, location)
+}
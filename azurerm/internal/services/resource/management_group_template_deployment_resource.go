@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
 	mgParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup/parse"
 	mgValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/client"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
@@ -32,6 +33,8 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(templateDeploymentWhatIfCustomizeDiff(managementGroupTemplateDeploymentWhatIf)),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(180 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -94,6 +97,16 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				StateFunc: utils.NormalizeJson,
 			},
 
+			"what_if_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"what_if_fail_on_delete": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
 			"tags": tags.Schema(),
 
 			// Computed
@@ -103,6 +116,8 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
 	}
 }
@@ -191,6 +206,11 @@ func managementGroupTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData,
 		return err
 	}
 
+	if !d.HasChangesExcept(templateDeploymentPlanOnlyKeys...) {
+		// these fields are only used during plan, so there's nothing to deploy
+		return managementGroupTemplateDeploymentResourceRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] Retrieving Management Group Template Deployment %q..", id.DeploymentName)
 	template, err := client.GetAtManagementGroupScope(ctx, id.ManagementGroupName, id.DeploymentName)
 	if err != nil {
@@ -383,3 +403,33 @@ func validateManagementGroupTemplateDeployment(ctx context.Context, id parse.Man
 
 	return nil
 }
+
+func managementGroupTemplateDeploymentWhatIf(ctx context.Context, d *pluginsdk.ResourceDiff, client *client.Client, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+	if !d.NewValueKnown("management_group_id") || !d.NewValueKnown("location") {
+		return nil, nil
+	}
+
+	managementGroupId, err := mgParse.ManagementGroupID(d.Get("management_group_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentName := d.Get("name").(string)
+	parameters := resources.ScopedDeploymentWhatIf{
+		Location:   utils.String(location.Normalize(d.Get("location").(string))),
+		Properties: &properties,
+	}
+	future, err := client.DeploymentsClient.WhatIfAtManagementGroupScope(ctx, managementGroupId.Name, deploymentName, parameters)
+	if err != nil {
+		return nil, fmt.Errorf("requesting What-If for Management Group Template Deployment %q (Management Group %q): %+v", deploymentName, managementGroupId.Name, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.DeploymentsClient.Client); err != nil {
+		return nil, fmt.Errorf("waiting for What-If for Management Group Template Deployment %q (Management Group %q): %+v", deploymentName, managementGroupId.Name, err)
+	}
+	result, err := future.Result(*client.DeploymentsClient)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving What-If result for Management Group Template Deployment %q (Management Group %q): %+v", deploymentName, managementGroupId.Name, err)
+	}
+
+	return &result, nil
+}
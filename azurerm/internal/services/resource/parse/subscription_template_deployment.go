@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SubscriptionTemplateDeploymentId struct {
@@ -34,27 +34,28 @@ func (id SubscriptionTemplateDeploymentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.DeploymentName)
 }
 
+// Segments returns the ordered list of Segments which make up a SubscriptionTemplateDeployment ID
+func (id SubscriptionTemplateDeploymentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Resources"),
+		resourceid.StaticSegment("deployments"),
+		resourceid.UserSpecifiedSegment("DeploymentName"),
+	}
+}
+
 // SubscriptionTemplateDeploymentID parses a SubscriptionTemplateDeployment ID into an SubscriptionTemplateDeploymentId struct
 func SubscriptionTemplateDeploymentID(input string) (*SubscriptionTemplateDeploymentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(SubscriptionTemplateDeploymentId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SubscriptionTemplateDeploymentId{
-		SubscriptionId: id.SubscriptionID,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.DeploymentName, err = id.PopSegment("deployments"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		DeploymentName: id.Parsed["DeploymentName"],
 	}
 
 	return &resourceId, nil
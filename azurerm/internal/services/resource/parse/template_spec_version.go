@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type TemplateSpecVersionId struct {
@@ -40,35 +40,34 @@ func (id TemplateSpecVersionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.TemplateSpecName, id.VersionName)
 }
 
+// Segments returns the ordered list of Segments which make up a TemplateSpecVersion ID
+func (id TemplateSpecVersionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Resources"),
+		resourceid.StaticSegment("templateSpecs"),
+		resourceid.UserSpecifiedSegment("TemplateSpecName"),
+		resourceid.StaticSegment("versions"),
+		resourceid.UserSpecifiedSegment("VersionName"),
+	}
+}
+
 // TemplateSpecVersionID parses a TemplateSpecVersion ID into an TemplateSpecVersionId struct
 func TemplateSpecVersionID(input string) (*TemplateSpecVersionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(TemplateSpecVersionId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := TemplateSpecVersionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.TemplateSpecName, err = id.PopSegment("templateSpecs"); err != nil {
-		return nil, err
-	}
-	if resourceId.VersionName, err = id.PopSegment("versions"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   id.Parsed["SubscriptionId"],
+		ResourceGroup:    id.Parsed["ResourceGroup"],
+		TemplateSpecName: id.Parsed["TemplateSpecName"],
+		VersionName:      id.Parsed["VersionName"],
 	}
 
 	return &resourceId, nil
@@ -24,9 +24,19 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+// ResourceProviderNamespace returns the Resource Provider Namespace used by the specified Resource Type
+func (r Registration) ResourceProviderNamespace(resourceType string) string {
+	if resourceType == "azurerm_management_lock" {
+		return "Microsoft.Authorization"
+	}
+
+	return "Microsoft.Resources"
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
+		"azurerm_location":              dataSourceLocation(),
 		"azurerm_resources":             dataSourceResources(),
 		"azurerm_resource_group":        dataSourceResourceGroup(),
 		"azurerm_template_spec_version": dataSourceTemplateSpecVersion(),
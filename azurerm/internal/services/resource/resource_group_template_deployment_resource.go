@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/client"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
@@ -30,6 +31,8 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(templateDeploymentWhatIfCustomizeDiff(resourceGroupTemplateDeploymentWhatIf, "deployment_mode")),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(180 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -94,6 +97,16 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				StateFunc: utils.NormalizeJson,
 			},
 
+			"what_if_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"what_if_fail_on_delete": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
 			"tags": tags.Schema(),
 
 			// Computed
@@ -103,6 +116,8 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
 	}
 }
@@ -186,6 +201,11 @@ func resourceGroupTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, me
 		return err
 	}
 
+	if !d.HasChangesExcept(templateDeploymentPlanOnlyKeys...) {
+		// these fields are only used during plan, so there's nothing to deploy
+		return resourceGroupTemplateDeploymentResourceRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] Retrieving Template Deployment %q (Resource Group %q)..", id.DeploymentName, id.ResourceGroup)
 	template, err := client.Get(ctx, id.ResourceGroup, id.DeploymentName)
 	if err != nil {
@@ -400,3 +420,39 @@ func validateResourceGroupTemplateDeployment(ctx context.Context, id parse.Resou
 
 	return nil
 }
+
+func resourceGroupTemplateDeploymentWhatIf(ctx context.Context, d *pluginsdk.ResourceDiff, client *client.Client, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+	if !d.NewValueKnown("resource_group_name") || !d.NewValueKnown("deployment_mode") {
+		return nil, nil
+	}
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	deploymentName := d.Get("name").(string)
+
+	// the Resource Group may not exist yet, for example when it's provisioned in the same plan
+	group, err := client.GroupsClient.Get(ctx, resourceGroup)
+	if err != nil {
+		if utils.ResponseWasNotFound(group.Response) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving Resource Group %q: %+v", resourceGroup, err)
+	}
+
+	properties.Mode = resources.DeploymentMode(d.Get("deployment_mode").(string))
+	parameters := resources.DeploymentWhatIf{
+		Properties: &properties,
+	}
+	future, err := client.DeploymentsClient.WhatIf(ctx, resourceGroup, deploymentName, parameters)
+	if err != nil {
+		return nil, fmt.Errorf("requesting What-If for Template Deployment %q (Resource Group %q): %+v", deploymentName, resourceGroup, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.DeploymentsClient.Client); err != nil {
+		return nil, fmt.Errorf("waiting for What-If for Template Deployment %q (Resource Group %q): %+v", deploymentName, resourceGroup, err)
+	}
+	result, err := future.Result(*client.DeploymentsClient)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving What-If result for Template Deployment %q (Resource Group %q): %+v", deploymentName, resourceGroup, err)
+	}
+
+	return &result, nil
+}
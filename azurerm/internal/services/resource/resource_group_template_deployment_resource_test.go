@@ -3,6 +3,7 @@ package resource_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
@@ -257,6 +258,52 @@ func TestAccResourceGroupTemplateDeployment_templateSpecResources(t *testing.T)
 	})
 }
 
+func TestAccResourceGroupTemplateDeployment_whatIf(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_template_deployment", "test")
+	r := ResourceGroupTemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// the Resource Group doesn't exist during the first plan, so What-If isn't run
+			Config: r.singleItemWithWhatIfConfig(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("what_if_enabled", "what_if_changes"),
+		{
+			Config: r.singleItemWithWhatIfConfig(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				// the changes predicted during plan are persisted into the state
+				check.That(data.ResourceName).Key("what_if_changes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("what_if_changes.0.change_type").HasValue("Modify"),
+			),
+		},
+		data.ImportStep("what_if_enabled", "what_if_changes"),
+	})
+}
+
+func TestAccResourceGroupTemplateDeployment_whatIfFailOnDelete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_template_deployment", "test")
+	r := ResourceGroupTemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.singleItemWithPublicIPConfig(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// removing the Public IP from the template in Complete mode would delete it
+			Config:      r.emptyWithWhatIfFailOnDeleteConfig(data),
+			ExpectError: regexp.MustCompile("the What-If operation predicts that this Template Deployment will delete the following resources"),
+		},
+	})
+}
+
 func (t ResourceGroupTemplateDeploymentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ResourceGroupTemplateDeploymentID(state.ID)
 	if err != nil {
@@ -589,6 +636,80 @@ TEMPLATE
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, tagValue)
 }
 
+func (ResourceGroupTemplateDeploymentResource) singleItemWithWhatIfConfig(data acceptance.TestData, tagValue string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Complete"
+  what_if_enabled     = true
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": [
+    {
+      "type": "Microsoft.Network/publicIPAddresses",
+      "apiVersion": "2015-06-15",
+      "name": "acctestpip-%d",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "publicIPAllocationMethod": "Dynamic"
+      },
+      "tags": {
+        "Hello": %q
+      }
+    }
+  ]
+}
+TEMPLATE
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, tagValue)
+}
+
+func (ResourceGroupTemplateDeploymentResource) emptyWithWhatIfFailOnDeleteConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                   = "acctest"
+  resource_group_name    = azurerm_resource_group.test.name
+  deployment_mode        = "Complete"
+  what_if_enabled        = true
+  what_if_fail_on_delete = true
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": []
+}
+TEMPLATE
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (ResourceGroupTemplateDeploymentResource) withOutputsConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/client"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
@@ -30,6 +31,8 @@ func subscriptionTemplateDeploymentResource() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(templateDeploymentWhatIfCustomizeDiff(subscriptionTemplateDeploymentWhatIf)),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(180 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -85,6 +88,16 @@ func subscriptionTemplateDeploymentResource() *pluginsdk.Resource {
 				StateFunc: utils.NormalizeJson,
 			},
 
+			"what_if_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"what_if_fail_on_delete": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
 			"tags": tags.Schema(),
 
 			// Computed
@@ -94,6 +107,8 @@ func subscriptionTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
 	}
 }
@@ -178,6 +193,11 @@ func subscriptionTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, met
 		return err
 	}
 
+	if !d.HasChangesExcept(templateDeploymentPlanOnlyKeys...) {
+		// these fields are only used during plan, so there's nothing to deploy
+		return subscriptionTemplateDeploymentResourceRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] Retrieving Subscription Template Deployment %q..", id.DeploymentName)
 	template, err := client.GetAtSubscriptionScope(ctx, id.DeploymentName)
 	if err != nil {
@@ -366,3 +386,28 @@ func validateSubscriptionTemplateDeployment(ctx context.Context, id parse.Subscr
 
 	return nil
 }
+
+func subscriptionTemplateDeploymentWhatIf(ctx context.Context, d *pluginsdk.ResourceDiff, client *client.Client, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+	if !d.NewValueKnown("location") {
+		return nil, nil
+	}
+
+	deploymentName := d.Get("name").(string)
+	parameters := resources.DeploymentWhatIf{
+		Location:   utils.String(location.Normalize(d.Get("location").(string))),
+		Properties: &properties,
+	}
+	future, err := client.DeploymentsClient.WhatIfAtSubscriptionScope(ctx, deploymentName, parameters)
+	if err != nil {
+		return nil, fmt.Errorf("requesting What-If for Subscription Template Deployment %q: %+v", deploymentName, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.DeploymentsClient.Client); err != nil {
+		return nil, fmt.Errorf("waiting for What-If for Subscription Template Deployment %q: %+v", deploymentName, err)
+	}
+	result, err := future.Result(*client.DeploymentsClient)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving What-If result for Subscription Template Deployment %q: %+v", deploymentName, err)
+	}
+
+	return &result, nil
+}
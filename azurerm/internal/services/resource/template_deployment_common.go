@@ -6,12 +6,16 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"sort"
 	"strings"
+	"sync"
 
 	providers "github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/client"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -156,13 +160,9 @@ func deleteItemsProvisionedByTemplate(ctx context.Context, client *client.Client
 		return fmt.Errorf("determining API Versions for Resource Providers: %+v", err)
 	}
 
-	log.Printf("[DEBUG] Deleting the resources provisioned in this Template..")
-	nestedResources := *properties.OutputResources
-
-	// NOTE: this likely wants splitting out into a parallel loop which retries resources which fail deletion
-	// for example when A depends on B - try deleting them both then loop, and if both subsequentally fail then error
-	// but this seems sufficient for now
-	for _, nestedResource := range nestedResources {
+	apiVersionsForResources := make(map[string]string)
+	resourceIds := make([]string, 0)
+	for _, nestedResource := range *properties.OutputResources {
 		if nestedResource.ID == nil {
 			continue
 		}
@@ -177,25 +177,140 @@ func deleteItemsProvisionedByTemplate(ctx context.Context, client *client.Client
 			return fmt.Errorf("API version information for RP %q was not found", parsedId.Provider)
 		}
 
-		log.Printf("[DEBUG] Deleting Nested Resource %q..", *nestedResource.ID)
-		future, err := resourcesClient.DeleteByID(ctx, *nestedResource.ID, resourceProviderApiVersion)
+		apiVersionsForResources[*nestedResource.ID] = resourceProviderApiVersion
+		resourceIds = append(resourceIds, *nestedResource.ID)
+	}
+
+	log.Printf("[DEBUG] Deleting the resources provisioned in this Template..")
+	deleteFunc := func(ctx context.Context, resourceId string) error {
+		log.Printf("[DEBUG] Deleting Nested Resource %q..", resourceId)
+		future, err := resourcesClient.DeleteByID(ctx, resourceId, apiVersionsForResources[resourceId])
 		if err != nil {
 			if resp := future.Response(); resp != nil && resp.StatusCode == http.StatusNotFound {
-				log.Printf("[DEBUG] Nested Resource %q has been deleted.. continuing..", *nestedResource.ID)
-				continue
+				log.Printf("[DEBUG] Nested Resource %q has been deleted.. continuing..", resourceId)
+				return nil
 			}
-			return fmt.Errorf("deleting Nested Resource %q: %+v", *nestedResource.ID, err)
+			return fmt.Errorf("deleting Nested Resource %q: %+v", resourceId, err)
 		}
 
-		log.Printf("[DEBUG] Waiting for Deletion of Nested Resource %q..", *nestedResource.ID)
+		log.Printf("[DEBUG] Waiting for Deletion of Nested Resource %q..", resourceId)
 		if err := future.WaitForCompletionRef(ctx, resourcesClient.Client); err != nil {
-			return fmt.Errorf("waiting for deletion of Nested Resource %q: %+v", *nestedResource.ID, err)
+			return fmt.Errorf("waiting for deletion of Nested Resource %q: %+v", resourceId, err)
 		}
 
-		log.Printf("[DEBUG] Deleted Nested Resource %q.", *nestedResource.ID)
+		log.Printf("[DEBUG] Deleted Nested Resource %q.", resourceId)
+		return nil
 	}
 
-	return nil
+	return deleteResourcesInDependencyOrder(ctx, resourceIds, templateDeploymentDeletionParallelism, deleteFunc)
+}
+
+// templateDeploymentDeletionParallelism is the maximum number of Nested Resources which are deleted at once
+const templateDeploymentDeletionParallelism = 10
+
+// deleteResourcesInDependencyOrder deletes the specified Resources in rounds, where each round deletes (in parallel,
+// up to the specified parallelism) each of the remaining Resources which don't have any remaining child Resources -
+// as determined from the Resource ID hierarchy - such that child Resources are deleted prior to their parent.
+//
+// Since Resources can also depend on Resources outside of their hierarchy (e.g. a Network Interface depends on a
+// Subnet) any Resources which fail to be deleted are retried in the next round - and we only give up once a round
+// fails to delete any Resources, at which point the error contains each of the Resources which couldn't be deleted.
+func deleteResourcesInDependencyOrder(ctx context.Context, resourceIds []string, parallelism int, deleteFunc func(ctx context.Context, resourceId string) error) error {
+	// the same Resource can be output multiple times, so this is keyed by the (case-insensitive) Resource ID
+	remaining := make(map[string]string)
+	for _, resourceId := range resourceIds {
+		remaining[strings.ToLower(strings.TrimSuffix(resourceId, "/"))] = resourceId
+	}
+
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	failures := make(map[string]error)
+	for round := 1; len(remaining) > 0; round++ {
+		toDelete := make([]string, 0)
+		for key := range remaining {
+			if !hasRemainingChildResources(key, remaining) {
+				toDelete = append(toDelete, key)
+			}
+		}
+		sort.Strings(toDelete)
+
+		log.Printf("[DEBUG] Deleting %d of the %d remaining Nested Resources (Round %d)..", len(toDelete), len(remaining), round)
+
+		results := make(map[string]error)
+		resultsLock := &sync.Mutex{}
+		semaphore := make(chan struct{}, parallelism)
+		wg := &sync.WaitGroup{}
+		for _, key := range toDelete {
+			wg.Add(1)
+			go func(key string) {
+				defer wg.Done()
+
+				semaphore <- struct{}{}
+				defer func() { <-semaphore }()
+
+				err := deleteFunc(ctx, remaining[key])
+
+				resultsLock.Lock()
+				results[key] = err
+				resultsLock.Unlock()
+			}(key)
+		}
+		wg.Wait()
+
+		deleted := 0
+		for key, err := range results {
+			if err != nil {
+				log.Printf("[DEBUG] Unable to delete Nested Resource %q in Round %d - will retry: %+v", remaining[key], round, err)
+				failures[key] = err
+				continue
+			}
+
+			delete(failures, key)
+			delete(remaining, key)
+			deleted++
+		}
+
+		if deleted == 0 {
+			// we're not making progress, so there's no point retrying
+			break
+		}
+	}
+
+	if len(remaining) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0)
+	for key := range remaining {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	messages := make([]string, 0)
+	for _, key := range keys {
+		if err, ok := failures[key]; ok {
+			messages = append(messages, fmt.Sprintf("* %s: %+v", remaining[key], err))
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("* %s: child Resources could not be deleted", remaining[key]))
+	}
+
+	return fmt.Errorf("unable to delete %d Nested Resources provisioned by this Template:\n\n%s", len(remaining), strings.Join(messages, "\n"))
+}
+
+// hasRemainingChildResources returns whether any of the remaining Resources (keyed by the lower-cased
+// Resource ID) are children of the specified Resource (in the form of a lower-cased Resource ID)
+func hasRemainingChildResources(key string, remaining map[string]string) bool {
+	for other := range remaining {
+		if strings.HasPrefix(other, key+"/") {
+			return true
+		}
+	}
+
+	return false
 }
 
 func determineResourceProviderAPIVersionsForResources(ctx context.Context, client *providers.ProvidersClient, providers []resources.Provider) (*map[string]string, error) {
@@ -248,3 +363,293 @@ func findApiVersionForResourceType(resourceType string, availableResourceTypes [
 
 	return nil
 }
+
+// templateDeploymentWhatIfFunc runs the What-If operation for a Template Deployment at the relevant scope - which
+// returns nil when the What-If operation can't be run yet (for example when the Resource Group doesn't exist yet, or
+// when the fields defining the scope/mode of the Template Deployment aren't known until apply)
+type templateDeploymentWhatIfFunc func(ctx context.Context, d *pluginsdk.ResourceDiff, client *client.Client, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error)
+
+// templateDeploymentPlanOnlyKeys are the fields which are used during plan (rather than sent to the API), which
+// when changed in isolation don't require the Template Deployment to be re-deployed
+var templateDeploymentPlanOnlyKeys = []string{
+	"what_if_enabled",
+	"what_if_fail_on_delete",
+	"what_if_changes",
+}
+
+func templateDeploymentWhatIfChangesSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"resource_id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"change_type": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"property_changes": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"path": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"change_type": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"before": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"after": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// templateDeploymentWhatIfCustomizeDiff returns a CustomizeDiffFunc which (when `what_if_enabled` is set) runs the
+// What-If operation for the Template Deployment during plan, exposing the predicted changes in `what_if_changes`.
+// The What-If operation is only run when the Template Deployment is going to be (re-)deployed, that is when it's
+// being created or when the template, parameters or any of the specified additional fields have changed.
+//
+// The predicted changes are persisted into the state as planned (so that the result of the apply matches the plan)
+// and are replaced the next time the Template Deployment is re-deployed.
+func templateDeploymentWhatIfCustomizeDiff(whatIf templateDeploymentWhatIfFunc, additionalKeys ...string) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		enabled := d.Get("what_if_enabled").(bool)
+		failOnDelete := d.Get("what_if_fail_on_delete").(bool)
+		if failOnDelete && !enabled {
+			return fmt.Errorf("`what_if_fail_on_delete` can only be set when `what_if_enabled` is set to `true`")
+		}
+		if !enabled {
+			// clear out the changes predicted during a previous plan, since these are no longer relevant
+			if d.HasChange("what_if_enabled") {
+				return d.SetNew("what_if_changes", []interface{}{})
+			}
+
+			return nil
+		}
+
+		keys := append([]string{"template_content", "template_spec_version_id", "parameters_content"}, additionalKeys...)
+		if d.Id() != "" {
+			changed := false
+			for _, key := range keys {
+				if d.HasChange(key) {
+					changed = true
+					break
+				}
+			}
+			if !changed {
+				return nil
+			}
+		}
+
+		// when the What-If operation can't be run the changes aren't known until apply - which isn't allowed
+		// when `what_if_fail_on_delete` is set, since the resources which would be deleted can't be determined
+		skip := func(reason string) error {
+			if failOnDelete {
+				return fmt.Errorf("the What-If operation can't be run to check for deleted resources since %s - `what_if_fail_on_delete` can only be set when the What-If operation can be run during plan", reason)
+			}
+
+			log.Printf("[DEBUG] Skipping the What-If operation since %s", reason)
+			return d.SetNewComputed("what_if_changes")
+		}
+
+		properties := resources.DeploymentWhatIfProperties{
+			Mode: resources.DeploymentModeIncremental,
+			WhatIfSettings: &resources.DeploymentWhatIfSettings{
+				ResultFormat: resources.WhatIfResultFormatFullResourcePayloads,
+			},
+		}
+
+		// when the template or parameters aren't known until apply, neither are the changes
+		if !d.NewValueKnown("template_spec_version_id") {
+			return skip("`template_spec_version_id` isn't known until apply")
+		}
+		if templateSpecVersionId := d.Get("template_spec_version_id").(string); templateSpecVersionId != "" {
+			properties.TemplateLink = &resources.TemplateLink{
+				ID: utils.String(templateSpecVersionId),
+			}
+		} else {
+			if !d.NewValueKnown("template_content") {
+				return skip("`template_content` isn't known until apply")
+			}
+
+			template, err := expandTemplateDeploymentBody(d.Get("template_content").(string))
+			if err != nil {
+				return fmt.Errorf("expanding `template_content`: %+v", err)
+			}
+			properties.Template = template
+		}
+
+		// NOTE: since `parameters_content` is Optional & Computed this isn't known during creation when
+		// omitted - as such we can only skip this for existing Template Deployments
+		if !d.NewValueKnown("parameters_content") && d.Id() != "" {
+			return skip("`parameters_content` isn't known until apply")
+		}
+		if v := d.Get("parameters_content").(string); v != "" {
+			parameters, err := expandTemplateDeploymentBody(v)
+			if err != nil {
+				return fmt.Errorf("expanding `parameters_content`: %+v", err)
+			}
+			properties.Parameters = parameters
+		}
+
+		resourcesClient := meta.(*clients.Client).Resource
+		log.Printf("[DEBUG] Running the What-If operation for this Template Deployment..")
+		result, err := whatIf(ctx, d, resourcesClient, properties)
+		if err == nil && result != nil && result.Error != nil {
+			err = fmt.Errorf("%+v", *result.Error)
+			if result.Error.Message != nil {
+				err = fmt.Errorf("%s", *result.Error.Message)
+			}
+		}
+		if err != nil {
+			if failOnDelete {
+				return fmt.Errorf("running the What-If operation to check for deleted resources: %+v", err)
+			}
+
+			// the What-If operation is best-effort, since this is only a preview of the changes
+			log.Printf("[WARN] Unable to run the What-If operation for this Template Deployment: %+v", err)
+			return d.SetNewComputed("what_if_changes")
+		}
+		if result == nil {
+			return skip("the scope of this Template Deployment either doesn't exist yet or isn't known until apply")
+		}
+
+		changes := make([]resources.WhatIfChange, 0)
+		if result.WhatIfOperationProperties != nil && result.WhatIfOperationProperties.Changes != nil {
+			changes = *result.WhatIfOperationProperties.Changes
+		}
+
+		if failOnDelete {
+			deleted := make([]string, 0)
+			for _, change := range changes {
+				if change.ChangeType == resources.ChangeTypeDelete && change.ResourceID != nil {
+					deleted = append(deleted, *change.ResourceID)
+				}
+			}
+			if len(deleted) > 0 {
+				sort.Strings(deleted)
+				return fmt.Errorf("the What-If operation predicts that this Template Deployment will delete the following resources - since `what_if_fail_on_delete` is set this is not allowed:\n\n* %s", strings.Join(deleted, "\n* "))
+			}
+		}
+
+		flattened, err := flattenTemplateDeploymentWhatIfChanges(changes)
+		if err != nil {
+			return fmt.Errorf("flattening `what_if_changes`: %+v", err)
+		}
+		return d.SetNew("what_if_changes", flattened)
+	}
+}
+
+func flattenTemplateDeploymentWhatIfChanges(input []resources.WhatIfChange) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+
+	for _, change := range input {
+		// resources which aren't changed (or are outside of this template) are omitted to keep the plan readable
+		if change.ChangeType == resources.ChangeTypeNoChange || change.ChangeType == resources.ChangeTypeIgnore {
+			continue
+		}
+
+		resourceId := ""
+		if change.ResourceID != nil {
+			resourceId = *change.ResourceID
+		}
+
+		propertyChanges := make([]interface{}, 0)
+		if change.Delta != nil {
+			flattened, err := flattenTemplateDeploymentWhatIfPropertyChanges("", false, *change.Delta)
+			if err != nil {
+				return nil, fmt.Errorf("flattening the property changes for %q: %+v", resourceId, err)
+			}
+			propertyChanges = flattened
+		}
+
+		output = append(output, map[string]interface{}{
+			"resource_id":      resourceId,
+			"change_type":      string(change.ChangeType),
+			"property_changes": propertyChanges,
+		})
+	}
+
+	return output, nil
+}
+
+// flattenTemplateDeploymentWhatIfPropertyChanges flattens the (nested) property changes into a list of changes
+// using the full path to each property, e.g. `properties.subnets[0].name`
+func flattenTemplateDeploymentWhatIfPropertyChanges(parentPath string, parentIsArray bool, input []resources.WhatIfPropertyChange) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+
+	for _, change := range input {
+		path := ""
+		if change.Path != nil {
+			path = *change.Path
+		}
+		switch {
+		case parentIsArray:
+			path = fmt.Sprintf("%s[%s]", parentPath, path)
+		case parentPath != "":
+			path = fmt.Sprintf("%s.%s", parentPath, path)
+		}
+
+		if change.Children != nil && len(*change.Children) > 0 {
+			children, err := flattenTemplateDeploymentWhatIfPropertyChanges(path, change.PropertyChangeType == resources.PropertyChangeTypeArray, *change.Children)
+			if err != nil {
+				return nil, err
+			}
+			output = append(output, children...)
+			continue
+		}
+
+		before, err := flattenTemplateDeploymentWhatIfValue(change.Before)
+		if err != nil {
+			return nil, fmt.Errorf("flattening the value of %q before: %+v", path, err)
+		}
+		after, err := flattenTemplateDeploymentWhatIfValue(change.After)
+		if err != nil {
+			return nil, fmt.Errorf("flattening the value of %q after: %+v", path, err)
+		}
+
+		output = append(output, map[string]interface{}{
+			"path":        path,
+			"change_type": string(change.PropertyChangeType),
+			"before":      before,
+			"after":       after,
+		})
+	}
+
+	return output, nil
+}
+
+func flattenTemplateDeploymentWhatIfValue(input interface{}) (string, error) {
+	if input == nil {
+		return "", nil
+	}
+
+	// values can be strings, ints, objects etc - so these are exposed as JSON
+	bytes, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes), nil
+}
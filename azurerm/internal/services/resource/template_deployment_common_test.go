@@ -0,0 +1,313 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/client"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestDeleteResourcesInDependencyOrder(t *testing.T) {
+	virtualNetworkId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+	subnetId := virtualNetworkId + "/subnets/subnet1"
+	networkInterfaceId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1"
+	publicIpId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1"
+
+	deleted := make([]string, 0)
+	lock := &sync.Mutex{}
+	deleteFunc := func(ctx context.Context, resourceId string) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		for _, v := range deleted {
+			if v == resourceId {
+				return fmt.Errorf("%q has already been deleted", resourceId)
+			}
+		}
+
+		// the Subnet can't be deleted whilst the Network Interface exists
+		if resourceId == subnetId && !containsString(deleted, networkInterfaceId) {
+			return fmt.Errorf("the Subnet is in use by %q", networkInterfaceId)
+		}
+
+		deleted = append(deleted, resourceId)
+		return nil
+	}
+
+	// the Virtual Network is output multiple times, in differing casing
+	resourceIds := []string{virtualNetworkId, strings.ToUpper(virtualNetworkId), subnetId, networkInterfaceId, publicIpId}
+	if err := deleteResourcesInDependencyOrder(context.TODO(), resourceIds, 2, deleteFunc); err != nil {
+		t.Fatalf("deleting Resources: %+v", err)
+	}
+
+	if len(deleted) != 4 {
+		t.Fatalf("Expected 4 Resources to be deleted but got %d: %+v", len(deleted), deleted)
+	}
+	if !strings.EqualFold(deleted[3], virtualNetworkId) {
+		t.Fatalf("Expected the Virtual Network to be deleted last but got %+v", deleted)
+	}
+}
+
+func TestDeleteResourcesInDependencyOrderNoProgress(t *testing.T) {
+	parentId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+	childId := parentId + "/subnets/subnet1"
+	otherId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1"
+
+	attempts := make(map[string]int)
+	lock := &sync.Mutex{}
+	deleteFunc := func(ctx context.Context, resourceId string) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		attempts[resourceId]++
+		if resourceId == childId {
+			return fmt.Errorf("conflict")
+		}
+
+		return nil
+	}
+
+	err := deleteResourcesInDependencyOrder(context.TODO(), []string{parentId, childId, otherId}, 10, deleteFunc)
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	for _, expected := range []string{parentId, childId, "conflict", "child Resources could not be deleted"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Fatalf("Expected the error to contain %q but got: %+v", expected, err)
+		}
+	}
+	if strings.Contains(err.Error(), otherId) {
+		t.Fatalf("Expected the error not to contain %q but got: %+v", otherId, err)
+	}
+
+	// the first round deletes the unrelated resource, the second round makes no progress
+	if attempts[childId] != 2 {
+		t.Fatalf("Expected the child Resource to be attempted twice but got %d", attempts[childId])
+	}
+	if attempts[parentId] != 0 {
+		t.Fatalf("Expected the parent Resource not to be attempted but got %d", attempts[parentId])
+	}
+}
+
+func containsString(input []string, value string) bool {
+	for _, v := range input {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestFlattenTemplateDeploymentWhatIfChanges(t *testing.T) {
+	resourceId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+	input := []resources.WhatIfChange{
+		{
+			ResourceID: utils.String(resourceId),
+			ChangeType: resources.ChangeTypeModify,
+			Delta: &[]resources.WhatIfPropertyChange{
+				{
+					Path:               utils.String("properties"),
+					PropertyChangeType: resources.PropertyChangeTypeModify,
+					Children: &[]resources.WhatIfPropertyChange{
+						{
+							Path:               utils.String("subnets"),
+							PropertyChangeType: resources.PropertyChangeTypeArray,
+							Children: &[]resources.WhatIfPropertyChange{
+								{
+									Path:               utils.String("0"),
+									PropertyChangeType: resources.PropertyChangeTypeDelete,
+									Before: map[string]interface{}{
+										"name": "subnet1",
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Path:               utils.String("tags.environment"),
+					PropertyChangeType: resources.PropertyChangeTypeModify,
+					Before:             "dev",
+					After:              "prod",
+				},
+			},
+		},
+		{
+			ResourceID: utils.String(resourceId + "/subnets/subnet2"),
+			ChangeType: resources.ChangeTypeNoChange,
+		},
+		{
+			ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1"),
+			ChangeType: resources.ChangeTypeIgnore,
+		},
+	}
+
+	actual, err := flattenTemplateDeploymentWhatIfChanges(input)
+	if err != nil {
+		t.Fatalf("flattening: %+v", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"resource_id": resourceId,
+			"change_type": "Modify",
+			"property_changes": []interface{}{
+				map[string]interface{}{
+					"path":        "properties.subnets[0]",
+					"change_type": "Delete",
+					"before":      `{"name":"subnet1"}`,
+					"after":       "",
+				},
+				map[string]interface{}{
+					"path":        "tags.environment",
+					"change_type": "Modify",
+					"before":      `"dev"`,
+					"after":       `"prod"`,
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestTemplateDeploymentWhatIfCustomizeDiff(t *testing.T) {
+	resourceId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1"
+	testData := []struct {
+		name         string
+		result       *resources.WhatIfOperationResult
+		failOnDelete bool
+		expectError  string
+		expectKnown  bool
+	}{
+		{
+			name: "changes are planned",
+			result: &resources.WhatIfOperationResult{
+				WhatIfOperationProperties: &resources.WhatIfOperationProperties{
+					Changes: &[]resources.WhatIfChange{
+						{
+							ResourceID: utils.String(resourceId),
+							ChangeType: resources.ChangeTypeCreate,
+						},
+					},
+				},
+			},
+			expectKnown: true,
+		},
+		{
+			name:        "skipped when the What-If operation can't be run",
+			result:      nil,
+			expectKnown: false,
+		},
+		{
+			name:         "skipped when the What-If operation can't be run with fail on delete",
+			result:       nil,
+			failOnDelete: true,
+			expectError:  "the What-If operation can't be run to check for deleted resources",
+		},
+		{
+			name: "deleted resources with fail on delete",
+			result: &resources.WhatIfOperationResult{
+				WhatIfOperationProperties: &resources.WhatIfOperationProperties{
+					Changes: &[]resources.WhatIfChange{
+						{
+							ResourceID: utils.String(resourceId),
+							ChangeType: resources.ChangeTypeDelete,
+						},
+					},
+				},
+			},
+			failOnDelete: true,
+			expectError:  "will delete the following resources",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		result := v.result
+		whatIf := func(_ context.Context, _ *pluginsdk.ResourceDiff, _ *client.Client, _ resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+			return result, nil
+		}
+		resource := &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"template_content": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+				},
+				"template_spec_version_id": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+				},
+				"parameters_content": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"what_if_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+				},
+				"what_if_fail_on_delete": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+				},
+				"what_if_changes": templateDeploymentWhatIfChangesSchema(),
+			},
+			CustomizeDiff: pluginsdk.CustomizeDiffShim(templateDeploymentWhatIfCustomizeDiff(whatIf)),
+			Create: func(d *pluginsdk.ResourceData, _ interface{}) error {
+				d.SetId("example")
+				return nil
+			},
+		}
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"template_content":       `{"resources": []}`,
+			"parameters_content":     `{}`,
+			"what_if_enabled":        true,
+			"what_if_fail_on_delete": v.failOnDelete,
+		})
+		diff, err := resource.Diff(context.TODO(), nil, config, &clients.Client{})
+		if v.expectError != "" {
+			if err == nil || !strings.Contains(err.Error(), v.expectError) {
+				t.Fatalf("expected an error containing %q but got %+v", v.expectError, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expected no error but got %+v", err)
+		}
+
+		attr, ok := diff.Attributes["what_if_changes.#"]
+		if !ok {
+			t.Fatalf("expected a diff for `what_if_changes`")
+		}
+		if v.expectKnown && (attr.NewComputed || attr.New != "1") {
+			t.Fatalf("expected `what_if_changes` to contain 1 change but got %+v", attr)
+		}
+		if !v.expectKnown && !attr.NewComputed {
+			t.Fatalf("expected `what_if_changes` to be unknown but got %+v", attr)
+		}
+
+		// the planned changes must be persisted into the state, so that the result of the apply matches the plan
+		state, diags := resource.Apply(context.TODO(), nil, diff, &clients.Client{})
+		if diags.HasError() {
+			t.Fatalf("applying: %+v", diags)
+		}
+		if v.expectKnown && state.Attributes["what_if_changes.#"] != "1" {
+			t.Fatalf("expected the planned `what_if_changes` to be persisted into the state but got %+v", state.Attributes)
+		}
+	}
+}
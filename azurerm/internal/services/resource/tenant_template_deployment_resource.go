@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/client"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
@@ -30,6 +31,8 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(templateDeploymentWhatIfCustomizeDiff(tenantTemplateDeploymentWhatIf)),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(180 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -85,6 +88,16 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 				StateFunc: utils.NormalizeJson,
 			},
 
+			"what_if_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"what_if_fail_on_delete": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
 			"tags": tags.Schema(),
 
 			// Computed
@@ -94,6 +107,8 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
 	}
 }
@@ -177,6 +192,11 @@ func tenantTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
+	if !d.HasChangesExcept(templateDeploymentPlanOnlyKeys...) {
+		// these fields are only used during plan, so there's nothing to deploy
+		return tenantTemplateDeploymentResourceRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] Retrieving Tenant Template Deployment %q..", id.DeploymentName)
 	template, err := client.GetAtTenantScope(ctx, id.DeploymentName)
 	if err != nil {
@@ -367,3 +387,28 @@ func validateTenantTemplateDeployment(ctx context.Context, id parse.TenantTempla
 
 	return nil
 }
+
+func tenantTemplateDeploymentWhatIf(ctx context.Context, d *pluginsdk.ResourceDiff, client *client.Client, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+	if !d.NewValueKnown("location") {
+		return nil, nil
+	}
+
+	deploymentName := d.Get("name").(string)
+	parameters := resources.ScopedDeploymentWhatIf{
+		Location:   utils.String(location.Normalize(d.Get("location").(string))),
+		Properties: &properties,
+	}
+	future, err := client.DeploymentsClient.WhatIfAtTenantScope(ctx, deploymentName, parameters)
+	if err != nil {
+		return nil, fmt.Errorf("requesting What-If for Tenant Template Deployment %q: %+v", deploymentName, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.DeploymentsClient.Client); err != nil {
+		return nil, fmt.Errorf("waiting for What-If for Tenant Template Deployment %q: %+v", deploymentName, err)
+	}
+	result, err := future.Result(*client.DeploymentsClient)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving What-If result for Tenant Template Deployment %q: %+v", deploymentName, err)
+	}
+
+	return &result, nil
+}
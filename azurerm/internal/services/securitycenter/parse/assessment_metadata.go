@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AssessmentMetadataId struct {
@@ -34,27 +34,28 @@ func (id AssessmentMetadataId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.AssessmentMetadataName)
 }
 
+// Segments returns the ordered list of Segments which make up a AssessmentMetadata ID
+func (id AssessmentMetadataId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Security"),
+		resourceid.StaticSegment("assessmentMetadata"),
+		resourceid.UserSpecifiedSegment("AssessmentMetadataName"),
+	}
+}
+
 // AssessmentMetadataID parses a AssessmentMetadata ID into an AssessmentMetadataId struct
 func AssessmentMetadataID(input string) (*AssessmentMetadataId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(AssessmentMetadataId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := AssessmentMetadataId{
-		SubscriptionId: id.SubscriptionID,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.AssessmentMetadataName, err = id.PopSegment("assessmentMetadata"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         id.Parsed["SubscriptionId"],
+		AssessmentMetadataName: id.Parsed["AssessmentMetadataName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SentinelAlertRuleTemplateId struct {
@@ -40,35 +40,36 @@ func (id SentinelAlertRuleTemplateId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.AlertRuleTemplateName)
 }
 
+// Segments returns the ordered list of Segments which make up a SentinelAlertRuleTemplate ID
+func (id SentinelAlertRuleTemplateId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.OperationalInsights"),
+		resourceid.StaticSegment("workspaces"),
+		resourceid.UserSpecifiedSegment("WorkspaceName"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.SecurityInsights"),
+		resourceid.StaticSegment("AlertRuleTemplates"),
+		resourceid.UserSpecifiedSegment("AlertRuleTemplateName"),
+	}
+}
+
 // SentinelAlertRuleTemplateID parses a SentinelAlertRuleTemplate ID into an SentinelAlertRuleTemplateId struct
 func SentinelAlertRuleTemplateID(input string) (*SentinelAlertRuleTemplateId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(SentinelAlertRuleTemplateId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SentinelAlertRuleTemplateId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.AlertRuleTemplateName, err = id.PopSegment("AlertRuleTemplates"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:        id.Parsed["SubscriptionId"],
+		ResourceGroup:         id.Parsed["ResourceGroup"],
+		WorkspaceName:         id.Parsed["WorkspaceName"],
+		AlertRuleTemplateName: id.Parsed["AlertRuleTemplateName"],
 	}
 
 	return &resourceId, nil
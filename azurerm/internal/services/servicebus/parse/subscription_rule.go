@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SubscriptionRuleId struct {
@@ -46,41 +46,40 @@ func (id SubscriptionRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.TopicName, id.SubscriptionName, id.RuleName)
 }
 
+// Segments returns the ordered list of Segments which make up a SubscriptionRule ID
+func (id SubscriptionRuleId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.ServiceBus"),
+		resourceid.StaticSegment("namespaces"),
+		resourceid.UserSpecifiedSegment("NamespaceName"),
+		resourceid.StaticSegment("topics"),
+		resourceid.UserSpecifiedSegment("TopicName"),
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionName"),
+		resourceid.StaticSegment("rules"),
+		resourceid.UserSpecifiedSegment("RuleName"),
+	}
+}
+
 // SubscriptionRuleID parses a SubscriptionRule ID into an SubscriptionRuleId struct
 func SubscriptionRuleID(input string) (*SubscriptionRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(SubscriptionRuleId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SubscriptionRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.TopicName, err = id.PopSegment("topics"); err != nil {
-		return nil, err
-	}
-	if resourceId.SubscriptionName, err = id.PopSegment("subscriptions"); err != nil {
-		return nil, err
-	}
-	if resourceId.RuleName, err = id.PopSegment("rules"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   id.Parsed["SubscriptionId"],
+		ResourceGroup:    id.Parsed["ResourceGroup"],
+		NamespaceName:    id.Parsed["NamespaceName"],
+		TopicName:        id.Parsed["TopicName"],
+		SubscriptionName: id.Parsed["SubscriptionName"],
+		RuleName:         id.Parsed["RuleName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SecretValueId struct {
@@ -40,35 +40,34 @@ func (id SecretValueId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SecretName, id.ValueName)
 }
 
+// Segments returns the ordered list of Segments which make up a SecretValue ID
+func (id SecretValueId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.ServiceFabricMesh"),
+		resourceid.StaticSegment("secrets"),
+		resourceid.UserSpecifiedSegment("SecretName"),
+		resourceid.StaticSegment("values"),
+		resourceid.UserSpecifiedSegment("ValueName"),
+	}
+}
+
 // SecretValueID parses a SecretValue ID into an SecretValueId struct
 func SecretValueID(input string) (*SecretValueId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(SecretValueId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SecretValueId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.SecretName, err = id.PopSegment("secrets"); err != nil {
-		return nil, err
-	}
-	if resourceId.ValueName, err = id.PopSegment("values"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		SecretName:     id.Parsed["SecretName"],
+		ValueName:      id.Parsed["ValueName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SpringCloudAppId struct {
@@ -40,35 +40,34 @@ func (id SpringCloudAppId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.AppName)
 }
 
+// Segments returns the ordered list of Segments which make up a SpringCloudApp ID
+func (id SpringCloudAppId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.AppPlatform"),
+		resourceid.StaticSegment("Spring"),
+		resourceid.UserSpecifiedSegment("SpringName"),
+		resourceid.StaticSegment("apps"),
+		resourceid.UserSpecifiedSegment("AppName"),
+	}
+}
+
 // SpringCloudAppID parses a SpringCloudApp ID into an SpringCloudAppId struct
 func SpringCloudAppID(input string) (*SpringCloudAppId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(SpringCloudAppId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SpringCloudAppId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.SpringName, err = id.PopSegment("Spring"); err != nil {
-		return nil, err
-	}
-	if resourceId.AppName, err = id.PopSegment("apps"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		SpringName:     id.Parsed["SpringName"],
+		AppName:        id.Parsed["AppName"],
 	}
 
 	return &resourceId, nil
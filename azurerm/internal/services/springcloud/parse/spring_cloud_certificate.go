@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SpringCloudCertificateId struct {
@@ -40,35 +40,34 @@ func (id SpringCloudCertificateId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.CertificateName)
 }
 
+// Segments returns the ordered list of Segments which make up a SpringCloudCertificate ID
+func (id SpringCloudCertificateId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.AppPlatform"),
+		resourceid.StaticSegment("Spring"),
+		resourceid.UserSpecifiedSegment("SpringName"),
+		resourceid.StaticSegment("certificates"),
+		resourceid.UserSpecifiedSegment("CertificateName"),
+	}
+}
+
 // SpringCloudCertificateID parses a SpringCloudCertificate ID into an SpringCloudCertificateId struct
 func SpringCloudCertificateID(input string) (*SpringCloudCertificateId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(SpringCloudCertificateId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SpringCloudCertificateId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.SpringName, err = id.PopSegment("Spring"); err != nil {
-		return nil, err
-	}
-	if resourceId.CertificateName, err = id.PopSegment("certificates"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:  id.Parsed["SubscriptionId"],
+		ResourceGroup:   id.Parsed["ResourceGroup"],
+		SpringName:      id.Parsed["SpringName"],
+		CertificateName: id.Parsed["CertificateName"],
 	}
 
 	return &resourceId, nil
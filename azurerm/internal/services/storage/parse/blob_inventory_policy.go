@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BlobInventoryPolicyId struct {
@@ -40,35 +40,34 @@ func (id BlobInventoryPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.InventoryPolicyName)
 }
 
+// Segments returns the ordered list of Segments which make up a BlobInventoryPolicy ID
+func (id BlobInventoryPolicyId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Storage"),
+		resourceid.StaticSegment("storageAccounts"),
+		resourceid.UserSpecifiedSegment("StorageAccountName"),
+		resourceid.StaticSegment("inventoryPolicies"),
+		resourceid.UserSpecifiedSegment("InventoryPolicyName"),
+	}
+}
+
 // BlobInventoryPolicyID parses a BlobInventoryPolicy ID into an BlobInventoryPolicyId struct
 func BlobInventoryPolicyID(input string) (*BlobInventoryPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(BlobInventoryPolicyId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := BlobInventoryPolicyId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.InventoryPolicyName, err = id.PopSegment("inventoryPolicies"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      id.Parsed["SubscriptionId"],
+		ResourceGroup:       id.Parsed["ResourceGroup"],
+		StorageAccountName:  id.Parsed["StorageAccountName"],
+		InventoryPolicyName: id.Parsed["InventoryPolicyName"],
 	}
 
 	return &resourceId, nil
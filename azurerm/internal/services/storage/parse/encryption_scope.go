@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EncryptionScopeId struct {
@@ -40,35 +40,34 @@ func (id EncryptionScopeId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.Name)
 }
 
+// Segments returns the ordered list of Segments which make up a EncryptionScope ID
+func (id EncryptionScopeId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Storage"),
+		resourceid.StaticSegment("storageAccounts"),
+		resourceid.UserSpecifiedSegment("StorageAccountName"),
+		resourceid.StaticSegment("encryptionScopes"),
+		resourceid.UserSpecifiedSegment("Name"),
+	}
+}
+
 // EncryptionScopeID parses a EncryptionScope ID into an EncryptionScopeId struct
 func EncryptionScopeID(input string) (*EncryptionScopeId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(EncryptionScopeId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := EncryptionScopeId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("encryptionScopes"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     id.Parsed["SubscriptionId"],
+		ResourceGroup:      id.Parsed["ResourceGroup"],
+		StorageAccountName: id.Parsed["StorageAccountName"],
+		Name:               id.Parsed["Name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageContainerResourceManagerId struct {
@@ -43,38 +43,37 @@ func (id StorageContainerResourceManagerId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName)
 }
 
+// Segments returns the ordered list of Segments which make up a StorageContainerResourceManager ID
+func (id StorageContainerResourceManagerId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Storage"),
+		resourceid.StaticSegment("storageAccounts"),
+		resourceid.UserSpecifiedSegment("StorageAccountName"),
+		resourceid.StaticSegment("blobServices"),
+		resourceid.UserSpecifiedSegment("BlobServiceName"),
+		resourceid.StaticSegment("containers"),
+		resourceid.UserSpecifiedSegment("ContainerName"),
+	}
+}
+
 // StorageContainerResourceManagerID parses a StorageContainerResourceManager ID into an StorageContainerResourceManagerId struct
 func StorageContainerResourceManagerID(input string) (*StorageContainerResourceManagerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(StorageContainerResourceManagerId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := StorageContainerResourceManagerId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.BlobServiceName, err = id.PopSegment("blobServices"); err != nil {
-		return nil, err
-	}
-	if resourceId.ContainerName, err = id.PopSegment("containers"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     id.Parsed["SubscriptionId"],
+		ResourceGroup:      id.Parsed["ResourceGroup"],
+		StorageAccountName: id.Parsed["StorageAccountName"],
+		BlobServiceName:    id.Parsed["BlobServiceName"],
+		ContainerName:      id.Parsed["ContainerName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageShareResourceManagerId struct {
@@ -43,38 +43,37 @@ func (id StorageShareResourceManagerId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.FileServiceName, id.FileshareName)
 }
 
+// Segments returns the ordered list of Segments which make up a StorageShareResourceManager ID
+func (id StorageShareResourceManagerId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Storage"),
+		resourceid.StaticSegment("storageAccounts"),
+		resourceid.UserSpecifiedSegment("StorageAccountName"),
+		resourceid.StaticSegment("fileServices"),
+		resourceid.UserSpecifiedSegment("FileServiceName"),
+		resourceid.StaticSegment("fileshares"),
+		resourceid.UserSpecifiedSegment("FileshareName"),
+	}
+}
+
 // StorageShareResourceManagerID parses a StorageShareResourceManager ID into an StorageShareResourceManagerId struct
 func StorageShareResourceManagerID(input string) (*StorageShareResourceManagerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(StorageShareResourceManagerId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := StorageShareResourceManagerId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.FileServiceName, err = id.PopSegment("fileServices"); err != nil {
-		return nil, err
-	}
-	if resourceId.FileshareName, err = id.PopSegment("fileshares"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     id.Parsed["SubscriptionId"],
+		ResourceGroup:      id.Parsed["ResourceGroup"],
+		StorageAccountName: id.Parsed["StorageAccountName"],
+		FileServiceName:    id.Parsed["FileServiceName"],
+		FileshareName:      id.Parsed["FileshareName"],
 	}
 
 	return &resourceId, nil
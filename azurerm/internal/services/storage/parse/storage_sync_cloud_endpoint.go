@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageSyncCloudEndpointId struct {
@@ -43,38 +43,37 @@ func (id StorageSyncCloudEndpointId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageSyncServiceName, id.SyncGroupName, id.CloudEndpointName)
 }
 
+// Segments returns the ordered list of Segments which make up a StorageSyncCloudEndpoint ID
+func (id StorageSyncCloudEndpointId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.StorageSync"),
+		resourceid.StaticSegment("storageSyncServices"),
+		resourceid.UserSpecifiedSegment("StorageSyncServiceName"),
+		resourceid.StaticSegment("syncGroups"),
+		resourceid.UserSpecifiedSegment("SyncGroupName"),
+		resourceid.StaticSegment("cloudEndpoints"),
+		resourceid.UserSpecifiedSegment("CloudEndpointName"),
+	}
+}
+
 // StorageSyncCloudEndpointID parses a StorageSyncCloudEndpoint ID into an StorageSyncCloudEndpointId struct
 func StorageSyncCloudEndpointID(input string) (*StorageSyncCloudEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(StorageSyncCloudEndpointId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := StorageSyncCloudEndpointId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.StorageSyncServiceName, err = id.PopSegment("storageSyncServices"); err != nil {
-		return nil, err
-	}
-	if resourceId.SyncGroupName, err = id.PopSegment("syncGroups"); err != nil {
-		return nil, err
-	}
-	if resourceId.CloudEndpointName, err = id.PopSegment("cloudEndpoints"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         id.Parsed["SubscriptionId"],
+		ResourceGroup:          id.Parsed["ResourceGroup"],
+		StorageSyncServiceName: id.Parsed["StorageSyncServiceName"],
+		SyncGroupName:          id.Parsed["SyncGroupName"],
+		CloudEndpointName:      id.Parsed["CloudEndpointName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageSyncGroupId struct {
@@ -40,35 +40,34 @@ func (id StorageSyncGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageSyncServiceName, id.SyncGroupName)
 }
 
+// Segments returns the ordered list of Segments which make up a StorageSyncGroup ID
+func (id StorageSyncGroupId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.StorageSync"),
+		resourceid.StaticSegment("storageSyncServices"),
+		resourceid.UserSpecifiedSegment("StorageSyncServiceName"),
+		resourceid.StaticSegment("syncGroups"),
+		resourceid.UserSpecifiedSegment("SyncGroupName"),
+	}
+}
+
 // StorageSyncGroupID parses a StorageSyncGroup ID into an StorageSyncGroupId struct
 func StorageSyncGroupID(input string) (*StorageSyncGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(StorageSyncGroupId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := StorageSyncGroupId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.StorageSyncServiceName, err = id.PopSegment("storageSyncServices"); err != nil {
-		return nil, err
-	}
-	if resourceId.SyncGroupName, err = id.PopSegment("syncGroups"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         id.Parsed["SubscriptionId"],
+		ResourceGroup:          id.Parsed["ResourceGroup"],
+		StorageSyncServiceName: id.Parsed["StorageSyncServiceName"],
+		SyncGroupName:          id.Parsed["SyncGroupName"],
 	}
 
 	return &resourceId, nil
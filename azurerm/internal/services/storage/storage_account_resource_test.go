@@ -78,6 +78,36 @@ func TestAccStorageAccount_tagCount(t *testing.T) {
 	})
 }
 
+func TestAccStorageAccount_defaultTagsUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account", "test")
+	r := StorageAccountResource{}
+
+	// the Storage Account only sends the `tags` when these change, so this checks that changing
+	// only the Provider's `default_tags` updates the Tags assigned to the Storage Account
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.defaultTags(data, "platform"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+				check.That(data.ResourceName).Key("tags_all.%").HasValue("2"),
+				check.That(data.ResourceName).Key("tags_all.owner").HasValue("platform"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.defaultTags(data, "security"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+				check.That(data.ResourceName).Key("tags_all.%").HasValue("2"),
+				check.That(data.ResourceName).Key("tags_all.owner").HasValue("security"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccStorageAccount_writeLock(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_account", "test")
 	r := StorageAccountResource{}
@@ -1068,6 +1098,38 @@ resource "azurerm_storage_account" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
 
+func (r StorageAccountResource) defaultTags(data acceptance.TestData, owner string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+
+  default_tags {
+    tags = {
+      owner = "%s"
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  tags = {
+    environment = "production"
+  }
+}
+`, owner, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
 func (r StorageAccountResource) tagCount(data acceptance.TestData) string {
 	tags := ""
 	for i := 0; i < 50; i++ {
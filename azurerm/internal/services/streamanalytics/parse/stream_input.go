@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StreamInputId struct {
@@ -40,35 +40,34 @@ func (id StreamInputId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StreamingjobName, id.InputName)
 }
 
+// Segments returns the ordered list of Segments which make up a StreamInput ID
+func (id StreamInputId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.StreamAnalytics"),
+		resourceid.StaticSegment("streamingjobs"),
+		resourceid.UserSpecifiedSegment("StreamingjobName"),
+		resourceid.StaticSegment("inputs"),
+		resourceid.UserSpecifiedSegment("InputName"),
+	}
+}
+
 // StreamInputID parses a StreamInput ID into an StreamInputId struct
 func StreamInputID(input string) (*StreamInputId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(StreamInputId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := StreamInputId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.StreamingjobName, err = id.PopSegment("streamingjobs"); err != nil {
-		return nil, err
-	}
-	if resourceId.InputName, err = id.PopSegment("inputs"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   id.Parsed["SubscriptionId"],
+		ResourceGroup:    id.Parsed["ResourceGroup"],
+		StreamingjobName: id.Parsed["StreamingjobName"],
+		InputName:        id.Parsed["InputName"],
 	}
 
 	return &resourceId, nil
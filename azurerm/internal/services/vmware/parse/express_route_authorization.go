@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ExpressRouteAuthorizationId struct {
@@ -40,35 +40,34 @@ func (id ExpressRouteAuthorizationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PrivateCloudName, id.AuthorizationName)
 }
 
+// Segments returns the ordered list of Segments which make up a ExpressRouteAuthorization ID
+func (id ExpressRouteAuthorizationId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.AVS"),
+		resourceid.StaticSegment("privateClouds"),
+		resourceid.UserSpecifiedSegment("PrivateCloudName"),
+		resourceid.StaticSegment("authorizations"),
+		resourceid.UserSpecifiedSegment("AuthorizationName"),
+	}
+}
+
 // ExpressRouteAuthorizationID parses a ExpressRouteAuthorization ID into an ExpressRouteAuthorizationId struct
 func ExpressRouteAuthorizationID(input string) (*ExpressRouteAuthorizationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(ExpressRouteAuthorizationId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ExpressRouteAuthorizationId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.PrivateCloudName, err = id.PopSegment("privateClouds"); err != nil {
-		return nil, err
-	}
-	if resourceId.AuthorizationName, err = id.PopSegment("authorizations"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:    id.Parsed["SubscriptionId"],
+		ResourceGroup:     id.Parsed["ResourceGroup"],
+		PrivateCloudName:  id.Parsed["PrivateCloudName"],
+		AuthorizationName: id.Parsed["AuthorizationName"],
 	}
 
 	return &resourceId, nil
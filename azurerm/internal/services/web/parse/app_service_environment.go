@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AppServiceEnvironmentId struct {
@@ -37,32 +37,31 @@ func (id AppServiceEnvironmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.HostingEnvironmentName)
 }
 
+// Segments returns the ordered list of Segments which make up a AppServiceEnvironment ID
+func (id AppServiceEnvironmentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Web"),
+		resourceid.StaticSegment("hostingEnvironments"),
+		resourceid.UserSpecifiedSegment("HostingEnvironmentName"),
+	}
+}
+
 // AppServiceEnvironmentID parses a AppServiceEnvironment ID into an AppServiceEnvironmentId struct
 func AppServiceEnvironmentID(input string) (*AppServiceEnvironmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(AppServiceEnvironmentId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := AppServiceEnvironmentId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.HostingEnvironmentName, err = id.PopSegment("hostingEnvironments"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         id.Parsed["SubscriptionId"],
+		ResourceGroup:          id.Parsed["ResourceGroup"],
+		HostingEnvironmentName: id.Parsed["HostingEnvironmentName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AppServicePlanId struct {
@@ -37,32 +37,31 @@ func (id AppServicePlanId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerfarmName)
 }
 
+// Segments returns the ordered list of Segments which make up a AppServicePlan ID
+func (id AppServicePlanId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Web"),
+		resourceid.StaticSegment("serverfarms"),
+		resourceid.UserSpecifiedSegment("ServerfarmName"),
+	}
+}
+
 // AppServicePlanID parses a AppServicePlan ID into an AppServicePlanId struct
 func AppServicePlanID(input string) (*AppServicePlanId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(AppServicePlanId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := AppServicePlanId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServerfarmName, err = id.PopSegment("serverfarms"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		ServerfarmName: id.Parsed["ServerfarmName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FunctionAppSlotId struct {
@@ -40,35 +40,34 @@ func (id FunctionAppSlotId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName)
 }
 
+// Segments returns the ordered list of Segments which make up a FunctionAppSlot ID
+func (id FunctionAppSlotId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("subscriptions"),
+		resourceid.UserSpecifiedSegment("SubscriptionId"),
+		resourceid.StaticSegment("resourceGroups").Insensitively(),
+		resourceid.UserSpecifiedSegment("ResourceGroup"),
+		resourceid.StaticSegment("providers"),
+		resourceid.ResourceProviderSegment("Microsoft.Web"),
+		resourceid.StaticSegment("sites"),
+		resourceid.UserSpecifiedSegment("SiteName"),
+		resourceid.StaticSegment("slots"),
+		resourceid.UserSpecifiedSegment("SlotName"),
+	}
+}
+
 // FunctionAppSlotID parses a FunctionAppSlot ID into an FunctionAppSlotId struct
 func FunctionAppSlotID(input string) (*FunctionAppSlotId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(FunctionAppSlotId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FunctionAppSlotId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
-		return nil, err
-	}
-	if resourceId.SlotName, err = id.PopSegment("slots"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: id.Parsed["SubscriptionId"],
+		ResourceGroup:  id.Parsed["ResourceGroup"],
+		SiteName:       id.Parsed["SiteName"],
+		SlotName:       id.Parsed["SlotName"],
 	}
 
 	return &resourceId, nil
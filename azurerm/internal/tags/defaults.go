@@ -0,0 +1,34 @@
+package tags
+
+// MergeDefaults returns the Tags which should be assigned to a resource - which are the Default Tags
+// (configured in the Provider block) merged with the Tags specified on the resource, where the value
+// specified on the resource takes precedence
+func MergeDefaults(defaults map[string]string, tagsMap map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(defaults)+len(tagsMap))
+	for k, v := range defaults {
+		output[k] = v
+	}
+	for k, v := range tagsMap {
+		output[k] = v
+	}
+
+	return output
+}
+
+// RemoveDefaults returns the Tags with any Default Tags (configured in the Provider block) removed,
+// unless the Tag is also specified on the resource - such that the Default Tags don't show in the
+// diff for the resource's `tags`
+func RemoveDefaults(defaults map[string]string, tagsMap map[string]interface{}, specified map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagsMap))
+	for k, v := range tagsMap {
+		if _, isDefault := defaults[k]; isDefault {
+			if _, isSpecified := specified[k]; !isSpecified {
+				continue
+			}
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
@@ -0,0 +1,85 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeDefaults(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Defaults map[string]string
+		Input    map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name:     "No Defaults",
+			Defaults: nil,
+			Input: map[string]interface{}{
+				"hello": "world",
+			},
+			Expected: map[string]interface{}{
+				"hello": "world",
+			},
+		},
+		{
+			Name: "Defaults Only",
+			Defaults: map[string]string{
+				"env": "prod",
+			},
+			Input: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"env": "prod",
+			},
+		},
+		{
+			Name: "Resource Value Wins",
+			Defaults: map[string]string{
+				"env":   "prod",
+				"owner": "platform",
+			},
+			Input: map[string]interface{}{
+				"env":   "dev",
+				"hello": "world",
+			},
+			Expected: map[string]interface{}{
+				"env":   "dev",
+				"hello": "world",
+				"owner": "platform",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		actual := MergeDefaults(v.Defaults, v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestRemoveDefaults(t *testing.T) {
+	defaults := map[string]string{
+		"env":   "prod",
+		"owner": "platform",
+	}
+	input := map[string]interface{}{
+		"env":   "dev",
+		"hello": "world",
+		"owner": "platform",
+	}
+	specified := map[string]interface{}{
+		"env": "dev",
+	}
+
+	expected := map[string]interface{}{
+		"env":   "dev",
+		"hello": "world",
+	}
+	actual := RemoveDefaults(defaults, input, specified)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
@@ -6,12 +6,15 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 )
 
+// Flatten flattens the Tags returned from the API, removing any Tags which should be ignored
+// (as specified in the `ignore_tags` block in the Provider)
 func Flatten(tagMap map[string]*string) map[string]interface{} {
 	// If tagsMap is nil, len(tagsMap) will be 0.
 	output := make(map[string]interface{}, len(tagMap))
 
+	ignored := Ignored()
 	for i, v := range tagMap {
-		if v == nil {
+		if v == nil || ignored.IsIgnored(i) {
 			continue
 		}
 
@@ -0,0 +1,91 @@
+package tags
+
+import (
+	"strings"
+	"sync"
+)
+
+// IgnoreConfig specifies Tags which are managed outside of Terraform (for example by Azure Policy)
+// and which should be ignored - meaning these are removed when flattening Tags
+type IgnoreConfig struct {
+	// Keys is a list of Tag Keys which should be ignored (compared case-insensitively)
+	Keys []string
+
+	// KeyPrefixes is a list of prefixes for Tag Keys which should be ignored (compared case-insensitively)
+	KeyPrefixes []string
+}
+
+// IsIgnored returns whether the specified Tag Key should be ignored
+func (c *IgnoreConfig) IsIgnored(key string) bool {
+	if c == nil {
+		return false
+	}
+
+	for _, v := range c.Keys {
+		if strings.EqualFold(v, key) {
+			return true
+		}
+	}
+
+	for _, v := range c.KeyPrefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var (
+	ignoreConfigLock = &sync.RWMutex{}
+
+	// ignoreConfig is the IgnoreConfig specified in the Provider block - which is held here
+	// since the Flatten functions are called without access to the Provider's configuration
+	ignoreConfig *IgnoreConfig
+)
+
+// SetIgnoreConfig sets the Tags which should be ignored when flattening Tags
+func SetIgnoreConfig(config *IgnoreConfig) {
+	ignoreConfigLock.Lock()
+	defer ignoreConfigLock.Unlock()
+
+	ignoreConfig = config
+}
+
+// Ignored returns the Tags which should be ignored when flattening Tags - or nil if none are ignored
+func Ignored() *IgnoreConfig {
+	ignoreConfigLock.RLock()
+	defer ignoreConfigLock.RUnlock()
+
+	return ignoreConfig
+}
+
+// RemoveIgnored returns the Tags with any Tags which should be ignored removed
+func RemoveIgnored(tagsMap map[string]interface{}) map[string]interface{} {
+	config := Ignored()
+	output := make(map[string]interface{}, len(tagsMap))
+	for k, v := range tagsMap {
+		if config.IsIgnored(k) {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+// OnlyIgnored returns the Tags which should be ignored from the specified Tags
+func OnlyIgnored(tagMap map[string]*string) map[string]interface{} {
+	config := Ignored()
+	output := make(map[string]interface{})
+	for k, v := range tagMap {
+		if v == nil || !config.IsIgnored(k) {
+			continue
+		}
+
+		output[k] = *v
+	}
+
+	return output
+}
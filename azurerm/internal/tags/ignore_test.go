@@ -0,0 +1,72 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestIgnoreConfigIsIgnored(t *testing.T) {
+	config := &IgnoreConfig{
+		Keys:        []string{"createdOn"},
+		KeyPrefixes: []string{"hidden-"},
+	}
+
+	testData := map[string]bool{
+		"createdOn":      true,
+		"CREATEDON":      true,
+		"createdOnDate":  false,
+		"hidden-link":    true,
+		"Hidden-Title":   true,
+		"not-hidden-tag": false,
+		"env":            false,
+	}
+
+	for key, expected := range testData {
+		if actual := config.IsIgnored(key); actual != expected {
+			t.Fatalf("expected IsIgnored(%q) to be %t but got %t", key, expected, actual)
+		}
+	}
+
+	var disabled *IgnoreConfig
+	if disabled.IsIgnored("createdOn") {
+		t.Fatalf("expected a nil IgnoreConfig not to ignore any tags")
+	}
+}
+
+func TestFlattenWithIgnoredTags(t *testing.T) {
+	SetIgnoreConfig(&IgnoreConfig{
+		Keys:        []string{"createdOn"},
+		KeyPrefixes: []string{"hidden-"},
+	})
+	defer SetIgnoreConfig(nil)
+
+	input := map[string]*string{
+		"createdOn":   utils.String("2021-01-01"),
+		"env":         utils.String("prod"),
+		"hidden-link": utils.String("example"),
+	}
+
+	expected := map[string]interface{}{
+		"env": "prod",
+	}
+	if actual := Flatten(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	expectedTyped := map[string]string{
+		"env": "prod",
+	}
+	if actual := ToTypedObject(input); !reflect.DeepEqual(actual, expectedTyped) {
+		t.Fatalf("expected %+v but got %+v", expectedTyped, actual)
+	}
+
+	expectedIgnored := map[string]interface{}{
+		"createdOn":   "2021-01-01",
+		"hidden-link": "example",
+	}
+	if actual := OnlyIgnored(input); !reflect.DeepEqual(actual, expectedIgnored) {
+		t.Fatalf("expected %+v but got %+v", expectedIgnored, actual)
+	}
+}
@@ -50,3 +50,15 @@ func SchemaEnforceLowerCaseKeys() *pluginsdk.Schema {
 		},
 	}
 }
+
+// SchemaTagsAll returns the Schema used for the `tags_all` attribute, which contains the
+// Tags assigned to the resource - including those inherited from the Provider's `default_tags`
+func SchemaTagsAll() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeMap,
+		Computed: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
+}
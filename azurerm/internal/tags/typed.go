@@ -12,11 +12,14 @@ func FromTypedObject(input map[string]string) map[string]*string {
 	return output
 }
 
+// ToTypedObject flattens the Tags returned from the API, removing any Tags which should be ignored
+// (as specified in the `ignore_tags` block in the Provider)
 func ToTypedObject(input map[string]*string) map[string]string {
 	output := make(map[string]string)
 
+	ignored := Ignored()
 	for k, v := range input {
-		if v == nil {
+		if v == nil || ignored.IsIgnored(k) {
 			continue
 		}
 
@@ -15,6 +15,17 @@ const (
 	TypeSet     = schema.TypeSet
 )
 
+type StringKind = schema.StringKind
+
+const (
+	StringPlain    = schema.StringPlain
+	StringMarkdown = schema.StringMarkdown
+)
+
+// DescriptionKind is the format used for all of the descriptions within the Provider
+// Schema - both those defined in the Schema and those loaded from the documentation
+const DescriptionKind = StringMarkdown
+
 const (
 	SchemaConfigModeAuto  = schema.SchemaConfigModeAuto
 	SchemaConfigModeAttr  = schema.SchemaConfigModeAttr
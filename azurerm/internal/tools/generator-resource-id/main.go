@@ -7,9 +7,12 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 	"unicode"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 var packagesUsingAlias = map[string]struct{}{
@@ -23,6 +26,8 @@ func main() {
 	name := flag.String("name", "", "The name of this Resource Type")
 	id := flag.String("id", "", "An example of this Resource ID")
 	rewrite := flag.Bool("rewrite", false, "Should this Resource ID be parsed insensitively, to workaround an API bug?")
+	scopes := flag.String("scopes", "", "A comma-separated list of example Scopes, for a Resource ID containing a Scope segment (e.g. `/{scope}/providers/...`)")
+	insensitive := flag.String("insensitive", "", "A comma-separated list of the segments in this Resource ID which should always be matched case-insensitively")
 	showHelp := flag.Bool("help", false, "Display this message")
 
 	flag.Parse()
@@ -32,12 +37,22 @@ func main() {
 		return
 	}
 
-	if err := run(*servicePackagePath, *name, *id, *rewrite); err != nil {
+	if err := run(*servicePackagePath, *name, *id, *rewrite, splitList(*scopes), splitList(*insensitive)); err != nil {
 		panic(err)
 	}
 }
 
-func run(servicePackagePath, name, id string, shouldRewrite bool) error {
+func splitList(input string) []string {
+	output := make([]string, 0)
+	for _, v := range strings.Split(input, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			output = append(output, v)
+		}
+	}
+	return output
+}
+
+func run(servicePackagePath, name, id string, shouldRewrite bool, scopeExamples, insensitiveSegments []string) error {
 	servicePackage, err := parseServicePackageName(servicePackagePath)
 	if err != nil {
 		return fmt.Errorf("determining Service Package Name for %q: %+v", servicePackagePath, err)
@@ -59,7 +74,7 @@ func run(servicePackagePath, name, id string, shouldRewrite bool) error {
 		// e.g. "webtest" in applicationInsights
 		fileName += "_id"
 	}
-	resourceId, err := NewResourceID(name, *servicePackage, id)
+	resourceId, err := NewResourceID(name, *servicePackage, id, scopeExamples, insensitiveSegments)
 	if err != nil {
 		return err
 	}
@@ -171,6 +186,22 @@ type ResourceIdSegment struct {
 
 	// SegmentValue is the value for this segment used in the Resource ID
 	SegmentValue string
+
+	// IsScope specifies whether this segment is a Scope, which can span any number of segments
+	IsScope bool
+}
+
+// ResourceIdComponent is a single Segment within the Resource ID, used to generate the list of Segments
+type ResourceIdComponent struct {
+	// Type is the type of this Segment
+	Type resourceid.SegmentType
+
+	// Value is the fixed value for Static and Resource Provider Segments, or the Field Name for
+	// User Specified Segments
+	Value string
+
+	// CaseInsensitive specifies whether the fixed value should always be matched case-insensitively
+	CaseInsensitive bool
 }
 
 type ResourceId struct {
@@ -178,6 +209,15 @@ type ResourceId struct {
 	IDFmt    string
 	IDRaw    string
 
+	// Components is the ordered list of Segments which make up this Resource ID
+	Components []ResourceIdComponent
+
+	// ScopeExamples are examples of the Scope for this Resource ID, when this contains a Scope segment
+	ScopeExamples []string
+
+	// IDTemplate is the Resource ID with the placeholder for the Scope segment, e.g. `/{scope}/providers/...`
+	IDTemplate string
+
 	ServicePackageName string
 	TestPackageSuffix  string
 
@@ -186,20 +226,80 @@ type ResourceId struct {
 	Segments          []ResourceIdSegment // this has to be a slice not a map since we care about the order
 }
 
-func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId, error) {
+// defaultScopeExample is the example Scope used for a Resource ID containing a Scope segment, when none are specified
+const defaultScopeExample = "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1"
+
+// scopePlaceholder matches the placeholder for a Scope segment within a Resource ID, e.g. `{scope}`
+var scopePlaceholder = regexp.MustCompile(`^\{([a-zA-Z]+)\}$`)
+
+// scopePlaceholderInTemplate matches the placeholder for a Scope segment, including the leading `/`
+var scopePlaceholderInTemplate = regexp.MustCompile(`/\{[a-zA-Z]+\}`)
+
+func NewResourceID(typeName, servicePackageName, resourceId string, scopeExamples, insensitiveSegments []string) (*ResourceId, error) {
 	// split the string, but remove the prefix of `/` since it's an empty segment
 	split := strings.Split(strings.TrimPrefix(resourceId, "/"), "/")
-	if len(split)%2 != 0 {
-		return nil, fmt.Errorf("segments weren't divisible by 2: %q", resourceId)
+
+	isInsensitive := func(key string) bool {
+		for _, v := range insensitiveSegments {
+			if v == key {
+				return true
+			}
+		}
+
+		// some API's return `resourcegroups` rather than `resourceGroups`
+		return strings.EqualFold(key, "resourceGroups")
+	}
+
+	if len(scopeExamples) == 0 {
+		scopeExamples = []string{defaultScopeExample}
 	}
 
 	segments := make([]ResourceIdSegment, 0)
+	components := make([]ResourceIdComponent, 0)
+	idRaw := resourceId
+	hasScope := false
 	for i := 0; i < len(split); i += 2 {
+		if match := scopePlaceholder.FindStringSubmatch(split[i]); match != nil {
+			if hasScope {
+				return nil, fmt.Errorf("a Resource ID can contain at most one Scope segment: %q", resourceId)
+			}
+			hasScope = true
+
+			segment := ResourceIdSegment{
+				ArgumentName: match[1],
+				FieldName:    strings.Title(match[1]),
+				SegmentValue: scopeExamples[0],
+				IsScope:      true,
+			}
+			segments = append(segments, segment)
+			components = append(components, ResourceIdComponent{
+				Type:  resourceid.SegmentTypeScope,
+				Value: segment.FieldName,
+			})
+			idRaw = strings.Replace(idRaw, "/"+split[i], strings.TrimSuffix(scopeExamples[0], "/"), 1)
+
+			// the scope is a single segment, so the next key is the next segment
+			i--
+			continue
+		}
+
+		if i+1 >= len(split) {
+			return nil, fmt.Errorf("segments weren't divisible by 2: %q", resourceId)
+		}
+
 		key := split[i]
 		value := split[i+1]
 
 		// the RP shouldn't be transformed
 		if key == "providers" {
+			components = append(components, ResourceIdComponent{
+				Type:            resourceid.SegmentTypeStatic,
+				Value:           key,
+				CaseInsensitive: isInsensitive(key),
+			}, ResourceIdComponent{
+				Type:  resourceid.SegmentTypeResourceProvider,
+				Value: value,
+			})
 			continue
 		}
 
@@ -280,6 +380,14 @@ func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId
 
 		segment := segmentBuilder(key, value, hasSubscriptionId)
 		segments = append(segments, segment)
+		components = append(components, ResourceIdComponent{
+			Type:            resourceid.SegmentTypeStatic,
+			Value:           key,
+			CaseInsensitive: isInsensitive(key),
+		}, ResourceIdComponent{
+			Type:  resourceid.SegmentTypeUserSpecified,
+			Value: segment.FieldName,
+		})
 	}
 
 	// finally build up the format string based on this information
@@ -287,6 +395,12 @@ func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId
 	hasResourceGroup := false
 	hasSubscriptionId := false
 	for _, segment := range segments {
+		if segment.IsScope {
+			// the Scope is prefixed with a `/`, which is trimmed when formatting the Resource ID
+			fmtString = scopePlaceholderInTemplate.ReplaceAllString(fmtString, "%s")
+			continue
+		}
+
 		if strings.EqualFold(segment.SegmentKey, "subscriptions") {
 			hasSubscriptionId = true
 		}
@@ -303,9 +417,16 @@ func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId
 		packageSuffix = "_test"
 	}
 
+	if !hasScope {
+		scopeExamples = nil
+	}
+
 	return &ResourceId{
+		Components:         components,
 		IDFmt:              fmtString,
-		IDRaw:              resourceId,
+		IDRaw:              idRaw,
+		IDTemplate:         resourceId,
+		ScopeExamples:      scopeExamples,
 		HasResourceGroup:   hasResourceGroup,
 		HasSubscriptionId:  hasSubscriptionId,
 		Segments:           segments,
@@ -331,7 +452,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 %s
@@ -340,7 +461,8 @@ import (
 %s
 %s
 %s
-`, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForParser(), id.codeForParserInsensitive())
+%s
+`, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForSegments(), id.codeForParser(), id.codeForParserInsensitive())
 }
 
 func (id ResourceIdGenerator) codeForType() string {
@@ -420,6 +542,11 @@ func (id %[1]sId) String() string {
 func (id ResourceIdGenerator) codeForFormatter() string {
 	formatKeys := make([]string, 0)
 	for _, segment := range id.Segments {
+		if segment.IsScope {
+			// the Scope is prefixed with a `/` - which for the Tenant (Root) Scope is the entire Scope
+			formatKeys = append(formatKeys, fmt.Sprintf("strings.TrimSuffix(id.%s, \"/\")", segment.FieldName))
+			continue
+		}
 		formatKeys = append(formatKeys, fmt.Sprintf("id.%s", segment.FieldName))
 	}
 	formatKeysString := strings.Join(formatKeys, ", ")
@@ -431,37 +558,52 @@ func (id %[1]sId) ID() string {
 `, id.TypeName, id.IDFmt, formatKeysString)
 }
 
-func (id ResourceIdGenerator) codeForParser() string {
-	directAssignments := make([]string, 0)
-	if id.HasSubscriptionId {
-		directAssignments = append(directAssignments, "\t\tSubscriptionId: id.SubscriptionID,")
+func (id ResourceIdGenerator) codeForSegments() string {
+	segments := make([]string, 0)
+	for _, component := range id.Components {
+		var segment string
+		switch component.Type {
+		case resourceid.SegmentTypeResourceProvider:
+			segment = fmt.Sprintf("resourceid.ResourceProviderSegment(%q)", component.Value)
+		case resourceid.SegmentTypeScope:
+			segment = fmt.Sprintf("resourceid.ScopeSegment(%q)", component.Value)
+		case resourceid.SegmentTypeStatic:
+			segment = fmt.Sprintf("resourceid.StaticSegment(%q)", component.Value)
+		case resourceid.SegmentTypeUserSpecified:
+			segment = fmt.Sprintf("resourceid.UserSpecifiedSegment(%q)", component.Value)
+		}
+
+		// Resource Provider Segments are always matched insensitively
+		if component.CaseInsensitive && component.Type != resourceid.SegmentTypeResourceProvider {
+			segment += ".Insensitively()"
+		}
+
+		segments = append(segments, fmt.Sprintf("\t\t%s,", segment))
 	}
-	if id.HasResourceGroup {
-		directAssignments = append(directAssignments, "\t\tResourceGroup: id.ResourceGroup,")
+
+	return fmt.Sprintf(`
+// Segments returns the ordered list of Segments which make up a %[1]s ID
+func (id %[1]sId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+%[2]s
 	}
-	directAssignmentsStr := strings.Join(directAssignments, "\n")
+}
+`, id.TypeName, strings.Join(segments, "\n"))
+}
 
-	parserStatements := make([]string, 0)
+func (id ResourceIdGenerator) codeForParsedAssignments() string {
+	assignments := make([]string, 0)
 	for _, segment := range id.Segments {
-		isSubscription := strings.EqualFold(segment.FieldName, "SubscriptionId") && id.HasSubscriptionId
-		isResourceGroup := strings.EqualFold(segment.FieldName, "ResourceGroup") && id.HasResourceGroup
-		if isSubscription || isResourceGroup {
-			parserStatements = append(parserStatements, fmt.Sprintf(`
-	if resourceId.%[1]s == "" {
-		return nil, fmt.Errorf("ID was missing the '%[2]s' element")
-	}
-`, segment.FieldName, segment.SegmentKey))
-			continue
-		}
-
-		fmtString := "\tif resourceId.%[1]s, err = id.PopSegment(\"%[2]s\"); err != nil {\n\t\treturn nil, err\n\t}"
-		parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey))
+		assignments = append(assignments, fmt.Sprintf("\t\t%[1]s:\tid.Parsed[%[1]q],", segment.FieldName))
 	}
-	parserStatementsStr := strings.Join(parserStatements, "\n")
+	return strings.Join(assignments, "\n")
+}
+
+func (id ResourceIdGenerator) codeForParser() string {
 	return fmt.Sprintf(`
-// %[1]sID parses a %[1]s ID into an %[1]sId struct 
+// %[1]sID parses a %[1]s ID into an %[1]sId struct
 func %[1]sID(input string) (*%[1]sId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(%[1]sId{}).Parse(input, false)
 	if err != nil {
 		return nil, err
 	}
@@ -470,15 +612,9 @@ func %[1]sID(input string) (*%[1]sId, error) {
 %[2]s
 	}
 
-%[3]s
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
-	}
-
 	return &resourceId, nil
 }
-`, id.TypeName, directAssignmentsStr, parserStatementsStr)
+`, id.TypeName, id.codeForParsedAssignments())
 }
 
 func (id ResourceIdGenerator) codeForParserInsensitive() string {
@@ -487,46 +623,6 @@ func (id ResourceIdGenerator) codeForParserInsensitive() string {
 		return ""
 	}
 
-	directAssignments := make([]string, 0)
-	if id.HasSubscriptionId {
-		directAssignments = append(directAssignments, "\t\tSubscriptionId: id.SubscriptionID,")
-	}
-	if id.HasResourceGroup {
-		directAssignments = append(directAssignments, "\t\tResourceGroup: id.ResourceGroup,")
-	}
-	directAssignmentsStr := strings.Join(directAssignments, "\n")
-
-	parserStatements := make([]string, 0)
-	for _, segment := range id.Segments {
-		isSubscription := strings.EqualFold(segment.FieldName, "SubscriptionId") && id.HasSubscriptionId
-		isResourceGroup := strings.EqualFold(segment.FieldName, "ResourceGroup") && id.HasResourceGroup
-		if isSubscription || isResourceGroup {
-			parserStatements = append(parserStatements, fmt.Sprintf(`
-	if resourceId.%[1]s == "" {
-		return nil, fmt.Errorf("ID was missing the '%[2]s' element")
-	}
-`, segment.FieldName, segment.SegmentKey))
-			continue
-		}
-
-		// NOTE: This becomes dramatically simpler long-term - but for now has to be long-winded
-		// to avoid subtle changes to resources until this is threaded through everywhere
-		fmtString := `
-  // find the correct casing for the '%[2]s' segment
-  %[2]sKey := "%[2]s"
-  for key := range id.Path {
-  	if strings.EqualFold(key, %[2]sKey) {
-  		%[2]sKey = key
-  		break
-  	}
-  }
-  if resourceId.%[1]s, err = id.PopSegment(%[2]sKey); err != nil {
-    return nil, err
-  }
-`
-		parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey))
-	}
-	parserStatementsStr := strings.Join(parserStatements, "\n")
 	return fmt.Sprintf(`
 // %[1]sIDInsensitively parses an %[1]s ID into an %[1]sId struct, insensitively
 // This should only be used to parse an ID for rewriting, the %[1]sID
@@ -535,7 +631,7 @@ func (id ResourceIdGenerator) codeForParserInsensitive() string {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func %[1]sIDInsensitively(input string) (*%[1]sId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.NewParser(%[1]sId{}).Parse(input, true)
 	if err != nil {
 		return nil, err
 	}
@@ -544,15 +640,117 @@ func %[1]sIDInsensitively(input string) (*%[1]sId, error) {
 %[2]s
 	}
 
-%[3]s
+	return &resourceId, nil
+}
+`, id.TypeName, id.codeForParsedAssignments())
+}
 
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+// testCasesForMissingSegment returns the test cases where the key, or the value, for this segment is missing
+func (id ResourceIdGenerator) testCasesForMissingSegment(segment ResourceIdSegment, testCaseFmt string) []string {
+	// any number of segments are valid for a Scope, so there's nothing missing
+	if segment.IsScope {
+		return nil
 	}
 
-	return &resourceId, nil
+	// the key/value could also be present in the example Scope, so only the remainder of the Resource ID is searched
+	offset := len(id.scopePrefix())
+
+	// missing the key
+	resourceIdToThisPointIndex := offset + strings.Index(id.IDRaw[offset:], segment.SegmentKey)
+	resourceIdToThisPoint := id.IDRaw[0:resourceIdToThisPointIndex]
+	missingKey := fmt.Sprintf(testCaseFmt, segment.FieldName, resourceIdToThisPoint)
+
+	// missing the value
+	resourceIdToThisPointIndex = offset + strings.Index(id.IDRaw[offset:], segment.SegmentValue)
+	resourceIdToThisPoint = id.IDRaw[0:resourceIdToThisPointIndex]
+	missingValue := fmt.Sprintf(testCaseFmt, fmt.Sprintf("value for %s", segment.FieldName), resourceIdToThisPoint)
+
+	return []string{missingKey, missingValue}
+}
+
+// scopePrefix returns the example Scope which the Resource ID is prefixed with, if any
+func (id ResourceIdGenerator) scopePrefix() string {
+	if len(id.Segments) == 0 || !id.Segments[0].IsScope {
+		return ""
+	}
+
+	return strings.TrimSuffix(id.Segments[0].SegmentValue, "/")
+}
+
+// additionalScopeExamples returns a map of the additional example Scopes to the Resource ID at that Scope
+func (id ResourceIdGenerator) additionalScopeExamples() map[string]string {
+	output := make(map[string]string)
+	prefix := id.scopePrefix()
+	if len(id.ScopeExamples) < 2 || len(id.Segments) == 0 || !id.Segments[0].IsScope {
+		return output
+	}
+
+	suffix := strings.TrimPrefix(id.IDRaw, prefix)
+	for _, scope := range id.ScopeExamples[1:] {
+		output[scope] = strings.TrimSuffix(scope, "/") + suffix
+	}
+	return output
+}
+
+// sortedKeys returns the keys of the specified map, sorted alphabetically
+func sortedKeys(input map[string]string) []string {
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// testCasesForAdditionalScopes returns a valid test case for each of the additional example Scopes
+func (id ResourceIdGenerator) testCasesForAdditionalScopes(typeName string) []string {
+	testCases := make([]string, 0)
+	examples := id.additionalScopeExamples()
+	for _, scope := range sortedKeys(examples) {
+		expectAssignments := make([]string, 0)
+		for _, segment := range id.Segments {
+			value := segment.SegmentValue
+			if segment.IsScope {
+				value = scope
+			}
+			expectAssignments = append(expectAssignments, fmt.Sprintf("\t\t\t\t%s:\t%q,", segment.FieldName, value))
+		}
+
+		testCases = append(testCases, fmt.Sprintf(`
+		{
+			// valid at the Scope %[4]q
+			Input: %[1]q,
+			Expected: &%[2]s{
+%[3]s
+			},
+		},`, examples[scope], typeName, strings.Join(expectAssignments, "\n"), scope))
+	}
+
+	return testCases
 }
-`, id.TypeName, directAssignmentsStr, parserStatementsStr)
+
+// resourceIdWithInsensitiveSegments returns the Resource ID with the segments which are always matched
+// case-insensitively lower-cased, or an empty string if there are no such segments
+func (id ResourceIdGenerator) resourceIdWithInsensitiveSegments() string {
+	prefix := id.scopePrefix()
+	suffix := strings.TrimPrefix(id.IDRaw, prefix)
+
+	found := false
+	for _, component := range id.Components {
+		// Resource Providers are always matched insensitively and `resourceGroups` is matched insensitively by default
+		if component.Type != resourceid.SegmentTypeStatic || !component.CaseInsensitive || strings.EqualFold(component.Value, "resourceGroups") {
+			continue
+		}
+
+		found = true
+		suffix = strings.Replace(suffix, "/"+component.Value+"/", "/"+strings.ToLower(component.Value)+"/", 1)
+	}
+
+	if !found {
+		return ""
+	}
+
+	return prefix + suffix
 }
 
 func (id ResourceIdGenerator) TestCode() string {
@@ -629,15 +827,7 @@ func (id ResourceIdGenerator) testCodeForParser() string {
 			Input: %q,
 			Error: true,
 		},`
-		// missing the key
-		resourceIdToThisPointIndex := strings.Index(id.IDRaw, segment.SegmentKey)
-		resourceIdToThisPoint := id.IDRaw[0:resourceIdToThisPointIndex]
-		testCases = append(testCases, fmt.Sprintf(testCaseFmt, segment.FieldName, resourceIdToThisPoint))
-
-		// missing the value
-		resourceIdToThisPointIndex = strings.Index(id.IDRaw, segment.SegmentValue)
-		resourceIdToThisPoint = id.IDRaw[0:resourceIdToThisPointIndex]
-		testCases = append(testCases, fmt.Sprintf(testCaseFmt, fmt.Sprintf("value for %s", segment.FieldName), resourceIdToThisPoint))
+		testCases = append(testCases, id.testCasesForMissingSegment(segment, testCaseFmt)...)
 
 		assignmentsFmt := "\t\tif actual.%[1]s != v.Expected.%[1]s {\n\t\t\tt.Fatalf(\"Expected %%q but got %%q for %[1]s\", v.Expected.%[1]s, actual.%[1]s)\n\t\t}"
 		assignmentChecks = append(assignmentChecks, fmt.Sprintf(assignmentsFmt, segment.FieldName))
@@ -661,6 +851,19 @@ func (id ResourceIdGenerator) testCodeForParser() string {
 			},
 		},
 `, id.IDRaw, typeName, strings.Join(expectAssignments, "\n")))
+	testCases = append(testCases, id.testCasesForAdditionalScopes(typeName)...)
+
+	// add a test case for the segments which are always matched case-insensitively
+	if input := id.resourceIdWithInsensitiveSegments(); input != "" {
+		testCases = append(testCases, fmt.Sprintf(`
+		{
+			// case-insensitive segments
+			Input: %[1]q,
+			Expected: &%[2]s{
+%[3]s
+			},
+		},`, input, typeName, strings.Join(expectAssignments, "\n")))
+	}
 
 	// add an intentionally failing upper-cased test case
 	testCases = append(testCases, fmt.Sprintf(`
@@ -758,15 +961,7 @@ func (id ResourceIdGenerator) testCodeForParserInsensitive() string {
 			Input: %q,
 			Error: true,
 		},`
-		// missing the key
-		resourceIdToThisPointIndex := strings.Index(id.IDRaw, segment.SegmentKey)
-		resourceIdToThisPoint := id.IDRaw[0:resourceIdToThisPointIndex]
-		testCases = append(testCases, fmt.Sprintf(testCaseFmt, segment.FieldName, resourceIdToThisPoint))
-
-		// missing the value
-		resourceIdToThisPointIndex = strings.Index(id.IDRaw, segment.SegmentValue)
-		resourceIdToThisPoint = id.IDRaw[0:resourceIdToThisPointIndex]
-		testCases = append(testCases, fmt.Sprintf(testCaseFmt, fmt.Sprintf("value for %s", segment.FieldName), resourceIdToThisPoint))
+		testCases = append(testCases, id.testCasesForMissingSegment(segment, testCaseFmt)...)
 
 		assignmentsFmt := "\t\tif actual.%[1]s != v.Expected.%[1]s {\n\t\t\tt.Fatalf(\"Expected %%q but got %%q for %[1]s\", v.Expected.%[1]s, actual.%[1]s)\n\t\t}"
 		assignmentChecks = append(assignmentChecks, fmt.Sprintf(assignmentsFmt, segment.FieldName))
@@ -788,16 +983,19 @@ func (id ResourceIdGenerator) testCodeForParserInsensitive() string {
 `, id.IDRaw, id.TypeName, strings.Join(expectAssignments, "\n")))
 
 	testCaseWithTransformation := func(testCaseName string, transform func(in string) string) string {
-		resourceIdWithTransform := id.IDRaw
+		// the segments within the example Scope aren't transformed
+		prefix := id.scopePrefix()
+		resourceIdWithTransform := strings.TrimPrefix(id.IDRaw, prefix)
 		for _, segment := range id.Segments {
 			// we're not as concerned with these two for now
-			if segment.FieldName == "SubscriptionId" || segment.FieldName == "ResourceGroup" {
+			if segment.IsScope || segment.FieldName == "SubscriptionId" || segment.FieldName == "ResourceGroup" {
 				continue
 			}
 
 			transformedKey := transform(segment.SegmentKey)
 			resourceIdWithTransform = strings.Replace(resourceIdWithTransform, segment.SegmentKey, transformedKey, 1)
 		}
+		resourceIdWithTransform = prefix + resourceIdWithTransform
 
 		typeName := fmt.Sprintf("%sId", id.TypeName)
 		if id.TestPackageSuffix != "" {
@@ -936,15 +1134,7 @@ func (id ResourceIdGenerator) ValidatorTestCode() string {
 			Input: %q,
 			Valid: false,
 		},`
-		// missing the key
-		resourceIdToThisPointIndex := strings.Index(id.IDRaw, segment.SegmentKey)
-		resourceIdToThisPoint := id.IDRaw[0:resourceIdToThisPointIndex]
-		testCases = append(testCases, fmt.Sprintf(testCaseFmt, segment.FieldName, resourceIdToThisPoint))
-
-		// missing the value
-		resourceIdToThisPointIndex = strings.Index(id.IDRaw, segment.SegmentValue)
-		resourceIdToThisPoint = id.IDRaw[0:resourceIdToThisPointIndex]
-		testCases = append(testCases, fmt.Sprintf(testCaseFmt, fmt.Sprintf("value for %s", segment.FieldName), resourceIdToThisPoint))
+		testCases = append(testCases, id.testCasesForMissingSegment(segment, testCaseFmt)...)
 	}
 
 	// add a successful test case
@@ -956,6 +1146,16 @@ func (id ResourceIdGenerator) ValidatorTestCode() string {
 		},
 `, id.IDRaw))
 
+	examples := id.additionalScopeExamples()
+	for _, scope := range sortedKeys(examples) {
+		testCases = append(testCases, fmt.Sprintf(`
+		{
+			// valid at the Scope %q
+			Input: %q,
+			Valid: true,
+		},`, scope, examples[scope]))
+	}
+
 	// add an intentionally failing upper-cased test case
 	testCases = append(testCases, fmt.Sprintf(`
 		{
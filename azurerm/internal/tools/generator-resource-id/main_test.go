@@ -1,7 +1,10 @@
 package main
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 func Test(t *testing.T) {
@@ -54,3 +57,24 @@ func Test(t *testing.T) {
 		}
 	}
 }
+
+func TestNewResourceIDComponents(t *testing.T) {
+	id, err := NewResourceID("Server", "analysisservices", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AnalysisServices/servers/Server1", nil, nil)
+	if err != nil {
+		t.Fatalf("building Resource ID: %+v", err)
+	}
+
+	expected := []ResourceIdComponent{
+		{Type: resourceid.SegmentTypeStatic, Value: "subscriptions"},
+		{Type: resourceid.SegmentTypeUserSpecified, Value: "SubscriptionId"},
+		{Type: resourceid.SegmentTypeStatic, Value: "resourceGroups", CaseInsensitive: true},
+		{Type: resourceid.SegmentTypeUserSpecified, Value: "ResourceGroup"},
+		{Type: resourceid.SegmentTypeStatic, Value: "providers"},
+		{Type: resourceid.SegmentTypeResourceProvider, Value: "Microsoft.AnalysisServices"},
+		{Type: resourceid.SegmentTypeStatic, Value: "servers"},
+		{Type: resourceid.SegmentTypeUserSpecified, Value: "Name"},
+	}
+	if !reflect.DeepEqual(id.Components, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, id.Components)
+	}
+}
@@ -0,0 +1,912 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/docs"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+)
+
+// NOTE: since we're using `go run` for these tools all of the code needs to live within the main.go
+
+func main() {
+	f := flag.NewFlagSet("website-docs", flag.ExitOnError)
+
+	websitePath := f.String("website-path", "", "The relative path to the website folder")
+	resourceName := f.String("name", "", "(Optional) The name of a single Data Source/Resource to generate/check the documentation for")
+	checkOnly := f.Bool("check", false, "Check that the existing documentation matches the schema, rather than generating it")
+
+	_ = f.Parse(os.Args[1:])
+
+	if websitePath == nil || *websitePath == "" {
+		log.Print("The Relative Website Path must be specified via `-website-path`")
+		os.Exit(1)
+	}
+
+	if err := run(*websitePath, *resourceName, *checkOnly); err != nil {
+		log.Print(err)
+		os.Exit(1)
+	}
+}
+
+func run(websitePath, resourceName string, checkOnly bool) error {
+	resources, err := registeredResources()
+	if err != nil {
+		return err
+	}
+
+	problems := make([]string, 0)
+	found := false
+	for _, resource := range resources {
+		if resourceName != "" && resource.name != resourceName {
+			continue
+		}
+		found = true
+
+		fileName := docs.PagePath(filepath.Join(websitePath, "docs"), resource.name, resource.isDataSource)
+		contents, err := ioutil.ReadFile(fileName)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				return fmt.Errorf("reading %q: %+v", fileName, err)
+			}
+
+			// new Data Sources/Resources should be scaffolded using the `website-scaffold` tool first
+			if checkOnly {
+				problems = append(problems, fmt.Sprintf("%s: no documentation exists at %q", resource, fileName))
+				continue
+			}
+
+			log.Printf("[DEBUG] Skipping %s since no documentation exists at %q", resource, fileName)
+			continue
+		}
+
+		generator := newDocumentationGenerator(resource, docs.ParsePage(string(contents)))
+		if checkOnly {
+			for _, problem := range generator.check() {
+				problems = append(problems, fmt.Sprintf("%s: %s", resource, problem))
+			}
+			continue
+		}
+
+		if err := writeToFile(fileName, generator.generate()); err != nil {
+			return fmt.Errorf("writing documentation for %s: %+v", resource, err)
+		}
+	}
+
+	if resourceName != "" && !found {
+		return fmt.Errorf("%q was not registered as a Data Source or Resource", resourceName)
+	}
+
+	if len(problems) > 0 {
+		for _, problem := range problems {
+			log.Print(problem)
+		}
+
+		return fmt.Errorf("the documentation didn't match the schema in %d places - these can be fixed by running this tool without `-check`", len(problems))
+	}
+
+	return nil
+}
+
+type documentedResource struct {
+	// name is the name of the Data Source/Resource e.g. `azurerm_resource_group`
+	name string
+
+	// isDataSource defines if this is a Data Source (if not it's a Resource)
+	isDataSource bool
+
+	resource *schema.Resource
+}
+
+func (r documentedResource) String() string {
+	if r.isDataSource {
+		return fmt.Sprintf("Data Source %q", r.name)
+	}
+
+	return fmt.Sprintf("Resource %q", r.name)
+}
+
+// registeredResources returns all of the Data Sources and Resources registered within the Typed and Untyped
+// Service Registrations, ordered by name
+func registeredResources() ([]documentedResource, error) {
+	resources := make(map[string]documentedResource)
+	add := func(name string, isDataSource bool, resource *schema.Resource) {
+		key := fmt.Sprintf("%t-%s", isDataSource, name)
+		resources[key] = documentedResource{
+			name:         name,
+			isDataSource: isDataSource,
+			resource:     resource,
+		}
+	}
+
+	for _, service := range provider.SupportedTypedServices() {
+		for _, ds := range service.DataSources() {
+			wrapper := sdk.NewDataSourceWrapper(ds)
+			dsWrapper, err := wrapper.DataSource()
+			if err != nil {
+				return nil, fmt.Errorf("wrapping Data Source %q: %+v", ds.ResourceType(), err)
+			}
+
+			add(ds.ResourceType(), true, dsWrapper)
+		}
+
+		for _, rs := range service.Resources() {
+			wrapper := sdk.NewResourceWrapper(rs)
+			rsWrapper, err := wrapper.Resource()
+			if err != nil {
+				return nil, fmt.Errorf("wrapping Resource %q: %+v", rs.ResourceType(), err)
+			}
+
+			add(rs.ResourceType(), false, rsWrapper)
+		}
+	}
+
+	for _, service := range provider.SupportedUntypedServices() {
+		for name, ds := range service.SupportedDataSources() {
+			add(name, true, ds)
+		}
+
+		for name, rs := range service.SupportedResources() {
+			add(name, false, rs)
+		}
+	}
+
+	keys := make([]string, 0)
+	for key := range resources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	output := make([]documentedResource, 0)
+	for _, key := range keys {
+		output = append(output, resources[key])
+	}
+	return output, nil
+}
+
+// generatedSections are the sections which are generated from the schema, in the order they should appear
+var generatedSections = []string{docs.SectionArguments, docs.SectionAttributes, docs.SectionTimeouts, docs.SectionImport}
+
+// generation
+
+type documentationGenerator struct {
+	documentedResource
+
+	page docs.Page
+
+	// brandName is the marketing brand name used for this resource (e.g. Resource Group / App Service / Web Apps)
+	brandName string
+
+	arguments  map[string]docs.Block
+	attributes map[string]docs.Block
+}
+
+func newDocumentationGenerator(resource documentedResource, page docs.Page) documentationGenerator {
+	brandName := page.BrandName()
+	if brandName == "" {
+		words := strings.Split(strings.TrimPrefix(resource.name, "azurerm_"), "_")
+		for i, word := range words {
+			words[i] = strings.Title(word)
+		}
+		brandName = strings.Join(words, " ")
+	}
+
+	return documentationGenerator{
+		documentedResource: resource,
+		page:               page,
+		brandName:          brandName,
+		arguments:          page.Fields(docs.SectionArguments),
+		attributes:         page.Fields(docs.SectionAttributes),
+	}
+}
+
+// generate returns the existing documentation page with the generated sections replaced
+func (gen documentationGenerator) generate() string {
+	generated := map[string]string{
+		docs.SectionArguments:  gen.argumentsBlock(),
+		docs.SectionAttributes: gen.attributesBlock(),
+		docs.SectionTimeouts:   gen.timeoutsBlock(),
+		docs.SectionImport:     gen.importBlock(),
+	}
+
+	sections := make([]string, 0)
+	present := make(map[string]struct{})
+	for _, section := range gen.page.Sections {
+		name := docs.NormalizeHeading(section.Heading)
+		contents, isGenerated := generated[name]
+		if !isGenerated {
+			sections = append(sections, section.Raw)
+			continue
+		}
+
+		present[name] = struct{}{}
+		if contents != "" {
+			sections = append(sections, contents)
+		}
+	}
+
+	// then add any generated sections which were missing, after the section which precedes them
+	for i, name := range generatedSections {
+		if _, exists := present[name]; exists || generated[name] == "" {
+			continue
+		}
+
+		index := len(sections)
+		for j := i - 1; j >= 0; j-- {
+			previous := generated[generatedSections[j]]
+			if previous == "" {
+				continue
+			}
+
+			for k, v := range sections {
+				if v == previous {
+					index = k + 1
+				}
+			}
+			break
+		}
+
+		sections = append(sections[:index], append([]string{generated[name]}, sections[index:]...)...)
+	}
+
+	output := gen.page.Preamble
+	for _, section := range sections {
+		output += "\n\n" + section
+	}
+
+	return strings.TrimSpace(output) + "\n"
+}
+
+// check returns a list of the places where the existing documentation doesn't match the schema
+func (gen documentationGenerator) check() []string {
+	problems := make([]string, 0)
+
+	checkFields := func(sectionName string, expected map[string]map[string]*schema.Schema, documented map[string]docs.Block, isArgument bool) {
+		for _, blockName := range sortedKeys(expected) {
+			documentedBlock, exists := documented[blockName]
+			if !exists {
+				problems = append(problems, fmt.Sprintf("the `%s` block isn't documented in the %s", blockName, sectionName))
+				continue
+			}
+
+			fields := expected[blockName]
+			for _, fieldName := range sortedKeys(fields) {
+				field := fields[fieldName]
+				fieldId := fieldReference(blockName, fieldName)
+
+				documentedField, exists := documentedBlock.Fields[fieldName]
+				if !exists {
+					// Deprecated fields are intentionally omitted from the documentation
+					if field.Deprecated != "" {
+						continue
+					}
+
+					problems = append(problems, fmt.Sprintf("%s isn't documented in the %s", fieldId, sectionName))
+					continue
+				}
+
+				if !isArgument {
+					continue
+				}
+
+				status := "Optional"
+				if field.Required {
+					status = "Required"
+				}
+				// the arguments for Data Sources are conventionally documented without a status
+				if documentedField.Status == "" && !gen.isDataSource {
+					problems = append(problems, fmt.Sprintf("%s is %s in the schema but isn't documented as either Required or Optional", fieldId, status))
+				} else if documentedField.Status != "" && documentedField.Status != status {
+					problems = append(problems, fmt.Sprintf("%s is %s in the schema but is documented as %s", fieldId, status, documentedField.Status))
+				}
+
+				documentedAsForceNew := docs.ForceNewRegex.MatchString(documentedField.Description)
+				if field.ForceNew && !documentedAsForceNew {
+					problems = append(problems, fmt.Sprintf("%s is ForceNew in the schema but isn't documented as such", fieldId))
+				}
+				if !field.ForceNew && documentedAsForceNew {
+					problems = append(problems, fmt.Sprintf("%s isn't ForceNew in the schema but is documented as such", fieldId))
+				}
+			}
+		}
+
+		for _, blockName := range sortedKeys(documented) {
+			fields, exists := expected[blockName]
+			if !exists {
+				problems = append(problems, fmt.Sprintf("the `%s` block is documented in the %s but doesn't exist in the schema", blockName, sectionName))
+				continue
+			}
+
+			for _, fieldName := range sortedKeys(documented[blockName].Fields) {
+				if blockName == "" && fieldName == "id" && !isArgument {
+					continue
+				}
+
+				if _, exists := fields[fieldName]; !exists {
+					problems = append(problems, fmt.Sprintf("%s is documented in the %s but doesn't exist in the schema", fieldReference(blockName, fieldName), sectionName))
+				}
+			}
+		}
+	}
+
+	checkFields(docs.SectionArguments, gen.argumentBlocks(), gen.arguments, true)
+	checkFields(docs.SectionAttributes, gen.attributeBlocks(), gen.attributes, false)
+
+	expectedTimeouts := gen.defaultTimeouts()
+	documentedTimeouts := gen.page.Timeouts()
+	for _, operation := range sortedKeys(expectedTimeouts) {
+		documented, exists := documentedTimeouts[operation]
+		if !exists {
+			problems = append(problems, fmt.Sprintf("the `%s` timeout isn't documented", operation))
+			continue
+		}
+
+		if expected := expectedTimeouts[operation]; friendlyTextToTimeout(documented) != expected {
+			problems = append(problems, fmt.Sprintf("the `%s` timeout defaults to %s but is documented as %s", operation, timeoutToFriendlyText(expected), documented))
+		}
+	}
+	for _, operation := range sortedKeys(documentedTimeouts) {
+		if _, exists := expectedTimeouts[operation]; !exists {
+			problems = append(problems, fmt.Sprintf("the `%s` timeout is documented but isn't supported", operation))
+		}
+	}
+
+	isImportable := gen.isImportable()
+	isImportDocumented := gen.page.Section(docs.SectionImport) != nil
+	if isImportable && !isImportDocumented {
+		problems = append(problems, "the Import section is missing")
+	}
+	if !isImportable && isImportDocumented {
+		problems = append(problems, "the Import section is documented but this doesn't support import")
+	}
+
+	return problems
+}
+
+// blocks
+func (gen documentationGenerator) argumentsBlock() string {
+	documentationForArguments := func(input map[string]*schema.Schema, onlyRequired bool, blockName string) string {
+		fields := ""
+		for _, fieldName := range orderedFieldNames(input, gen.arguments[blockName]) {
+			field := input[fieldName]
+			if onlyRequired != field.Required {
+				continue
+			}
+
+			status := "Optional"
+			if field.Required {
+				status = "Required"
+			}
+
+			existing := gen.arguments[blockName].Fields[fieldName]
+			value := gen.descriptionForArgument(fieldName, field, blockName, existing.Description)
+			fields += fmt.Sprintf("* `%s` - (%s) %s\n\n", fieldName, status, value)
+			if existing.Notes != "" {
+				fields += fmt.Sprintf("%s\n\n", existing.Notes)
+			}
+		}
+
+		return fields
+	}
+
+	blocks := gen.argumentBlocks()
+
+	// first output the Required fields
+	fields := documentationForArguments(blocks[""], true, "")
+	// then prepare the Optional fields
+	optionalFields := documentationForArguments(blocks[""], false, "")
+
+	// assuming we have both optional & required fields - let's add a separator
+	if len(fields) > 0 && len(optionalFields) > 0 {
+		fields += "---\n\n"
+	}
+	fields += optionalFields
+
+	for _, blockName := range orderedBlockNames(blocks, gen.arguments) {
+		if blockName == "" {
+			continue
+		}
+
+		fields += "---\n\n"
+		fields += fmt.Sprintf("%s `%s` block supports the following:\n\n", article(blockName), blockName)
+		fields += documentationForArguments(blocks[blockName], true, blockName)
+		fields += documentationForArguments(blocks[blockName], false, blockName)
+	}
+
+	fields = strings.TrimSuffix(fields, "\n\n")
+
+	return fmt.Sprintf(`## %s
+
+The following arguments are supported:
+
+%s`, gen.heading(docs.SectionArguments), fields)
+}
+
+func (gen documentationGenerator) attributesBlock() string {
+	documentationForAttributes := func(input map[string]*schema.Schema, blockName string) string {
+		fields := ""
+		for _, fieldName := range orderedFieldNames(input, gen.attributes[blockName]) {
+			existing := gen.attributes[blockName].Fields[fieldName]
+			value := gen.descriptionForAttribute(fieldName, input[fieldName], blockName, existing.Description)
+			fields += fmt.Sprintf("* `%s` - %s\n\n", fieldName, value)
+			if existing.Notes != "" {
+				fields += fmt.Sprintf("%s\n\n", existing.Notes)
+			}
+		}
+
+		return fields
+	}
+
+	blocks := gen.attributeBlocks()
+
+	// present in everything
+	idDescription := fmt.Sprintf("The ID of the %s.", gen.brandName)
+	if existing, ok := gen.attributes[""].Fields["id"]; ok && existing.Description != "" {
+		idDescription = existing.Description
+	}
+	fields := fmt.Sprintf("* `id` - %s\n\n", idDescription)
+	fields += documentationForAttributes(blocks[""], "")
+
+	for _, blockName := range orderedBlockNames(blocks, gen.attributes) {
+		if blockName == "" {
+			continue
+		}
+
+		fields += "---\n\n"
+		fields += fmt.Sprintf("%s `%s` block exports the following:\n\n", article(blockName), blockName)
+		fields += documentationForAttributes(blocks[blockName], blockName)
+	}
+
+	fields = strings.TrimSuffix(fields, "\n\n")
+
+	return fmt.Sprintf(`## %s
+
+In addition to the Arguments listed above - the following Attributes are exported:
+
+%s`, gen.heading(docs.SectionAttributes), fields)
+}
+
+func (gen documentationGenerator) importBlock() string {
+	if !gen.isImportable() {
+		return ""
+	}
+
+	resourceId := gen.page.ImportResourceId()
+	if resourceId == "" {
+		resourceId = "TODO"
+	}
+
+	template := fmt.Sprintf(`## Import
+
+%ss can be imported using the []resource id[], e.g.
+
+[][][]shell
+terraform import %s.example %s
+[][][]`, gen.brandName, gen.name, resourceId)
+	return strings.ReplaceAll(template, "[]", "`")
+}
+
+func (gen documentationGenerator) timeoutsBlock() string {
+	timeouts := gen.defaultTimeouts()
+	if len(timeouts) == 0 {
+		return ""
+	}
+
+	timeoutsBlurb := "The `timeouts` block allows you to specify [timeouts](https://www.terraform.io/docs/configuration/resources.html#timeouts) for certain actions:"
+
+	descriptions := map[string]string{
+		"create": "creating",
+		"read":   "retrieving",
+		"update": "updating",
+		"delete": "deleting",
+	}
+
+	timeoutsText := ""
+	for _, operation := range []string{"create", "read", "update", "delete"} {
+		duration, ok := timeouts[operation]
+		if !ok {
+			continue
+		}
+
+		timeoutsText += fmt.Sprintf("* `%s` - (Defaults to %s) Used when %s the %s.\n", operation, timeoutToFriendlyText(duration), descriptions[operation], gen.brandName)
+	}
+
+	timeoutsText = strings.TrimSuffix(timeoutsText, "\n")
+	return fmt.Sprintf(`## Timeouts
+
+%s
+
+%s`, timeoutsBlurb, timeoutsText)
+}
+
+// helpers
+
+// heading returns the heading used for the specified section in the existing documentation, since some pages
+// use `Argument Reference` or `Attribute Reference` instead
+func (gen documentationGenerator) heading(sectionName string) string {
+	if section := gen.page.Section(sectionName); section != nil {
+		return section.Heading
+	}
+
+	return sectionName
+}
+
+// orderedBlockNames returns the names of the blocks in the order they're documented within the existing
+// documentation, followed by any undocumented blocks ordered by name
+func orderedBlockNames(blocks map[string]map[string]*schema.Schema, documented map[string]docs.Block) []string {
+	names := sortedKeys(blocks)
+	sort.SliceStable(names, func(i, j int) bool {
+		return documentationOrder(documented[names[i]].Order, documented[names[i]].Fields != nil) < documentationOrder(documented[names[j]].Order, documented[names[j]].Fields != nil)
+	})
+	return names
+}
+
+// orderedFieldNames returns the names of the fields in the order they're documented within the existing
+// documentation, followed by any undocumented fields ordered by name - omitting any undocumented Deprecated fields
+func orderedFieldNames(fields map[string]*schema.Schema, documented docs.Block) []string {
+	names := make([]string, 0)
+	for _, name := range sortedKeys(fields) {
+		if _, isDocumented := documented.Fields[name]; !isDocumented && fields[name].Deprecated != "" {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	sort.SliceStable(names, func(i, j int) bool {
+		first, firstIsDocumented := documented.Fields[names[i]]
+		second, secondIsDocumented := documented.Fields[names[j]]
+		return documentationOrder(first.Order, firstIsDocumented) < documentationOrder(second.Order, secondIsDocumented)
+	})
+	return names
+}
+
+func documentationOrder(order int, isDocumented bool) int {
+	if !isDocumented {
+		return math.MaxInt32
+	}
+
+	return order
+}
+
+// argumentBlocks returns the fields which can be specified, keyed by the block name (which is empty for the top-level fields)
+func (gen documentationGenerator) argumentBlocks() map[string]map[string]*schema.Schema {
+	isArgument := func(field *schema.Schema) bool {
+		return field.Optional || field.Required
+	}
+
+	blocks := make(map[string]map[string]*schema.Schema)
+	collectBlocks("", gen.resource.Schema, isArgument, isArgument, blocks)
+	return blocks
+}
+
+// attributeBlocks returns the fields which are exported, keyed by the block name (which is empty for the top-level fields)
+func (gen documentationGenerator) attributeBlocks() map[string]map[string]*schema.Schema {
+	isAttribute := func(field *schema.Schema) bool {
+		return field.Computed && !field.Optional && !field.Required
+	}
+	// all of the fields within a computed-only block are exported
+	isNestedAttribute := func(_ *schema.Schema) bool {
+		return true
+	}
+
+	blocks := make(map[string]map[string]*schema.Schema)
+	collectBlocks("", gen.resource.Schema, isAttribute, isNestedAttribute, blocks)
+	return blocks
+}
+
+// collectBlocks adds the fields matching `include` to the specified block - and then any nested blocks,
+// which are combined by name since nested blocks are documented by name
+func collectBlocks(blockName string, fields map[string]*schema.Schema, include func(*schema.Schema) bool, includeNested func(*schema.Schema) bool, blocks map[string]map[string]*schema.Schema) {
+	if _, exists := blocks[blockName]; !exists {
+		blocks[blockName] = make(map[string]*schema.Schema)
+	}
+
+	for _, fieldName := range sortedKeys(fields) {
+		field := fields[fieldName]
+		if !include(field) {
+			continue
+		}
+
+		blocks[blockName][fieldName] = field
+
+		if field.Type != schema.TypeList && field.Type != schema.TypeSet {
+			continue
+		}
+		if v, ok := field.Elem.(*schema.Resource); ok && v != nil {
+			collectBlocks(fieldName, v.Schema, includeNested, includeNested, blocks)
+		}
+	}
+}
+
+func (gen documentationGenerator) defaultTimeouts() map[string]time.Duration {
+	output := make(map[string]time.Duration)
+	if gen.resource.Timeouts == nil {
+		return output
+	}
+
+	timeouts := *gen.resource.Timeouts
+	if timeouts.Create != nil {
+		output["create"] = *timeouts.Create
+	}
+	if timeouts.Read != nil {
+		output["read"] = *timeouts.Read
+	}
+	if timeouts.Update != nil {
+		output["update"] = *timeouts.Update
+	}
+	if timeouts.Delete != nil {
+		output["delete"] = *timeouts.Delete
+	}
+	return output
+}
+
+func (gen documentationGenerator) isImportable() bool {
+	return !gen.isDataSource && gen.resource.Importer != nil
+}
+
+// descriptionForArgument returns the description for an Argument - using the description from the schema
+// if one is specified, otherwise the existing documentation - including any Possible Values, the Default Value
+// and whether changing the field forces a new resource
+func (gen documentationGenerator) descriptionForArgument(name string, field *schema.Schema, blockName, existing string) string {
+	description := strings.TrimSpace(field.Description)
+	if description == "" {
+		description = strings.TrimSpace(existing)
+	}
+	// whether this forces a new resource is determined from the schema, but the existing wording is retained
+	forceNewText := fmt.Sprintf("Changing this forces a new %s to be created.", gen.brandName)
+	if existingText := docs.ForceNewRegex.FindString(description); existingText != "" {
+		forceNewText = withFullStop(strings.TrimSpace(existingText))
+	}
+	description = strings.TrimSpace(docs.ForceNewRegex.ReplaceAllString(description, ""))
+	if description == "" {
+		description = gen.defaultDescription(name, field, blockName)
+	}
+	description = withFullStop(description)
+
+	if values := allowedValues(field); len(values) > 0 && !mentionsAllValues(description, values) {
+		description += fmt.Sprintf(" Possible values are %s.", formatList(values))
+	}
+
+	if field.Default != nil && !strings.Contains(strings.ToLower(description), "default") {
+		if value := fmt.Sprintf("%v", field.Default); value != "" {
+			description += fmt.Sprintf(" Defaults to `%s`.", value)
+		}
+	}
+
+	if field.ForceNew {
+		description += " " + forceNewText
+	}
+
+	return description
+}
+
+// descriptionForAttribute returns the description for an Attribute - using the description from the schema
+// if one is specified, otherwise the existing documentation
+func (gen documentationGenerator) descriptionForAttribute(name string, field *schema.Schema, blockName, existing string) string {
+	description := strings.TrimSpace(field.Description)
+	if description == "" {
+		description = strings.TrimSpace(existing)
+	}
+	if description == "" {
+		description = gen.defaultDescription(name, field, blockName)
+	}
+
+	return withFullStop(description)
+}
+
+func (gen documentationGenerator) defaultDescription(name string, field *schema.Schema, blockName string) string {
+	if _, ok := field.Elem.(*schema.Resource); ok {
+		position := "below"
+		if blockName != "" && name < blockName {
+			position = "above"
+		}
+
+		if field.MaxItems == 1 {
+			return fmt.Sprintf("%s `%s` block as defined %s.", article(name), name, position)
+		}
+
+		return fmt.Sprintf("One or more `%s` blocks as defined %s.", name, position)
+	}
+
+	if name == "tags" {
+		return fmt.Sprintf("A mapping of tags assigned to the %s.", gen.brandName)
+	}
+
+	return "TODO."
+}
+
+// allowedValues returns the values allowed by the validation function for this field (e.g. `validation.StringInSlice`)
+// which is determined by validating a value which can't be valid and then parsing the error
+func allowedValues(field *schema.Schema) (values []string) {
+	if field.ValidateFunc == nil || field.Type != schema.TypeString {
+		return nil
+	}
+
+	defer func() {
+		// since we're passing an unexpected value, a validation function could fail in unexpected ways
+		if r := recover(); r != nil {
+			values = nil
+		}
+	}()
+
+	_, errs := field.ValidateFunc("\x00", "field")
+	for _, err := range errs {
+		if match := allowedValuesRegex.FindStringSubmatch(err.Error()); match != nil {
+			if quoted := quotedValueRegex.FindAllStringSubmatch(match[1], -1); len(quoted) > 0 {
+				for _, v := range quoted {
+					values = append(values, v[1])
+				}
+				return values
+			}
+
+			return strings.Fields(match[1])
+		}
+	}
+
+	return nil
+}
+
+var (
+	allowedValuesRegex = regexp.MustCompile(`to be one of \[(.*)\], got`)
+	quotedValueRegex   = regexp.MustCompile(`"([^"]*)"`)
+)
+
+func article(name string) string {
+	if strings.ContainsAny(name[:1], "aeiou") {
+		return "An"
+	}
+
+	return "A"
+}
+
+func fieldReference(blockName, fieldName string) string {
+	if blockName == "" {
+		return fmt.Sprintf("`%s`", fieldName)
+	}
+
+	return fmt.Sprintf("`%s.%s`", blockName, fieldName)
+}
+
+// mentionsAllValues returns whether each of the specified values is already documented (e.g. as `Value`) in the description
+func mentionsAllValues(description string, values []string) bool {
+	for _, v := range values {
+		if !strings.Contains(strings.ToLower(description), strings.ToLower(fmt.Sprintf("`%s`", v))) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func formatList(input []string) string {
+	quoted := make([]string, 0)
+	for _, v := range input {
+		quoted = append(quoted, fmt.Sprintf("`%s`", v))
+	}
+
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+
+	return fmt.Sprintf("%s and %s", strings.Join(quoted[:len(quoted)-1], ", "), quoted[len(quoted)-1])
+}
+
+func sortedKeys(input interface{}) []string {
+	keys := make([]string, 0)
+	switch v := input.(type) {
+	case map[string]*schema.Schema:
+		for key := range v {
+			keys = append(keys, key)
+		}
+	case map[string]map[string]*schema.Schema:
+		for key := range v {
+			keys = append(keys, key)
+		}
+	case map[string]docs.Block:
+		for key := range v {
+			keys = append(keys, key)
+		}
+	case map[string]docs.Field:
+		for key := range v {
+			keys = append(keys, key)
+		}
+	case map[string]time.Duration:
+		for key := range v {
+			keys = append(keys, key)
+		}
+	case map[string]string:
+		for key := range v {
+			keys = append(keys, key)
+		}
+	default:
+		panic(fmt.Sprintf("unsupported type %T", input))
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+func timeoutToFriendlyText(duration time.Duration) string {
+	hours := int(math.Floor(duration.Hours()))
+	if hours > 0 {
+		var hoursText string
+		if hours > 1 {
+			hoursText = fmt.Sprintf("%d hours", hours)
+		} else {
+			hoursText = "1 hour"
+		}
+
+		minutesRemaining := int(math.Floor(duration.Minutes())) % 60.0
+		if minutesRemaining == 0 {
+			return hoursText
+		}
+
+		var minutesText string
+		if minutesRemaining > 1 {
+			minutesText = fmt.Sprintf("%d minutes", minutesRemaining)
+		} else {
+			minutesText = "1 minute"
+		}
+
+		return fmt.Sprintf("%s and %s", hoursText, minutesText)
+	}
+
+	minutes := int(duration.Minutes())
+	if minutes > 1 {
+		return fmt.Sprintf("%d minutes", minutes)
+	}
+
+	return "1 minute"
+}
+
+// friendlyTextToTimeout parses the documented default for a timeout (e.g. `1 hour and 30 minutes` or `90 minutes`)
+func friendlyTextToTimeout(input string) time.Duration {
+	var duration time.Duration
+	for _, match := range friendlyTimeoutRegex.FindAllStringSubmatch(input, -1) {
+		value, err := strconv.Atoi(match[1])
+		if err != nil {
+			return 0
+		}
+
+		if strings.HasPrefix(match[2], "hour") {
+			duration += time.Duration(value) * time.Hour
+		} else {
+			duration += time.Duration(value) * time.Minute
+		}
+	}
+
+	return duration
+}
+
+var friendlyTimeoutRegex = regexp.MustCompile(`(\d+) (hours?|minutes?)`)
+
+func withFullStop(input string) string {
+	if strings.HasSuffix(input, ".") || strings.HasSuffix(input, "?") {
+		return input
+	}
+
+	return input + "."
+}
+
+func writeToFile(filePath string, contents string) error {
+	outputPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(outputPath, []byte(contents), 0644)
+}
@@ -0,0 +1,289 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/docs"
+)
+
+func testResource() documentedResource {
+	timeout := 30 * time.Minute
+	readTimeout := 5 * time.Minute
+	return documentedResource{
+		name: "azurerm_foobar",
+		resource: &schema.Resource{
+			Importer: &schema.ResourceImporter{},
+			Timeouts: &schema.ResourceTimeout{
+				Create: &timeout,
+				Read:   &readTimeout,
+				Delete: &timeout,
+			},
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"sku": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "Basic",
+					ValidateFunc: validation.StringInSlice([]string{"Basic", "Premium"}, false),
+				},
+				"enabled": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Should the Foobar be enabled",
+				},
+				"rule": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"priority": {
+								Type:     schema.TypeInt,
+								Required: true,
+							},
+						},
+					},
+				},
+				"legacy": {
+					Type:       schema.TypeString,
+					Optional:   true,
+					Deprecated: "this has been superseded by `sku`",
+				},
+				"fqdn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+const testExistingPage = `---
+subcategory: "Foobar"
+layout: "azurerm"
+page_title: "Azure Resource Manager: azurerm_foobar"
+description: |-
+  Manages a Foobar.
+---
+
+# azurerm_foobar
+
+Manages a Foobar.
+
+## Example Usage
+
+'''hcl
+## this isn't a heading
+resource "azurerm_foobar" "example" {
+  name = "example"
+}
+'''
+
+## Argument Reference
+
+The following arguments are supported:
+
+* 'name' - (Required) The name of the Foobar.
+
+* 'sku' - (Required) The SKU of the Foobar, which can be
+  used for wrapping.
+
+~> **NOTE:** Premium is expensive.
+
+* 'unknown' - (Optional) A field which was removed.
+
+## Attributes Reference
+
+The following attributes are exported:
+
+* 'id' - The Foobar ID.
+
+## Import
+
+Foobars can be imported using the 'resource id', e.g.
+
+'''shell
+terraform import azurerm_foobar.example /subscriptions/00000000-0000-0000-0000-000000000000/foobars/foobar1
+'''
+`
+
+func TestGenerate(t *testing.T) {
+	expected := strings.ReplaceAll(`---
+subcategory: "Foobar"
+layout: "azurerm"
+page_title: "Azure Resource Manager: azurerm_foobar"
+description: |-
+  Manages a Foobar.
+---
+
+# azurerm_foobar
+
+Manages a Foobar.
+
+## Example Usage
+
+'''hcl
+## this isn't a heading
+resource "azurerm_foobar" "example" {
+  name = "example"
+}
+'''
+
+## Argument Reference
+
+The following arguments are supported:
+
+* 'name' - (Required) The name of the Foobar. Changing this forces a new Foobar to be created.
+
+---
+
+* 'sku' - (Optional) The SKU of the Foobar, which can be used for wrapping. Possible values are 'Basic' and 'Premium'. Defaults to 'Basic'.
+
+~> **NOTE:** Premium is expensive.
+
+* 'enabled' - (Optional) Should the Foobar be enabled.
+
+* 'rule' - (Optional) One or more 'rule' blocks as defined below.
+
+---
+
+A 'rule' block supports the following:
+
+* 'priority' - (Required) TODO.
+
+## Attributes Reference
+
+In addition to the Arguments listed above - the following Attributes are exported:
+
+* 'id' - The Foobar ID.
+
+* 'fqdn' - TODO.
+
+## Timeouts
+
+The 'timeouts' block allows you to specify [timeouts](https://www.terraform.io/docs/configuration/resources.html#timeouts) for certain actions:
+
+* 'create' - (Defaults to 30 minutes) Used when creating the Foobar.
+* 'read' - (Defaults to 5 minutes) Used when retrieving the Foobar.
+* 'delete' - (Defaults to 30 minutes) Used when deleting the Foobar.
+
+## Import
+
+Foobars can be imported using the 'resource id', e.g.
+
+'''shell
+terraform import azurerm_foobar.example /subscriptions/00000000-0000-0000-0000-000000000000/foobars/foobar1
+'''
+`, "'''", "```")
+	expected = strings.ReplaceAll(expected, "'", "`")
+
+	input := strings.ReplaceAll(strings.ReplaceAll(testExistingPage, "'''", "```"), "'", "`")
+	actual := newDocumentationGenerator(testResource(), docs.ParsePage(input)).generate()
+	if actual != expected {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(expected, actual, false)
+		t.Fatalf("Expected the generated documentation to match but got:\n\n%s", dmp.DiffPrettyText(diffs))
+	}
+
+	// generating the documentation again shouldn't change anything
+	if regenerated := newDocumentationGenerator(testResource(), docs.ParsePage(actual)).generate(); regenerated != actual {
+		t.Fatalf("Expected generating the documentation to be idempotent but got:\n\n%s", regenerated)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	input := strings.ReplaceAll(strings.ReplaceAll(testExistingPage, "'''", "```"), "'", "`")
+	actual := newDocumentationGenerator(testResource(), docs.ParsePage(input)).check()
+	expected := []string{
+		"`enabled` isn't documented in the Arguments Reference",
+		"`name` is ForceNew in the schema but isn't documented as such",
+		"`rule` isn't documented in the Arguments Reference",
+		"`sku` is Optional in the schema but is documented as Required",
+		"the `rule` block isn't documented in the Arguments Reference",
+		"`unknown` is documented in the Arguments Reference but doesn't exist in the schema",
+		"`fqdn` isn't documented in the Attributes Reference",
+		"the `create` timeout isn't documented",
+		"the `delete` timeout isn't documented",
+		"the `read` timeout isn't documented",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected:\n\n%s\n\nbut got:\n\n%s", strings.Join(expected, "\n"), strings.Join(actual, "\n"))
+	}
+
+	generated := newDocumentationGenerator(testResource(), docs.ParsePage(input)).generate()
+	if problems := newDocumentationGenerator(testResource(), docs.ParsePage(generated)).check(); len(problems) > 0 {
+		t.Fatalf("Expected no problems for the generated documentation but got:\n\n%s", strings.Join(problems, "\n"))
+	}
+}
+
+func TestAllowedValues(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Field    *schema.Schema
+		Expected []string
+	}{
+		{
+			Name: "no validation",
+			Field: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		{
+			Name: "string in slice",
+			Field: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"First", "Second"}, true),
+			},
+			Expected: []string{"First", "Second"},
+		},
+		{
+			Name: "other validation",
+			Field: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		{
+			Name: "integer",
+			Field: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntInSlice([]int{1, 2}),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := allowedValues(v.Field)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestFriendlyTextToTimeout(t *testing.T) {
+	testData := map[string]time.Duration{
+		"1 minute":              time.Minute,
+		"90 minutes":            90 * time.Minute,
+		"1 hour and 30 minutes": 90 * time.Minute,
+		"2 hours":               2 * time.Hour,
+		"something unparseable": 0,
+		"12 hours and 1 minute": 12*time.Hour + time.Minute,
+	}
+
+	for input, expected := range testData {
+		if actual := friendlyTextToTimeout(input); actual != expected {
+			t.Fatalf("Expected %q to be %s but got %s", input, expected, actual)
+		}
+	}
+}
@@ -2,9 +2,15 @@ package azurerm
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
 )
 
 func Provider() *schema.Provider {
 	return provider.AzureProvider()
 }
+
+// Shutdown should be called once the Provider has finished serving requests
+func Shutdown() {
+	locks.WriteMetricsSummary()
+}
@@ -31,4 +31,6 @@ func main() {
 			ProviderFunc: azurerm.Provider,
 		})
 	}
+
+	azurerm.Shutdown()
 }